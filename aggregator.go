@@ -0,0 +1,478 @@
+package appdash
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// defaultSlowest is the number of the slowest traces that aggregated results
+// list by default, both of an AggregatingCollector and of InfluxDBStore.
+const defaultSlowest = 5
+
+// OtherGroup is the group that an AggregatingCollector aggregates the spans
+// of a window into once the window has MaxGroups groups.
+const OtherGroup = "(other)"
+
+// An AggregatingCollector is a Collector that aggregates the durations of the
+// spans it collects, grouped by name and by the values of the GroupBy
+// annotations, before passing the spans to the underlying Collector. It
+// implements the Aggregator interface, so stores that don't (such as
+// MemoryStore) can be used for the dashboard of the web UI.
+//
+// Durations are aggregated into rolling windows by the start time of the
+// span, so that Aggregate can report on any time range within the retention
+// period to within a window. A span is aggregated when a single Collect call
+// has both its name and its timespan events, as when it is recorded by a
+// Recorder.
+//
+// To bound the memory used, each window has at most MaxGroups groups of each
+// kind, and the windows older than CoarseAfter are merged into longer ones.
+type AggregatingCollector struct {
+	Collector // the underlying collector
+
+	// Window is the length of the time windows that durations are aggregated
+	// into.
+	//
+	// Default Window = 1 * time.Minute.
+	Window time.Duration
+
+	// Retention is how long the aggregated windows are kept.
+	//
+	// Default Retention = 72 * time.Hour.
+	Retention time.Duration
+
+	// CoarseWindow is the length of the time windows that durations older
+	// than CoarseAfter are aggregated into, so that fewer windows are kept
+	// for the whole retention period. If zero, all windows are of length
+	// Window.
+	//
+	// Default CoarseWindow = 1 * time.Hour.
+	CoarseWindow time.Duration
+
+	// CoarseAfter is the age after which windows are merged into windows of
+	// length CoarseWindow.
+	//
+	// Default CoarseAfter = 3 * time.Hour.
+	CoarseAfter time.Duration
+
+	// MaxGroups is the maximum number of groups of each kind (span names or
+	// the values of each of GroupBy, of root spans or all spans) that a
+	// window has. Beyond it, spans are aggregated into OtherGroup. If zero,
+	// there is no maximum.
+	//
+	// Default MaxGroups = 1000.
+	MaxGroups int
+
+	// Slowest is the number of the slowest traces that are kept for each
+	// group, and that results list.
+	//
+	// Default Slowest = 5.
+	Slowest int
+
+	// GroupBy is the annotation keys, besides span names, that Aggregate can
+	// group spans by. Aggregating by other keys is an error.
+	//
+	// Default GroupBy = DefaultGroupBy.
+	GroupBy []string
+
+	now func() time.Time // for testing; time.Now if nil
+
+	mu         sync.Mutex
+	windows    map[int64]*aggWindow // window start (UnixNano) -> window of length Window
+	coarse     map[int64]*aggWindow // window start (UnixNano) -> window of length CoarseWindow
+	lastPruned time.Time
+}
+
+// NewAggregatingCollector is shorthand for:
+//
+//	c := &appdash.AggregatingCollector{
+//		Collector:    c,
+//		Window:       1 * time.Minute,
+//		Retention:    72 * time.Hour,
+//		CoarseWindow: 1 * time.Hour,
+//		CoarseAfter:  3 * time.Hour,
+//		MaxGroups:    1000,
+//		Slowest:      5,
+//		GroupBy:      appdash.DefaultGroupBy,
+//	}
+//
+// except that GroupBy is a copy of DefaultGroupBy.
+func NewAggregatingCollector(c Collector) *AggregatingCollector {
+	return &AggregatingCollector{
+		Collector:    c,
+		Window:       1 * time.Minute,
+		Retention:    72 * time.Hour,
+		CoarseWindow: 1 * time.Hour,
+		CoarseAfter:  3 * time.Hour,
+		MaxGroups:    1000,
+		Slowest:      defaultSlowest,
+		GroupBy:      append([]string(nil), DefaultGroupBy...),
+	}
+}
+
+// DefaultGroupBy is the annotation keys, besides span names, that spans can
+// be grouped by unless configured otherwise: the route, user and response
+// status code of HTTP servers, the tag of sqltrace events and the service.
+var DefaultGroupBy = []string{"Server.Route", "Server.User", "Server.Response.StatusCode", "Tag", ServiceKey}
+
+// aggGrouping is a kind of groups of spans whose durations are aggregated.
+type aggGrouping struct {
+	groupBy  string // the annotation key, or "" for span names
+	allSpans bool   // whether the groups include non-root spans
+}
+
+// aggKey identifies a group of spans whose durations are aggregated.
+type aggKey struct {
+	aggGrouping
+	group string // the annotation value (or span name)
+}
+
+// aggWindow is the aggregated durations of the spans that started within a
+// time window.
+type aggWindow struct {
+	length time.Duration
+	groups map[aggKey]*aggStats
+	sizes  map[aggGrouping]int // number of groups of each grouping
+}
+
+func newAggWindow(length time.Duration) *aggWindow {
+	return &aggWindow{
+		length: length,
+		groups: make(map[aggKey]*aggStats),
+		sizes:  make(map[aggGrouping]int),
+	}
+}
+
+// keys returns the keys of the groups, those with the most samples first
+// and OtherGroup last, so that merging them into a window with fewer than
+// MaxGroups groups keeps the largest groups.
+func (w *aggWindow) keys() []aggKey {
+	keys := make([]aggKey, 0, len(w.groups))
+	for k := range w.groups {
+		keys = append(keys, k)
+	}
+	sort.Sort(aggKeysBySize{keys, w.groups})
+	return keys
+}
+
+// group returns the statistics of the group k, which are those of the
+// OtherGroup of its grouping if the window has max groups of it (and none
+// is k).
+func (w *aggWindow) group(k aggKey, max int) *aggStats {
+	if stats, ok := w.groups[k]; ok {
+		return stats
+	}
+	if max > 0 && w.sizes[k.aggGrouping] >= max {
+		k.group = OtherGroup
+		if stats, ok := w.groups[k]; ok {
+			return stats
+		}
+	}
+	stats := &aggStats{}
+	w.groups[k] = stats
+	w.sizes[k.aggGrouping]++
+	return stats
+}
+
+// Compile-time "implements" check.
+var _ interface {
+	Collector
+	Aggregator
+} = (*AggregatingCollector)(nil)
+
+// Collect implements the Collector interface by aggregating the duration of
+// the span, and then calling the underlying collector's Collect.
+func (ac *AggregatingCollector) Collect(id SpanID, anns ...Annotation) error {
+	if err := ac.aggregate(id, anns); err != nil {
+		return err
+	}
+	return ac.Collector.Collect(id, anns...)
+}
+
+// aggregate aggregates the duration of the span id, if anns has its name and
+// timespan events.
+func (ac *AggregatingCollector) aggregate(id SpanID, anns Annotations) error {
+	s := Span{ID: id, Annotations: anns}
+	name := s.Name()
+	if name == "" {
+		return nil
+	}
+	var events []Event
+	if err := UnmarshalEvents(anns, &events); err != nil {
+		return err
+	}
+	start, end, ok := findTraceTimes(events)
+	if !ok {
+		return nil
+	}
+
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	now := ac.nowTime()
+	if start.Before(now.Add(-ac.Retention)) {
+		return nil
+	}
+	w := ac.windowNoLock(start, ac.CoarseWindow > 0 && start.Before(now.Add(-ac.CoarseAfter)))
+	for _, key := range append([]string{""}, ac.GroupBy...) {
+		k := aggKey{aggGrouping{groupBy: key, allSpans: true}, name}
+		if key != "" {
+			// Spans without the annotation are not grouped by it.
+			v := anns.get(key)
+			if len(v) == 0 {
+				continue
+			}
+			k.group = string(v)
+		}
+		w.group(k, ac.MaxGroups).add(id.Trace, end.Sub(start), ac.Slowest)
+		if id.Parent == 0 {
+			k.allSpans = false
+			w.group(k, ac.MaxGroups).add(id.Trace, end.Sub(start), ac.Slowest)
+		}
+	}
+
+	if now.Sub(ac.lastPruned) > ac.Window {
+		ac.pruneNoLock(now)
+	}
+	return nil
+}
+
+// windowNoLock returns the window (of length CoarseWindow if coarse) that
+// the time t is within.
+func (ac *AggregatingCollector) windowNoLock(t time.Time, coarse bool) *aggWindow {
+	windows, length := &ac.windows, ac.Window
+	if coarse {
+		windows, length = &ac.coarse, ac.CoarseWindow
+	}
+	if *windows == nil {
+		*windows = make(map[int64]*aggWindow)
+	}
+	start := t.Truncate(length).UnixNano()
+	w, ok := (*windows)[start]
+	if !ok {
+		w = newAggWindow(length)
+		(*windows)[start] = w
+	}
+	return w
+}
+
+// pruneNoLock merges the windows that ended more than CoarseAfter ago into
+// coarse windows, and removes the windows that ended before the retention
+// period.
+func (ac *AggregatingCollector) pruneNoLock(now time.Time) {
+	ac.lastPruned = now
+	if ac.CoarseWindow > 0 {
+		coarseBefore := now.Add(-ac.CoarseAfter).UnixNano()
+		for start, w := range ac.windows {
+			if start+int64(w.length) > coarseBefore {
+				continue
+			}
+			cw := ac.windowNoLock(time.Unix(0, start), true)
+			for _, k := range w.keys() {
+				cw.group(k, ac.MaxGroups).merge(w.groups[k], ac.Slowest)
+			}
+			delete(ac.windows, start)
+		}
+	}
+	oldest := now.Add(-ac.Retention).UnixNano()
+	for _, windows := range []map[int64]*aggWindow{ac.windows, ac.coarse} {
+		for start, w := range windows {
+			if start+int64(w.length) <= oldest {
+				delete(windows, start)
+			}
+		}
+	}
+}
+
+// Aggregate implements the Aggregator interface. It aggregates the windows
+// that overlap the time range of opts. Results are ordered by group.
+func (ac *AggregatingCollector) Aggregate(opts AggregateOpts) ([]*AggregatedResult, error) {
+	if opts.GroupBy != "" && !ac.groupsBy(opts.GroupBy) {
+		return nil, fmt.Errorf("spans are not aggregated by the annotation %q", opts.GroupBy)
+	}
+
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+
+	now := ac.nowTime()
+	from := now.Add(opts.Start).UnixNano()
+	to := now.Add(opts.End).UnixNano()
+	grouping := aggGrouping{groupBy: opts.GroupBy, allSpans: opts.AllSpans}
+	byGroup := make(map[string]*aggStats)
+	for _, windows := range []map[int64]*aggWindow{ac.windows, ac.coarse} {
+		for start, w := range windows {
+			if start+int64(w.length) <= from || start > to {
+				continue
+			}
+			for k, stats := range w.groups {
+				if k.aggGrouping != grouping {
+					continue
+				}
+				merged, ok := byGroup[k.group]
+				if !ok {
+					merged = &aggStats{}
+					byGroup[k.group] = merged
+				}
+				merged.merge(stats, ac.Slowest)
+			}
+		}
+	}
+
+	results := make([]*AggregatedResult, 0, len(byGroup))
+	for group, stats := range byGroup {
+		r := stats.result(group)
+		if opts.GroupBy == "" {
+			r.RootSpanName = group
+		}
+		results = append(results, r)
+	}
+	sort.Sort(aggregatedResultsByGroup(results))
+	return results, nil
+}
+
+// groupsBy reports whether spans are aggregated by the annotation key.
+func (ac *AggregatingCollector) groupsBy(key string) bool {
+	for _, k := range ac.GroupBy {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (ac *AggregatingCollector) nowTime() time.Time {
+	if ac.now != nil {
+		return ac.now()
+	}
+	return time.Now()
+}
+
+// aggStats is the running statistics of a set of durations. The mean and the
+// sum of squared differences from it (m2) are kept rather than sums, so that
+// the standard deviation stays accurate; see
+// https://en.wikipedia.org/wiki/Algorithms_for_calculating_variance.
+type aggStats struct {
+	count    int64
+	mean, m2 float64 // in nanoseconds
+	min, max time.Duration
+	sketch   DurationSketch
+	slowest  []slowTrace // slowest first
+}
+
+// slowTrace is a trace and its duration.
+type slowTrace struct {
+	id       ID
+	duration time.Duration
+}
+
+// add adds the duration of a span of a trace, keeping the n slowest traces.
+func (s *aggStats) add(trace ID, d time.Duration, n int) {
+	o := &aggStats{
+		count:   1,
+		mean:    float64(d),
+		min:     d,
+		max:     d,
+		slowest: []slowTrace{{trace, d}},
+	}
+	o.sketch.Add(d)
+	s.merge(o, n)
+}
+
+// merge merges the statistics of o into s, keeping the n slowest traces.
+func (s *aggStats) merge(o *aggStats, n int) {
+	if o.count == 0 {
+		return
+	}
+	if s.count == 0 {
+		s.min, s.max = o.min, o.max
+	}
+	if o.min < s.min {
+		s.min = o.min
+	}
+	if o.max > s.max {
+		s.max = o.max
+	}
+	count := s.count + o.count
+	delta := o.mean - s.mean
+	s.mean += delta * float64(o.count) / float64(count)
+	s.m2 += o.m2 + delta*delta*float64(s.count)*float64(o.count)/float64(count)
+	s.count = count
+	s.sketch.Merge(&o.sketch)
+
+	all := append(append(make([]slowTrace, 0, len(s.slowest)+len(o.slowest)), s.slowest...), o.slowest...)
+	sort.Stable(slowTracesByDuration(all))
+
+	// Traces may have several spans in a group, and are listed once.
+	slowest := all[:0]
+	seen := make(map[ID]bool, len(all))
+	for _, t := range all {
+		if len(slowest) == n {
+			break
+		}
+		if !seen[t.id] {
+			seen[t.id] = true
+			slowest = append(slowest, t)
+		}
+	}
+	s.slowest = slowest
+}
+
+// result returns s as an AggregatedResult for the given group.
+func (s *aggStats) result(group string) *AggregatedResult {
+	r := &AggregatedResult{
+		Group:   group,
+		Average: time.Duration(s.mean),
+		Min:     s.min,
+		Max:     s.max,
+		StdDev:  time.Duration(math.Sqrt(s.m2 / float64(s.count))),
+		Samples: s.count,
+	}
+	r.setDistribution(&s.sketch)
+	for _, t := range s.slowest {
+		r.Slowest = append(r.Slowest, t.id)
+	}
+	return r
+}
+
+// setDistribution sets the percentiles, histogram and sketch of r from the
+// sketch of the durations it aggregates.
+func (r *AggregatedResult) setDistribution(sketch *DurationSketch) {
+	r.P50 = sketch.Quantile(0.5)
+	r.P90 = sketch.Quantile(0.9)
+	r.P95 = sketch.Quantile(0.95)
+	r.P99 = sketch.Quantile(0.99)
+	r.Histogram = sketch.Buckets(HistogramBounds)
+	r.Sketch = sketch.Copy()
+}
+
+type slowTracesByDuration []slowTrace
+
+func (v slowTracesByDuration) Len() int           { return len(v) }
+func (v slowTracesByDuration) Less(i, j int) bool { return v[i].duration > v[j].duration }
+func (v slowTracesByDuration) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+
+type aggregatedResultsByGroup []*AggregatedResult
+
+func (v aggregatedResultsByGroup) Len() int           { return len(v) }
+func (v aggregatedResultsByGroup) Less(i, j int) bool { return v[i].Group < v[j].Group }
+func (v aggregatedResultsByGroup) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+
+type aggKeysBySize struct {
+	keys   []aggKey
+	groups map[aggKey]*aggStats
+}
+
+func (v aggKeysBySize) Len() int      { return len(v.keys) }
+func (v aggKeysBySize) Swap(i, j int) { v.keys[i], v.keys[j] = v.keys[j], v.keys[i] }
+func (v aggKeysBySize) Less(i, j int) bool {
+	ki, kj := v.keys[i], v.keys[j]
+	if (ki.group == OtherGroup) != (kj.group == OtherGroup) {
+		return kj.group == OtherGroup
+	}
+	if ci, cj := v.groups[ki].count, v.groups[kj].count; ci != cj {
+		return ci > cj
+	}
+	return ki.group < kj.group
+}
@@ -0,0 +1,208 @@
+package appdash
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAggregatingCollector(t *testing.T) {
+	now := time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC)
+	ms := NewMemoryStore()
+	ac := NewAggregatingCollector(ms)
+	ac.Slowest = 2
+	ac.now = func() time.Time { return now }
+
+	collectSpan := func(id SpanID, name string, start time.Time, d time.Duration, anns ...Annotation) {
+		for _, e := range []Event{spanName{name}, Timespan{S: start, E: start.Add(d)}} {
+			as, err := MarshalEvent(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			anns = append(anns, as...)
+		}
+		if err := ac.Collect(id, anns...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	collect := func(trace ID, name string, start time.Time, d time.Duration, anns ...Annotation) {
+		collectSpan(SpanID{Trace: trace, Span: trace}, name, start, d, anns...)
+	}
+	route := func(r string) Annotation { return Annotation{Key: "Server.Route", Value: []byte(r)} }
+	collect(1, "/a", now.Add(-2*time.Hour), 100*time.Millisecond, route("a"))
+	collect(2, "/a", now.Add(-30*time.Minute), 300*time.Millisecond, route("a"))
+	collect(3, "/a", now.Add(-10*time.Minute), 200*time.Millisecond, route("a"))
+	collect(4, "/b", now.Add(-5*time.Minute), 1*time.Second, route("b"))
+	collect(5, "/old", now.Add(-73*time.Hour), 1*time.Second) // beyond retention
+
+	// Child spans are only aggregated with all spans, and are collected.
+	child := SpanID{Trace: 4, Span: 6, Parent: 4}
+	collectSpan(child, "query", now.Add(-5*time.Minute), 500*time.Millisecond, route("b"), Annotation{Key: "Tag", Value: []byte("users")})
+	if tr, err := ms.Trace(4); err != nil || len(tr.Sub) != 1 {
+		t.Errorf("got trace %v (err %v), want a trace with 1 child", tr, err)
+	}
+
+	results, err := ac.Aggregate(AggregateOpts{Start: -72 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := results[0]; !within(r.P50, 200*time.Millisecond) || !within(r.P99, 300*time.Millisecond) {
+		t.Errorf("got p50 %s and p99 %s, want about 200ms and 300ms", r.P50, r.P99)
+	}
+	if h := results[0].Histogram; len(h) == 0 || h[len(h)-1].Max != 500*time.Millisecond || h[len(h)-1].Count != 1 {
+		t.Errorf("got histogram %+v, want the last bucket to have 1 trace up to 500ms", h)
+	}
+	for _, r := range results {
+		r.P50, r.P90, r.P95, r.P99, r.Histogram, r.Sketch = 0, 0, 0, 0, nil, nil
+	}
+	want := []*AggregatedResult{
+		{
+			RootSpanName: "/a",
+			Group:        "/a",
+			Average:      200 * time.Millisecond,
+			Min:          100 * time.Millisecond,
+			Max:          300 * time.Millisecond,
+			StdDev:       time.Duration(math.Sqrt(2.0/3) * float64(100*time.Millisecond)),
+			Samples:      3,
+			Slowest:      []ID{2, 3},
+		},
+		{
+			RootSpanName: "/b",
+			Group:        "/b",
+			Average:      1 * time.Second,
+			Min:          1 * time.Second,
+			Max:          1 * time.Second,
+			Samples:      1,
+			Slowest:      []ID{4},
+		},
+	}
+	if !reflect.DeepEqual(results, want) {
+		t.Errorf("got results %v, want %v", resultsString(results), resultsString(want))
+	}
+
+	// Spans can be grouped by annotation (if they have it), and all spans can
+	// be aggregated.
+	for _, c := range []struct {
+		opts    AggregateOpts
+		want    []string
+		samples []int64
+	}{
+		{AggregateOpts{Start: -72 * time.Hour, GroupBy: "Server.Route"}, []string{"a", "b"}, []int64{3, 1}},
+		{AggregateOpts{Start: -72 * time.Hour, AllSpans: true}, []string{"/a", "/b", "query"}, []int64{3, 1, 1}},
+		{AggregateOpts{Start: -72 * time.Hour, GroupBy: "Tag", AllSpans: true}, []string{"users"}, []int64{1}},
+	} {
+		results, err := ac.Aggregate(c.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var groups []string
+		var samples []int64
+		for _, r := range results {
+			groups = append(groups, r.Group)
+			samples = append(samples, r.Samples)
+		}
+		if !reflect.DeepEqual(groups, c.want) || !reflect.DeepEqual(samples, c.samples) {
+			t.Errorf("%+v: got groups %q with samples %v, want %q with %v", c.opts, groups, samples, c.want, c.samples)
+		}
+		if c.opts.GroupBy != "" && results[0].RootSpanName != "" {
+			t.Errorf("%+v: got root span name %q, want none", c.opts, results[0].RootSpanName)
+		}
+	}
+	results, err = ac.Aggregate(AggregateOpts{Start: -72 * time.Hour, GroupBy: "Server.Route", AllSpans: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := results[1].Slowest; !reflect.DeepEqual(got, []ID{4}) {
+		t.Errorf("got slowest traces %v, want trace 4 listed once", got)
+	}
+	if _, err := ac.Aggregate(AggregateOpts{GroupBy: "Other"}); err == nil {
+		t.Error("got no error aggregating by an annotation that isn't aggregated")
+	}
+
+	// Only the windows within the time range are aggregated.
+	results, err = ac.Aggregate(AggregateOpts{Start: -time.Hour, End: -20 * time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].RootSpanName != "/a" || results[0].Samples != 1 || !reflect.DeepEqual(results[0].Slowest, []ID{2}) {
+		t.Errorf("got results %v, want only trace 2", resultsString(results))
+	}
+
+	// Windows beyond the retention period are pruned.
+	now = now.Add(71 * time.Hour)
+	collect(7, "/c", now, time.Second)
+	results, err = ac.Aggregate(AggregateOpts{Start: -100 * time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, r := range results {
+		names = append(names, r.RootSpanName)
+	}
+	if want := []string{"/a", "/b", "/c"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got names %v, want %v", names, want)
+	}
+	if results[0].Samples != 2 {
+		t.Errorf("got %d samples of /a, want 2", results[0].Samples)
+	}
+}
+
+func TestAggregatingCollector_bounded(t *testing.T) {
+	now := time.Date(2016, 1, 1, 12, 0, 0, 0, time.UTC)
+	ac := NewAggregatingCollector(NewMemoryStore())
+	ac.MaxGroups = 2
+	ac.now = func() time.Time { return now }
+
+	collect := func(trace ID, name string, start time.Time) {
+		var anns []Annotation
+		for _, e := range []Event{spanName{name}, Timespan{S: start, E: start.Add(time.Second)}} {
+			as, err := MarshalEvent(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			anns = append(anns, as...)
+		}
+		if err := ac.Collect(SpanID{Trace: trace, Span: trace}, anns...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check := func(want []string, samples []int64) {
+		results, err := ac.Aggregate(AggregateOpts{Start: -72 * time.Hour})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var groups []string
+		var gotSamples []int64
+		for _, r := range results {
+			groups = append(groups, r.Group)
+			gotSamples = append(gotSamples, r.Samples)
+		}
+		if !reflect.DeepEqual(groups, want) || !reflect.DeepEqual(gotSamples, samples) {
+			t.Errorf("got groups %q with samples %v, want %q with %v", groups, gotSamples, want, samples)
+		}
+	}
+
+	// Beyond MaxGroups, spans are aggregated into OtherGroup.
+	for i, name := range []string{"/a", "/b", "/c", "/a", "/d"} {
+		collect(ID(i+1), name, now)
+	}
+	check([]string{OtherGroup, "/a", "/b"}, []int64{2, 2, 1})
+
+	// Old windows are merged into coarse windows, which are bounded too.
+	now = now.Add(4 * time.Hour)
+	collect(6, "/e", now)
+	collect(7, "/e", now.Add(-4*time.Hour))
+	if len(ac.windows) != 1 || len(ac.coarse) != 1 {
+		t.Errorf("got %d windows and %d coarse windows, want 1 of each", len(ac.windows), len(ac.coarse))
+	}
+	check([]string{OtherGroup, "/a", "/b", "/e"}, []int64{3, 2, 1, 1})
+}
+
+func resultsString(results []*AggregatedResult) []AggregatedResult {
+	v := make([]AggregatedResult, len(results))
+	for i, r := range results {
+		v[i] = *r
+	}
+	return v
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"regexp"
+	"syscall"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+func init() {
+	_, err := CLI.AddCommand("agent",
+		"start an appdash agent that forwards spans to collector servers",
+		`The agent command starts a local agent, which accepts spans from applications on the same host (on localhost TCP and a Unix domain socket) and forwards them to one or more upstream collector servers (started with "appdash serve").
+
+Spans are sampled and redacted, and then sent to the upstream servers in batches. While no upstream server is available, they are kept in a spool on disk, to be sent once one is.`,
+		&agentCmd,
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// AgentCmd is the command for running Appdash in agent mode, where spans
+// are collected locally and forwarded to remote collectors.
+type AgentCmd struct {
+	CollectorAddr string `long:"collector" description:"collector listen address (empty to disable)" default:"localhost:7701"`
+	Socket        string `long:"socket" description:"collector Unix domain socket (empty to disable)" default:"/tmp/appdash.sock"`
+
+	Upstreams    []string `short:"u" long:"upstream" description:"address of an upstream collector server (may be repeated, in order of preference)" required:"true"`
+	ShardByTrace bool     `long:"shard-by-trace" description:"send all spans of a trace to the same upstream server, chosen by a hash of the trace ID"`
+	Compression  string   `long:"compression" description:"compression of the spans sent upstream (gzip or none)" default:"gzip"`
+
+	TLS        bool   `long:"tls" description:"connect to the upstream servers with TLS"`
+	ServerName string `long:"server-name" description:"server name to verify the upstream servers' certificates against (the host of each upstream by default)"`
+	CACert     string `long:"ca-cert" description:"CA certificate file to verify the upstream servers' certificates with (the system's CAs by default)"`
+	TLSCert    string `long:"tls-cert" description:"client certificate file to authenticate to the upstream servers with"`
+	TLSKey     string `long:"tls-key" description:"client key file to authenticate to the upstream servers with"`
+
+	FlushInterval time.Duration `long:"flush-interval" description:"interval between sending spans upstream" default:"500ms"`
+	SpoolDir      string        `long:"spool-dir" description:"directory of the spool of spans that can't be sent yet (empty to disable)" default:"/tmp/appdash-spool"`
+	SpoolMaxSize  int64         `long:"spool-max-size" description:"maximum size of the spool in bytes, beyond which the oldest spans are dropped" default:"67108864"`
+
+	SampleRate      float64  `long:"sample-rate" description:"fraction of traces to keep, between 0 and 1" default:"1"`
+	SamplePerSecond float64  `long:"sample-per-sec" description:"maximum number of traces per second to keep (0 for no limit)"`
+	RedactKeys      []string `long:"redact-key" description:"key of the annotations to redact (may be repeated; a trailing * matches all keys with that prefix); adds to the keys of credential HTTP headers"`
+	RedactValues    []string `long:"redact-value" description:"regexp of the data to redact from all annotations (may be repeated)"`
+
+	MaxConns        int           `long:"max-conns" description:"maximum number of connections from applications to serve at once (0 for no limit)"`
+	ShutdownTimeout time.Duration `long:"shutdown-timeout" description:"how long to wait for the spans being received to be collected when shutting down" default:"5s"`
+
+	Debug bool `short:"d" long:"debug" description:"debug log"`
+}
+
+var agentCmd AgentCmd
+
+// Execute execudes the commands with the given arguments and returns an error,
+// if any.
+func (c *AgentCmd) Execute(args []string) error {
+	if c.CollectorAddr == "" && c.Socket == "" {
+		return errors.New("either --collector or --socket must be set")
+	}
+
+	var mc *appdash.MultiRemoteCollector
+	if c.TLS {
+		tc, err := c.tlsConfig()
+		if err != nil {
+			return err
+		}
+		mc = appdash.NewTLSMultiRemoteCollector(c.Upstreams, tc)
+	} else {
+		mc = appdash.NewMultiRemoteCollector(c.Upstreams)
+	}
+	mc.ShardByTrace = c.ShardByTrace
+	for _, rc := range mc.Collectors {
+		rc.Debug = c.Debug
+		if c.Compression != "none" {
+			rc.Compression = c.Compression
+		}
+	}
+	defer mc.Close()
+
+	if c.SpoolDir != "" {
+		spool, err := appdash.OpenSpool(c.SpoolDir)
+		if err != nil {
+			return err
+		}
+		spool.MaxBytes = c.SpoolMaxSize
+		defer spool.Close()
+		if st := spool.Stats(); st.Pending > 0 {
+			log.Printf("Spool in %s has %d spans to send", c.SpoolDir, st.Pending)
+		}
+		mc.Spool = spool
+	}
+
+	cc := appdash.NewChunkedCollector(mc)
+	cc.MinInterval = c.FlushInterval
+	if mc.Spool != nil {
+		// Spans that can't be sent in time are spooled rather than lost, so
+		// don't drop them when a flush takes long.
+		cc.FlushTimeout = 0
+	}
+
+	collector, err := c.collector(cc)
+	if err != nil {
+		return err
+	}
+
+	var listeners []net.Listener
+	if c.CollectorAddr != "" {
+		l, err := net.Listen("tcp", c.CollectorAddr)
+		if err != nil {
+			return err
+		}
+		log.Printf("appdash agent listening on %s", c.CollectorAddr)
+		listeners = append(listeners, l)
+	}
+	if c.Socket != "" {
+		if err := removeStaleSocket(c.Socket); err != nil {
+			return err
+		}
+		l, err := net.Listen("unix", c.Socket)
+		if err != nil {
+			return err
+		}
+		defer os.Remove(c.Socket)
+		log.Printf("appdash agent listening on unix:%s", c.Socket)
+		listeners = append(listeners, l)
+	}
+	var servers []*appdash.CollectorServer
+	for _, l := range listeners {
+		cs := appdash.NewServer(l, collector)
+		cs.Debug = c.Debug
+		cs.MaxConns = c.MaxConns
+		go cs.Start()
+		servers = append(servers, cs)
+	}
+	log.Printf("Forwarding spans to %v", c.Upstreams)
+
+	// Receive the spans that applications are sending, and then stop the
+	// periodic flushes and send (or spool) all buffered spans before
+	// exiting.
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	<-sig
+	log.Println("Shutting down...")
+	ctx, cancel := context.WithTimeout(context.Background(), c.ShutdownTimeout)
+	defer cancel()
+	for _, cs := range servers {
+		if err := cs.Shutdown(ctx); err != nil {
+			log.Printf("Shutdown: %s", err)
+		}
+	}
+	cc.Stop()
+	return cc.Flush()
+}
+
+// removeStaleSocket removes the Unix domain socket at path left by an agent
+// that didn't exit cleanly. It fails if path is not a socket, or if another
+// agent is listening on it.
+func removeStaleSocket(path string) error {
+	fi, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%s exists and is not a socket", path)
+	}
+	if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+		conn.Close()
+		return fmt.Errorf("another agent is listening on %s", path)
+	}
+	return os.Remove(path)
+}
+
+// collector returns the collector that samples and redacts spans before
+// passing them on to c.
+func (c *AgentCmd) collector(cc appdash.Collector) (appdash.Collector, error) {
+	rc := appdash.NewRedactingCollector(cc, append(appdash.DefaultRedactedKeys, c.RedactKeys...))
+	for _, v := range c.RedactValues {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --redact-value: %s", err)
+		}
+		rc.Values = append(rc.Values, re)
+	}
+
+	if sampler := newSampler(c.SampleRate, c.SamplePerSecond); sampler != nil {
+		log.Printf("Sampling traces (--sample-rate=%g, --sample-per-sec=%g)", c.SampleRate, c.SamplePerSecond)
+		return appdash.NewSamplingCollector(rc, sampler), nil
+	}
+	return rc, nil
+}
+
+// tlsConfig returns the TLS configuration of the connections to the
+// upstream servers.
+func (c *AgentCmd) tlsConfig() (*tls.Config, error) {
+	tc := &tls.Config{ServerName: c.ServerName}
+	if c.CACert != "" {
+		pem, err := ioutil.ReadFile(c.CACert)
+		if err != nil {
+			return nil, err
+		}
+		tc.RootCAs = x509.NewCertPool()
+		if !tc.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in %s", c.CACert)
+		}
+	}
+	if c.TLSCert != "" || c.TLSKey != "" {
+		cert, err := tls.LoadX509KeyPair(c.TLSCert, c.TLSKey)
+		if err != nil {
+			return nil, err
+		}
+		tc.Certificates = []tls.Certificate{cert}
+	}
+	return tc, nil
+}
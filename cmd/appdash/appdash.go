@@ -41,6 +41,18 @@
 // UI into your application directly on a separate HTTP port (see the traceapp
 // package or examples/cmd/webapp for more details).
 //
+// Agent mode
+//
+// Applications can send their spans to an agent on the same host, which
+// forwards them to one or more collector servers:
+//
+//  appdash agent -u="collector1:7701" -u="collector2:7701" --tls
+//
+// The agent accepts spans on TCP port 7701 of localhost and on the Unix
+// domain socket /tmp/appdash.sock. It samples and redacts them, and sends
+// them upstream in batches, keeping them in a spool on disk while no server
+// is available.
+//
 // Send mode
 //
 // For testing purposes, the appdash command can send some fake data to a
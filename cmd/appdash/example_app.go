@@ -88,14 +88,14 @@ func (c *DemoCmd) Execute(args []string) error {
 	// The Appdash collection server that our demo app will use is running
 	// locally with our HTTP server in this case, so we set this up now.
 	localCollector := appdash.NewRemoteCollector(fmt.Sprintf(":%d", collectorPort))
+	localCollector.Resource = appdash.NewProcessResource("demo")
 
 	// Handle the root path of our app.
 	http.Handle("/", &middlewareHandler{
 		middleware: httptrace.Middleware(localCollector, &httptrace.MiddlewareConfig{
-			RouteName:      func(r *http.Request) string { return r.URL.Path },
-			SetContextSpan: requestSpans.setRequestSpan,
+			RouteName: func(r *http.Request) string { return r.URL.Path },
 		}),
-		next: &demoApp{collector: localCollector, baseURL: demoURL, appdashURL: appdashURL},
+		next: &demoApp{baseURL: demoURL, appdashURL: appdashURL},
 	})
 	return http.ListenAndServe(c.DemoHTTPAddr, nil)
 }
@@ -110,13 +110,13 @@ func (h *middlewareHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 type demoApp struct {
-	collector  appdash.Collector
 	baseURL    *url.URL
 	appdashURL *url.URL
 }
 
 func (a *demoApp) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	span := requestSpans[r]
+	// The middleware stores the request's span in its context.
+	span, _ := appdash.SpanIDFromContext(r.Context())
 
 	switch r.URL.Path {
 	case "/":
@@ -126,20 +126,21 @@ func (a *demoApp) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 <li><a href="/api-calls">Visit a page that issues some API calls</a></li>
 </ul>`)
 	case "/api-calls":
+		// The transport has no Recorder of its own, so it records each
+		// API call as a child of the span in the request's context.
 		httpClient := &http.Client{
-			Transport: &httptrace.Transport{Recorder: appdash.NewRecorder(span, a.collector), SetName: true},
+			Transport: &httptrace.Transport{SetName: true},
 		}
-		resp, err := httpClient.Get(a.baseURL.ResolveReference(&url.URL{Path: "/endpoint-A"}).String())
-		if err == nil {
-			defer resp.Body.Close()
-		}
-		resp, err = httpClient.Get(a.baseURL.ResolveReference(&url.URL{Path: "/endpoint-B"}).String())
-		if err == nil {
-			defer resp.Body.Close()
-		}
-		resp, err = httpClient.Get(a.baseURL.ResolveReference(&url.URL{Path: "/endpoint-C"}).String())
-		if err == nil {
-			defer resp.Body.Close()
+		for _, endpoint := range []string{"/endpoint-A", "/endpoint-B", "/endpoint-C"} {
+			req, err := http.NewRequest("GET", a.baseURL.ResolveReference(&url.URL{Path: endpoint}).String(), nil)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			resp, err := httpClient.Do(req.WithContext(r.Context()))
+			if err == nil {
+				defer resp.Body.Close()
+			}
 		}
 		io.WriteString(w, `<a href="/">Home</a><br><br><p>I just made 3 API calls. Check the trace below to see them!</p>`)
 	case "/endpoint-A":
@@ -159,15 +160,3 @@ func (a *demoApp) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	spanURL := a.appdashURL.ResolveReference(&url.URL{Path: fmt.Sprintf("/traces/%v", span.Trace)})
 	io.WriteString(w, fmt.Sprintf(`<br><br><hr><a href="%s">View request trace on appdash</a> (trace ID is %s)`, spanURL, span.Trace))
 }
-
-type requestSpanMap map[*http.Request]appdash.SpanID
-
-// requestSpans stores the appdash span ID associated with each HTTP
-// request. In a real app, you would use something like
-// gorilla/context instead of a map (so that entries get removed when
-// handling is completed, etc.).
-var requestSpans = requestSpanMap{}
-
-func (m requestSpanMap) setRequestSpan(r *http.Request, spanID appdash.SpanID) {
-	m[r] = spanID
-}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/subtle"
 	"crypto/tls"
 	"encoding/base64"
@@ -11,12 +12,17 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
 	"time"
 
 	"strings"
 
 	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/otlp"
 	"sourcegraph.com/sourcegraph/appdash/traceapp"
+	"sourcegraph.com/sourcegraph/appdash/zipkin"
 )
 
 func init() {
@@ -40,16 +46,32 @@ type ServeCmd struct {
 
 	StoreFile       string        `short:"f" long:"store-file" description:"persisted store file" default:"/tmp/appdash.gob"`
 	PersistInterval time.Duration `short:"p" long:"persist-interval" description:"interval between persisting store to file" default:"2s"`
+	StoreDir        string        `long:"store-dir" description:"directory of a disk-backed store (if set, --store-file and --persist-interval are ignored)"`
 
 	Debug bool `short:"d" long:"debug" description:"debug log"`
 	Trace bool `long:"trace" description:"trace log"`
 
+	MaxConns        int           `long:"max-conns" description:"maximum number of collector connections to serve at once (0 for no limit)"`
+	MaxMessageSize  int           `long:"max-message-size" description:"maximum size in bytes of a message on a collector connection" default:"1048576"`
+	IdleTimeout     time.Duration `long:"idle-timeout" description:"time after which a collector connection that receives no messages is closed (0 to disable)"`
+	ShutdownTimeout time.Duration `long:"shutdown-timeout" description:"how long to wait for the spans being received to be collected when shutting down" default:"5s"`
+
 	DeleteAfter time.Duration `long:"delete-after" description:"delete traces after a certain age (0 to disable)" default:"30m"`
 
+	SampleRate       float64       `long:"sample-rate" description:"fraction of traces to keep, between 0 and 1" default:"1"`
+	SamplePerSecond  float64       `long:"sample-per-sec" description:"maximum number of traces per second to keep (0 for no limit)"`
+	TailSample       bool          `long:"tail-sample" description:"buffer traces and keep all failed traces, sampling only the rest with --sample-rate and --sample-per-sec"`
+	TailSampleMinDur time.Duration `long:"tail-sample-min-duration" description:"with --tail-sample, also keep all traces lasting at least this long (0 to disable)"`
+	TailSampleWait   time.Duration `long:"tail-sample-wait" description:"with --tail-sample, how long to buffer traces before deciding whether to keep them" default:"5s"`
+
 	TLSCert string `long:"tls-cert" description:"TLS certificate file (if set, enables TLS)"`
 	TLSKey  string `long:"tls-key" description:"TLS key file (if set, enables TLS)"`
 
-	BasicAuth string `long:"basic-auth" description:"if set to 'user:passwd', require HTTP Basic Auth for web app"`
+	BasicAuth    string `long:"basic-auth" description:"if set to 'user:passwd', require HTTP Basic Auth for web app"`
+	AllowChanges bool   `long:"allow-changes" description:"allow deleting and pinning traces in the web app without --basic-auth"`
+
+	Zipkin bool `long:"zipkin" description:"accept Zipkin v2 spans on the HTTP server (POST /api/v2/spans), without HTTP Basic auth"`
+	OTLP   bool `long:"otlp" description:"accept OTLP/HTTP traces on the HTTP server (POST /v1/traces), without HTTP Basic auth"`
 }
 
 var serveCmd ServeCmd
@@ -58,42 +80,58 @@ var serveCmd ServeCmd
 // if any.
 func (c *ServeCmd) Execute(args []string) error {
 	var (
-		memStore = appdash.NewMemoryStore()
-		Store    = appdash.Store(memStore)
-		Queryer  = memStore
+		deleteStore appdash.DeleteStore
+		Queryer     appdash.Queryer
+		fileStore   *appdash.FileStore
+		memStore    *appdash.MemoryStore
 	)
 
-	if c.StoreFile != "" {
-		f, err := os.Open(c.StoreFile)
-		if err != nil && !os.IsNotExist(err) {
+	if c.StoreDir != "" {
+		var err error
+		fileStore, err = appdash.OpenFileStore(c.StoreDir)
+		if err != nil {
 			return err
 		}
-		if f != nil {
-			if n, err := memStore.ReadFrom(f); err == nil {
-				log.Printf("Read %d traces from file %s", n, c.StoreFile)
-			} else if err != nil {
-				f.Close()
-				return err
-			}
-			if err := f.Close(); err != nil {
-				return err
-			}
-		}
-		if c.PersistInterval != 0 {
-			go func() {
-				if err := appdash.PersistEvery(memStore, c.PersistInterval, c.StoreFile); err != nil {
-					log.Fatal(err)
-				}
-			}()
+		defer fileStore.Close()
+		log.Printf("Using disk-backed store in %s", c.StoreDir)
+		deleteStore, Queryer = fileStore, fileStore
+	} else {
+		memStore = appdash.NewMemoryStore()
+		deleteStore, Queryer = memStore, memStore
+		if err := c.loadMemoryStore(memStore); err != nil {
+			return err
 		}
 	}
 
+	// Traces pinned in the web UI are kept until they are unpinned, also
+	// across restarts if the store is persisted.
+	pins := new(appdash.Pins)
+	if file := c.pinsFile(); file != "" {
+		var err error
+		pins, err = appdash.OpenPins(file)
+		if err != nil {
+			return err
+		}
+		if n := len(pins.List()); n > 0 {
+			log.Printf("Read %d pinned traces from file %s", n, file)
+		}
+	}
+	Store := appdash.Store(deleteStore)
 	if c.DeleteAfter > 0 {
-		Store = &appdash.RecentStore{
+		recentStore := &appdash.RecentStore{
 			MinEvictAge: c.DeleteAfter,
-			DeleteStore: memStore,
+			DeleteStore: deleteStore,
 			Debug:       true,
+			Pins:        pins,
+		}
+		if fileStore != nil {
+			// Evict the traces stored before a restart too, by their
+			// start times.
+			for id, start := range fileStore.StartTimes() {
+				recentStore.Track(id, start)
+			}
 		}
+		Store = recentStore
 	}
 
 	url, err := c.urlOrDefault()
@@ -106,6 +144,18 @@ func (c *ServeCmd) Execute(args []string) error {
 	}
 	app.Store = Store
 	app.Queryer = Queryer
+	app.Pins = pins
+	// Anyone who can reach the web app could delete traces, so only allow
+	// it behind HTTP Basic auth, unless explicitly allowed.
+	app.AllowChanges = c.BasicAuth != "" || c.AllowChanges
+
+	// Aggregate the durations of all traces, for the dashboard, before
+	// sampling the traces that are stored, so that the dashboard's
+	// statistics are not skewed by sampling. (Some of the slowest traces it
+	// lists may not be stored.)
+	sampled := c.sampleCollector(Store)
+	aggregator := appdash.NewAggregatingCollector(sampled)
+	app.Aggregator = aggregator
 
 	var h http.Handler
 	if c.BasicAuth != "" {
@@ -124,7 +174,25 @@ func (c *ServeCmd) Execute(args []string) error {
 	}
 
 	if c.SampleData {
-		sampleData(Store)
+		sampleData(aggregator)
+	}
+
+	if c.Zipkin || c.OTLP {
+		// Like the collector server, the span receivers are not behind
+		// HTTP Basic auth, as Zipkin and OpenTelemetry reporters don't
+		// support it. So that anyone who can reach the web app can't also
+		// add spans to it, they are only enabled on request.
+		mux := http.NewServeMux()
+		if c.Zipkin {
+			mux.Handle(zipkin.SpansPath, zipkin.NewHandler(aggregator))
+			log.Printf("Accepting Zipkin v2 spans at %s", zipkin.SpansPath)
+		}
+		if c.OTLP {
+			mux.Handle(otlp.TracesPath, otlp.NewHandler(aggregator))
+			log.Printf("Accepting OTLP/HTTP traces at %s", otlp.TracesPath)
+		}
+		mux.Handle("/", h)
+		h = mux
 	}
 
 	var l net.Listener
@@ -159,18 +227,149 @@ func (c *ServeCmd) Execute(args []string) error {
 		proto = "plaintext TCP (no security)"
 	}
 	log.Printf("appdash collector listening on %s (%s)", c.CollectorAddr, proto)
-	cs := appdash.NewServer(l, appdash.NewLocalCollector(Store))
+	cs := appdash.NewServer(l, aggregator)
 	cs.Debug = c.Debug
 	cs.Trace = c.Trace
+	cs.MaxConns = c.MaxConns
+	cs.MaxMessageSize = c.MaxMessageSize
+	cs.IdleTimeout = c.IdleTimeout
 	go cs.Start()
 
-	if c.TLSCert != "" || c.TLSKey != "" {
-		log.Printf("appdash HTTPS server listening on %s (TLS cert %s, key %s)", c.HTTPAddr, c.TLSCert, c.TLSKey)
-		return http.ListenAndServeTLS(c.HTTPAddr, c.TLSCert, c.TLSKey, h)
+	srv := &http.Server{Addr: c.HTTPAddr, Handler: h}
+	errc := make(chan error, 1)
+	go func() {
+		if c.TLSCert != "" || c.TLSKey != "" {
+			log.Printf("appdash HTTPS server listening on %s (TLS cert %s, key %s)", c.HTTPAddr, c.TLSCert, c.TLSKey)
+			errc <- srv.ListenAndServeTLS(c.TLSCert, c.TLSKey)
+			return
+		}
+		log.Printf("appdash HTTP server listening on %s", c.HTTPAddr)
+		errc <- srv.ListenAndServe()
+	}()
+
+	// Serve until interrupted, and then collect the spans being received
+	// and those buffered for tail sampling, and persist the store, before
+	// exiting.
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	select {
+	case err := <-errc:
+		return err
+	case <-sig:
 	}
+	log.Println("Shutting down...")
+	ctx, cancel := context.WithTimeout(context.Background(), c.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("Shutdown: %s", err)
+	}
+	if err := cs.Shutdown(ctx); err != nil {
+		log.Printf("Shutdown: %s", err)
+	}
+	if tc, ok := sampled.(*appdash.TailSamplingCollector); ok {
+		if err := tc.Stop(); err != nil {
+			log.Printf("Stop: %s", err)
+		}
+	}
+	if memStore != nil && c.StoreFile != "" && c.PersistInterval != 0 {
+		if err := appdash.Persist(memStore, c.StoreFile); err != nil {
+			return err
+		}
+		log.Printf("Persisted the store to file %s", c.StoreFile)
+	}
+	return nil
+}
+
+// sampleCollector wraps the collector in a SamplingCollector or a
+// TailSamplingCollector, according to the sampling flags.
+func (c *ServeCmd) sampleCollector(collector appdash.Collector) appdash.Collector {
+	sampler := newSampler(c.SampleRate, c.SamplePerSecond)
+
+	if c.TailSample {
+		log.Printf("Tail sampling traces (keeping failed traces and those lasting at least %s)", c.TailSampleMinDur)
+		tc := appdash.NewTailSamplingCollector(collector, c.TailSampleMinDur)
+		tc.Wait = c.TailSampleWait
+		if sampler == nil {
+			// A nil Sampler drops the healthy traces, but the sampling
+			// flags (--sample-rate=1 by default) ask to keep them all.
+			sampler = appdash.ProbabilisticSampler(1)
+		}
+		tc.Sampler = sampler
+		return tc
+	}
+	if sampler != nil {
+		log.Printf("Sampling traces (--sample-rate=%g, --sample-per-sec=%g)", c.SampleRate, c.SamplePerSecond)
+		return appdash.NewSamplingCollector(collector, sampler)
+	}
+	return collector
+}
+
+// newSampler returns a Sampler that keeps the given fraction of traces, and
+// at most perSecond traces per second (if non-zero), or nil if it would keep
+// all traces.
+func newSampler(rate, perSecond float64) appdash.Sampler {
+	var samplers []appdash.Sampler
+	if rate < 1 {
+		samplers = append(samplers, appdash.ProbabilisticSampler(rate))
+	}
+	if perSecond > 0 {
+		samplers = append(samplers, appdash.NewRateLimitingSampler(perSecond))
+	}
+	if len(samplers) == 0 {
+		return nil
+	}
+	return appdash.SamplerFunc(func(span appdash.SpanID, name string) bool {
+		for _, s := range samplers {
+			if !s.Sample(span, name) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// pinsFile returns the file to persist the pinned traces in, next to the
+// store: in c.StoreDir, or beside c.StoreFile. It returns "" if the store is
+// not persisted.
+func (c *ServeCmd) pinsFile() string {
+	switch {
+	case c.StoreDir != "":
+		return filepath.Join(c.StoreDir, "pins")
+	case c.StoreFile != "":
+		return c.StoreFile + ".pins"
+	}
+	return ""
+}
 
-	log.Printf("appdash HTTP server listening on %s", c.HTTPAddr)
-	return http.ListenAndServe(c.HTTPAddr, h)
+// loadMemoryStore reads the memory store's traces from c.StoreFile, if set,
+// and starts persisting it there every c.PersistInterval.
+func (c *ServeCmd) loadMemoryStore(memStore *appdash.MemoryStore) error {
+	if c.StoreFile == "" {
+		return nil
+	}
+	f, err := os.Open(c.StoreFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if f != nil {
+		if n, err := memStore.ReadFrom(f); err == nil {
+			log.Printf("Read %d traces from file %s", n, c.StoreFile)
+		} else if err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+	if c.PersistInterval != 0 {
+		go func() {
+			if err := appdash.PersistEvery(memStore, c.PersistInterval, c.StoreFile); err != nil {
+				log.Fatal(err)
+			}
+		}()
+	}
+	return nil
 }
 
 // urlOrDefault returns c.URL if non-empty, otherwise it returns c.HTTPAddr
@@ -1,7 +1,10 @@
 package appdash
 
 import (
+	"bufio"
+	"context"
 	"crypto/tls"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
@@ -9,9 +12,11 @@ import (
 	"net"
 	"os"
 	"sync"
+	"syscall"
 	"time"
 
 	pio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
 	"sourcegraph.com/sourcegraph/appdash/internal/wire"
 )
 
@@ -70,8 +75,9 @@ var ErrQueueDropped = errors.New("ChunkedCollector queue entirely dropped (trace
 //      added to the queue.
 //  - After MinInterval (or if Flush is called manually), all queued collections
 //    are passed off to the underlying collector. If the overall Flush time
-//    measured after each underlying Collect call exceeds FlushTimeout, the
-//    pending queue is entirely dropped and ErrQueueDropped is returned.
+//    measured after each underlying Collect (or CollectBatch) call exceeds
+//    FlushTimeout, the pending queue is entirely dropped and ErrQueueDropped
+//    is returned.
 //  - If the queue has been entirely dropped as a result of one of the above
 //    cases, entire traces and/or parts of their data will be missing. For this
 //    reason, you may specify a Log for debugging purposes.
@@ -203,7 +209,8 @@ func (cc *ChunkedCollector) Collect(span SpanID, anns ...Annotation) error {
 }
 
 // Flush immediately sends all pending spans to the underlying
-// collector.
+// collector, in batches of up to flushBatchSize spans if it is a
+// BatchCollector.
 func (cc *ChunkedCollector) Flush() error {
 	start := time.Now()
 
@@ -219,18 +226,46 @@ func (cc *ChunkedCollector) Flush() error {
 	}
 
 	var errs []error
+	timedOut := func() bool {
+		if cc.FlushTimeout == 0 || time.Since(start) <= cc.FlushTimeout {
+			return false
+		}
+		cc.mu.Lock()
+		if cc.Log != nil {
+			cc.Log.Println("ChunkedCollector: queue entirely dropped (trace data will be missing)")
+			cc.Log.Printf("ChunkedCollector: queueSize:%v queueSizeBytes:%v\n", len(pendingBySpanID), queueSizeBytes)
+		}
+		cc.mu.Unlock()
+		errs = append(errs, ErrQueueDropped)
+		return true
+	}
+	if bc, ok := cc.Collector.(BatchCollector); ok {
+		// Send the spans in batches. This is done even if there are none,
+		// so that a RemoteCollector sends any spans it has spooled.
+		spans := make([]Span, 0, len(pendingBySpanID))
+		for spanID, p := range pendingBySpanID {
+			spans = append(spans, Span{ID: spanID, Annotations: p})
+		}
+		for {
+			n := len(spans)
+			if n > flushBatchSize {
+				n = flushBatchSize
+			}
+			if err := bc.CollectBatch(spans[:n]); err != nil {
+				errs = append(errs, err)
+			}
+			spans = spans[n:]
+			if len(spans) == 0 || timedOut() {
+				break
+			}
+		}
+		pendingBySpanID = nil
+	}
 	for spanID, p := range pendingBySpanID {
 		if err := cc.Collector.Collect(spanID, p...); err != nil {
 			errs = append(errs, err)
 		}
-		if cc.FlushTimeout != 0 && time.Since(start) > cc.FlushTimeout {
-			cc.mu.Lock()
-			if cc.Log != nil {
-				cc.Log.Println("ChunkedCollector: queue entirely dropped (trace data will be missing)")
-				cc.Log.Printf("ChunkedCollector: queueSize:%v queueSizeBytes:%v\n", len(pendingBySpanID), queueSizeBytes)
-			}
-			cc.mu.Unlock()
-			errs = append(errs, ErrQueueDropped)
+		if timedOut() {
 			break
 		}
 	}
@@ -243,6 +278,11 @@ func (cc *ChunkedCollector) Flush() error {
 	return nil
 }
 
+// flushBatchSize is the maximum number of spans that ChunkedCollector.Flush
+// passes to a BatchCollector at once, so that it can stop once FlushTimeout
+// elapses.
+const flushBatchSize = 1000
+
 func (cc *ChunkedCollector) start() {
 	cc.stopChan = make(chan struct{})
 	cc.started = true
@@ -264,11 +304,15 @@ func (cc *ChunkedCollector) start() {
 }
 
 // Stop stops the collector. After stopping, no more data will be sent
-// to the underlying collector and calls to Collect will fail.
+// to the underlying collector (except by calling Flush) and calls to
+// Collect will fail. It may be called before the first call to Collect,
+// and more than once.
 func (cc *ChunkedCollector) Stop() {
 	cc.mu.Lock()
 	defer cc.mu.Unlock()
-	close(cc.stopChan)
+	if cc.started && !cc.stopped {
+		close(cc.stopChan)
+	}
 	cc.stopped = true
 }
 
@@ -277,22 +321,22 @@ func (cc *ChunkedCollector) Stop() {
 // immediately when Collect is called. To send data in chunks, use a
 // ChunkedCollector.
 func NewRemoteCollector(addr string) *RemoteCollector {
-	return &RemoteCollector{
-		addr: addr,
-		dial: func() (net.Conn, error) {
-			return net.Dial("tcp", addr)
-		},
+	rc := &RemoteCollector{addr: addr}
+	rc.dial = func() (net.Conn, error) {
+		d := net.Dialer{Timeout: rc.DialTimeout}
+		return d.Dial("tcp", addr)
 	}
+	return rc
 }
 
 // NewTLSRemoteCollector creates a RemoteCollector that uses TLS.
 func NewTLSRemoteCollector(addr string, tlsConfig *tls.Config) *RemoteCollector {
-	return &RemoteCollector{
-		addr: addr,
-		dial: func() (net.Conn, error) {
-			return tls.Dial("tcp", addr, tlsConfig)
-		},
+	rc := &RemoteCollector{addr: addr}
+	rc.dial = func() (net.Conn, error) {
+		d := &net.Dialer{Timeout: rc.DialTimeout}
+		return tls.DialWithDialer(d, "tcp", addr, tlsConfig)
 	}
+	return rc
 }
 
 // A RemoteCollector sends data to a collector server (created with
@@ -302,8 +346,18 @@ type RemoteCollector struct {
 
 	dial func() (net.Conn, error)
 
-	mu    sync.Mutex      // guards pconn
-	pconn pio.WriteCloser // delimited-protobuf remote connection
+	mu          sync.Mutex      // guards the fields below
+	conn        net.Conn        // remote connection
+	pconn       pio.WriteCloser // delimited-protobuf writer of conn
+	preader     pio.ReadCloser  // delimited-protobuf reader of conn (version 2)
+	version     int             // protocol version of conn
+	compression string          // compression of conn's batches (version 2)
+	seq         uint64          // sequence of the last batch sent
+
+	// unacked are the batches sent on conn whose acks are unread, oldest
+	// first, and resend are those of a previous connection, to be sent
+	// again before any newer batches.
+	unacked, resend [][]*wire.CollectPacket
 
 	// Log is the logger to use for errors and warnings. If nil, a new
 	// logger is created.
@@ -312,64 +366,375 @@ type RemoteCollector struct {
 
 	// Debug is whether to log debug messages.
 	Debug bool
+
+	// Resource, if non-nil, identifies the service that records the spans.
+	// It is sent with the annotations of each span that doesn't already
+	// have a resource (see NewResourceCollector).
+	Resource *Resource
+
+	// ProtocolVersion is the version of the wire protocol to use. In version
+	// 2, spans are sent in batches, and CollectBatch returns once the server
+	// has acknowledged collecting them. Collect doesn't wait for the server,
+	// unless rc has a Spool, so as not to delay the operations that record
+	// spans. In version 1, both return once the spans are written to the
+	// connection, and so spans are lost silently if it fails afterwards.
+	// Servers older than version 2 only support version 1.
+	//
+	// If zero, version 2 is used, or version 1 if the server doesn't support
+	// version 2 (which is checked again on each new connection, in case the
+	// server has been upgraded).
+	ProtocolVersion int
+
+	// Compression is the compression to compress batches of spans with, if
+	// the server supports it: "gzip", or "" for none. It requires version 2
+	// of the protocol.
+	Compression string
+
+	// AckTimeout, if non-zero, is how long to wait for the server to
+	// acknowledge a batch of spans (or to reply to the handshake of version
+	// 2) before giving up on the connection.
+	//
+	// Default AckTimeout = 10 * time.Second.
+	AckTimeout time.Duration
+
+	// DialTimeout, if non-zero, is how long to wait for a connection to the
+	// server (and its TLS handshake) to be established.
+	DialTimeout time.Duration
+
+	// Spool, if non-nil, is where spans are kept while they can't be sent
+	// to the server (e.g. while it is restarting), to be sent once they can,
+	// before any newer spans. Collect and CollectBatch then return nil once
+	// spans are sent or spooled. Spooled spans are only safe from being
+	// lost with version 2 of the protocol, in which the server acknowledges
+	// them.
+	Spool *Spool
 }
 
 // Collect implements the Collector interface by sending the events that
 // occured in the span to the remote collector server (see CollectorServer).
+// Unless rc has a Spool, it doesn't wait for the server to acknowledge them.
 func (rc *RemoteCollector) Collect(span SpanID, anns ...Annotation) error {
-	return rc.collectAndRetry(newCollectPacket(span, anns))
+	return rc.collectSpans([]Span{{ID: span, Annotations: anns}}, rc.Spool != nil)
 }
 
-// connect makes a connection to the collector server. It must be
-// called with rc.mu held.
-func (rc *RemoteCollector) connect() error {
-	if rc.pconn != nil {
-		rc.pconn.Close()
-		rc.pconn = nil
+// CollectBatch implements the BatchCollector interface by sending the spans
+// to the remote collector server in as few batches as possible. In version 2
+// of the protocol, it returns nil only once the server has acknowledged
+// collecting all of them.
+//
+// If rc has a Spool, the spans in it are sent first, and the spans that
+// can't be sent are added to it. Calling CollectBatch with no spans sends
+// the spans in the spool.
+func (rc *RemoteCollector) CollectBatch(spans []Span) error {
+	return rc.collectSpans(spans, true)
+}
+
+// collectSpans sends the spans, waiting for the server to acknowledge them
+// if ack is true.
+func (rc *RemoteCollector) collectSpans(spans []Span, ack bool) error {
+	packets := make([]*wire.CollectPacket, len(spans))
+	for i, s := range spans {
+		packets[i] = newCollectPacket(s.ID, rc.Resource.annotate(s.Annotations))
+	}
+	batches, err := splitBatch(packets)
+	if err != nil {
+		return err
 	}
 
-	c, err := rc.dial()
-	if err == nil {
-		// Create a protobuf delimited writer wrapping the connection. When the
-		// writer is closed, it also closes the underlying connection (see
-		// source code for details).
-		rc.pconn = pio.NewDelimitedWriter(c)
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	send := func(batch []*wire.CollectPacket) error {
+		return rc.collectAndRetry(batch, ack)
 	}
-	return err
+	if rc.Spool != nil {
+		return rc.Spool.collect(batches, send)
+	}
+	for _, b := range batches {
+		if err := send(b); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-// Close closes the connection to the server.
-func (rc *RemoteCollector) Close() error {
+// sendBatch sends the batch of packets, whose annotations already include
+// those of the resource, to the server.
+func (rc *RemoteCollector) sendBatch(batch []*wire.CollectPacket) error {
 	rc.mu.Lock()
 	defer rc.mu.Unlock()
+	return rc.collectAndRetry(batch, true)
+}
 
-	if rc.pconn != nil {
-		err := rc.pconn.Close()
-		rc.pconn = nil
+// connect makes a connection to the collector server, and performs the
+// handshake of version 2 of the protocol unless only version 1 is to be
+// used. It must be called with rc.mu held.
+func (rc *RemoteCollector) connect() error {
+	if err := rc.dialConn(); err != nil || rc.ProtocolVersion == 1 {
+		return err
+	}
+
+	err := rc.handshake()
+	if err == nil {
+		return nil
+	}
+	rc.closeConn()
+	if rc.ProtocolVersion != 0 || !closedByServer(err) {
+		return err
+	}
+	// Servers that only support version 1 close the connection when they
+	// read the preamble.
+	rc.log().Printf("Server closed the connection in the handshake (%s), falling back to protocol version 1", err)
+	return rc.dialConn()
+}
+
+// dialConn makes a connection to the collector server, to be used with
+// version 1 of the protocol unless a handshake follows. It must be called
+// with rc.mu held.
+func (rc *RemoteCollector) dialConn() error {
+	rc.closeConn()
+
+	c, err := rc.dial()
+	if err != nil {
+		return err
+	}
+	// Create a protobuf delimited writer wrapping the connection. When the
+	// writer is closed, it also closes the underlying connection (see
+	// source code for details).
+	rc.conn = c
+	rc.pconn = pio.NewDelimitedWriter(c)
+	rc.version = 1
+	return nil
+}
+
+// closedByServer reports whether err is that of a connection that the
+// server closed.
+func closedByServer(err error) bool {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	if oe, ok := err.(*net.OpError); ok {
+		if se, ok := oe.Err.(*os.SyscallError); ok {
+			return se.Err == syscall.ECONNRESET || se.Err == syscall.EPIPE
+		}
+	}
+	return false
+}
+
+// handshake performs the handshake of version 2 of the protocol on a new
+// connection. It must be called with rc.mu held.
+func (rc *RemoteCollector) handshake() error {
+	if _, err := io.WriteString(rc.conn, protocolPreamble); err != nil {
+		return err
+	}
+	if err := rc.pconn.WriteMsg(newHello(rc.Resource, rc.Compression)); err != nil {
+		return err
+	}
+	rc.preader = pio.NewDelimitedReader(rc.conn, maxMessageSize)
+	var ack wire.HelloAck
+	if err := rc.readMsg(&ack); err != nil {
 		return err
 	}
+	if v := ack.GetVersion(); v != protocolVersion {
+		return fmt.Errorf("server chose unsupported protocol version %d", v)
+	}
+	rc.version = protocolVersion
+	rc.compression = ack.GetCompression()
+	if rc.Debug {
+		rc.log().Printf("Using protocol version %d with compression %q", rc.version, rc.compression)
+	}
 	return nil
 }
 
-func (rc *RemoteCollector) collectAndRetry(p *wire.CollectPacket) error {
+// readMsg reads a message from the server, waiting no longer than
+// AckTimeout. It must be called with rc.mu held.
+func (rc *RemoteCollector) readMsg(m proto.Message) error {
+	timeout := rc.AckTimeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	if err := rc.conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	return rc.preader.ReadMsg(m)
+}
+
+// closeConn closes the connection to the server, if any. It must be called
+// with rc.mu held.
+func (rc *RemoteCollector) closeConn() error {
+	if rc.pconn == nil {
+		return nil
+	}
+	err := rc.pconn.Close()
+	rc.conn, rc.pconn, rc.preader = nil, nil, nil
+	// The server may not have collected the batches it didn't acknowledge.
+	rc.resend = append(rc.resend, rc.unacked...)
+	rc.unacked = nil
+	return err
+}
+
+// Close closes the connection to the server, once the server has
+// acknowledged the spans sent by Collect. If the connection fails before
+// then, Close reconnects once to send the unacknowledged spans again.
+func (rc *RemoteCollector) Close() error {
 	rc.mu.Lock()
 	defer rc.mu.Unlock()
+	var err error
+	if rc.pconn != nil {
+		err = rc.readAcks(0)
+	}
+	if err2 := rc.closeConn(); err == nil {
+		err = err2
+	}
+	if len(rc.resend) > 0 {
+		if err = rc.connect(); err == nil {
+			err = rc.resendUnacked()
+		}
+		if err2 := rc.closeConn(); err == nil {
+			err = err2
+		}
+		if n := len(rc.resend); n > 0 {
+			rc.log().Printf("Abandoned %d unacknowledged batches", n)
+			rc.resend = nil
+		}
+	}
+	return err
+}
 
+// collectAndRetry sends the batch of packets, reconnecting and sending it
+// again once if that fails. It must be called with rc.mu held.
+func (rc *RemoteCollector) collectAndRetry(batch []*wire.CollectPacket, ack bool) error {
 	if rc.pconn != nil {
-		if err := rc.collect(p); err == nil {
+		err := rc.resendUnacked()
+		if err == nil {
+			err = rc.collect(batch, ack)
+		}
+		if err == nil {
 			return nil
 		}
+		if _, ok := err.(*ackError); ok {
+			return err // the server rejected the batch; it would again
+		}
 		if rc.Debug {
-			rc.log().Printf("Reconnecting to send %v", spanIDFromWire(p.Spanid))
+			rc.log().Printf("Reconnecting to send %v (%s)", spanIDFromWire(batch[0].Spanid), err)
 		}
 	}
 	if err := rc.connect(); err != nil {
 		return err
 	}
-	return rc.collect(p)
+	if err := rc.resendUnacked(); err != nil {
+		return err
+	}
+	return rc.collect(batch, ack)
+}
+
+// resendUnacked sends the batches that the server didn't acknowledge on a
+// previous connection, waiting for it to acknowledge each of them. Some of
+// them may have been collected already, and so be collected twice. It must
+// be called with rc.mu held.
+func (rc *RemoteCollector) resendUnacked() error {
+	if len(rc.resend) > 0 {
+		rc.log().Printf("Resending %d unacknowledged batches", len(rc.resend))
+	}
+	for len(rc.resend) > 0 {
+		err := rc.collect(rc.resend[0], true)
+		if e, ok := err.(*ackError); ok {
+			rc.log().Printf("Resent batch failed: %s", e)
+		} else if err != nil {
+			return err
+		}
+		rc.resend = rc.resend[1:]
+	}
+	rc.resend = nil
+	return nil
+}
+
+// collect sends the batch of packets, waiting for the server to acknowledge
+// it if ack is true (in version 2 of the protocol). It must be called with
+// rc.mu held.
+func (rc *RemoteCollector) collect(batch []*wire.CollectPacket, ack bool) error {
+	if rc.version == 1 {
+		for _, p := range batch {
+			if err := rc.collectV1(p); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if ack {
+		// Read the acks of the batches sent before, so that the next ack
+		// read is this batch's.
+		if err := rc.readAcks(0); err != nil {
+			return err
+		}
+	}
+	rc.seq++
+	b, err := encodeBatch(rc.seq, batch, rc.compression)
+	if err != nil {
+		return err
+	}
+	if rc.Debug {
+		rc.log().Printf("Sending batch %d of %d spans", rc.seq, len(batch))
+	}
+	if err := rc.pconn.WriteMsg(b); err != nil {
+		return err
+	}
+	if !ack {
+		rc.unacked = append(rc.unacked, batch)
+		// Don't let the acks pile up, which would eventually block the
+		// server from reading further batches.
+		return rc.readAcks(maxUnackedBatches)
+	}
+	if err := rc.readAck(rc.seq); err != nil {
+		return err
+	}
+	if rc.Debug {
+		rc.log().Printf("Sent batch %d", rc.seq)
+	}
+	return nil
+}
+
+// maxUnackedBatches is the number of batches that a RemoteCollector sends
+// without waiting for the server to acknowledge them, before it waits for
+// the acks of the oldest.
+const maxUnackedBatches = 64
+
+// readAcks reads the acks of the batches sent without waiting for them,
+// until at most n remain unread. The errors of the batches that the server
+// failed to collect are logged, as their spans were collected long ago.
+// It must be called with rc.mu held.
+func (rc *RemoteCollector) readAcks(n int) error {
+	for len(rc.unacked) > n {
+		seq := rc.seq - uint64(len(rc.unacked)) + 1
+		err := rc.readAck(seq)
+		if e, ok := err.(*ackError); ok {
+			rc.log().Printf("Batch %d failed: %s", seq, e)
+		} else if err != nil {
+			return err
+		}
+		rc.unacked = rc.unacked[1:]
+	}
+	return nil
+}
+
+// readAck reads the ack of the batch with the given sequence number. It
+// must be called with rc.mu held.
+func (rc *RemoteCollector) readAck(seq uint64) error {
+	var ack wire.Ack
+	if err := rc.readMsg(&ack); err != nil {
+		return err
+	}
+	if ack.GetSequence() != seq {
+		return fmt.Errorf("server acknowledged batch %d, want %d", ack.GetSequence(), seq)
+	}
+	if msg := ack.GetError(); msg != "" {
+		return &ackError{msg: msg}
+	}
+	return nil
 }
 
-func (rc *RemoteCollector) collect(p *wire.CollectPacket) error {
+// collectV1 sends a packet in version 1 of the protocol.
+func (rc *RemoteCollector) collectV1(p *wire.CollectPacket) error {
 	if rc.Debug {
 		rc.log().Printf("Sending %v", spanIDFromWire(p.Spanid))
 	}
@@ -385,6 +750,15 @@ func (rc *RemoteCollector) collect(p *wire.CollectPacket) error {
 	return nil
 }
 
+// ackError is the error of a batch that the server failed to collect.
+type ackError struct {
+	msg string
+}
+
+func (e *ackError) Error() string {
+	return "collector server: " + e.msg
+}
+
 func (rc *RemoteCollector) log() *log.Logger {
 	rc.logMu.Lock()
 	defer rc.logMu.Unlock()
@@ -400,7 +774,13 @@ func (rc *RemoteCollector) log() *log.Logger {
 // Call the CollectorServer's Start method to start listening and
 // serving.
 func NewServer(l net.Listener, c Collector) *CollectorServer {
-	cs := &CollectorServer{c: c, l: l}
+	cs := &CollectorServer{
+		c:              c,
+		l:              l,
+		MaxMessageSize: maxMessageSize,
+		ReadTimeout:    30 * time.Second,
+		WriteTimeout:   30 * time.Second,
+	}
 	return cs
 }
 
@@ -420,22 +800,191 @@ type CollectorServer struct {
 
 	// Trace is whether to log all data that is received.
 	Trace bool
+
+	// MaxConns, if non-zero, is the maximum number of connections to serve
+	// at once. Once it is reached, new connections wait to be accepted
+	// until one of the others is closed.
+	MaxConns int
+
+	// MaxMessageSize is the maximum size in bytes of a message from a
+	// client (and of a batch of spans, once decompressed). Clients that
+	// send larger messages are disconnected. RemoteCollector sends batches
+	// of up to 1 MB.
+	//
+	// Default MaxMessageSize = 1024 * 1024 (1 MB).
+	MaxMessageSize int
+
+	// ReadTimeout, if non-zero, is the time after which a connection is
+	// closed if the message being received on it is not complete.
+	//
+	// Default ReadTimeout = 30 * time.Second.
+	ReadTimeout time.Duration
+
+	// WriteTimeout, if non-zero, is the time after which a connection is
+	// closed if a reply to the client (in version 2 of the protocol) can't
+	// be sent.
+	//
+	// Default WriteTimeout = 30 * time.Second.
+	WriteTimeout time.Duration
+
+	// IdleTimeout, if non-zero, is the time after which a connection on
+	// which no message is received is closed. Clients of version 1 of the
+	// protocol may lose the spans they send as it is closed.
+	IdleTimeout time.Duration
+
+	mu       sync.Mutex        // guards the fields below
+	conns    map[net.Conn]bool // open connections, and whether they are idle
+	shutdown bool              // whether Shutdown or Close was called
+	wg       sync.WaitGroup    // counts the open connections
 }
 
-// Start starts the server.
+// Start starts the server. It returns once the server is shut down (see
+// Shutdown), or its listener fails (for example, because it was closed).
+// Temporary errors accepting connections are retried, after a delay.
 func (cs *CollectorServer) Start() {
+	var sem chan struct{}
+	if cs.MaxConns > 0 {
+		sem = make(chan struct{}, cs.MaxConns)
+	}
+	var delay time.Duration // how long to wait after a temporary error
 	for {
+		if sem != nil {
+			select {
+			case sem <- struct{}{}:
+			default:
+				if cs.Debug {
+					cs.log().Printf("Waiting for one of %d connections to close", cs.MaxConns)
+				}
+				sem <- struct{}{}
+			}
+		}
 		conn, err := cs.l.Accept()
 		if err != nil {
+			if sem != nil {
+				<-sem
+			}
+			if cs.shuttingDown() {
+				return
+			}
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				if delay == 0 {
+					delay = 5 * time.Millisecond
+				} else if delay *= 2; delay > time.Second {
+					delay = time.Second
+				}
+				cs.log().Printf("Accept: %s; retrying in %s", err, delay)
+				time.Sleep(delay)
+				continue
+			}
 			cs.log().Printf("Accept: %s", err)
-			continue
+			return
 		}
+		delay = 0
 
+		if !cs.trackConn(conn) {
+			conn.Close() // shut down since Accept returned
+			return
+		}
 		if cs.Debug {
 			cs.log().Printf("Client %s connected", conn.RemoteAddr())
 		}
 
-		go cs.handleConn(conn)
+		go func() {
+			cs.handleConn(conn)
+			cs.untrackConn(conn)
+			if sem != nil {
+				<-sem
+			}
+		}()
+	}
+}
+
+// trackConn adds conn to the open connections, unless the server is shut
+// down.
+func (cs *CollectorServer) trackConn(conn net.Conn) bool {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if cs.shutdown {
+		return false
+	}
+	if cs.conns == nil {
+		cs.conns = make(map[net.Conn]bool)
+	}
+	cs.conns[conn] = false
+	cs.wg.Add(1)
+	return true
+}
+
+func (cs *CollectorServer) untrackConn(conn net.Conn) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	delete(cs.conns, conn)
+	cs.wg.Done()
+}
+
+func (cs *CollectorServer) shuttingDown() bool {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	return cs.shutdown
+}
+
+// Shutdown shuts the server down gracefully. It stops accepting
+// connections, waits for the messages being received on open connections to
+// be collected and closes them, and then flushes the collector, if it has a
+// Flush method (such as ChunkedCollector). If ctx is done before the
+// connections are closed, Shutdown closes them immediately and returns the
+// context's error, without flushing the collector.
+func (cs *CollectorServer) Shutdown(ctx context.Context) error {
+	cs.mu.Lock()
+	cs.shutdown = true
+	err := cs.l.Close()
+	for conn, idle := range cs.conns {
+		if idle {
+			// Stop waiting for the next message.
+			conn.SetReadDeadline(time.Now())
+		}
+	}
+	cs.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		cs.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		cs.closeConns()
+		return ctx.Err()
+	}
+
+	if f, ok := cs.c.(interface {
+		Flush() error
+	}); ok {
+		if err := f.Flush(); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// Close closes the server's listener and all of its connections
+// immediately. The messages being received on them are lost (and, in
+// version 2 of the protocol, sent again by the clients).
+func (cs *CollectorServer) Close() error {
+	cs.mu.Lock()
+	cs.shutdown = true
+	err := cs.l.Close()
+	cs.mu.Unlock()
+	cs.closeConns()
+	return err
+}
+
+func (cs *CollectorServer) closeConns() {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	for conn := range cs.conns {
+		conn.Close()
 	}
 }
 
@@ -447,31 +996,213 @@ func (cs *CollectorServer) handleConn(conn net.Conn) (err error) {
 	}()
 	defer conn.Close()
 
-	rdr := pio.NewDelimitedReader(conn, maxMessageSize)
-	defer rdr.Close()
+	// Connections of version 2 of the protocol begin with a zero byte, which
+	// those of version 1 never do.
+	br := bufio.NewReader(conn)
+	if err := cs.waitForMsg(conn, br); err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if b, err := br.Peek(1); err == nil && b[0] == protocolPreamble[0] {
+		return cs.handleConnV2(conn, br)
+	}
+
 	for {
 		p := &wire.CollectPacket{}
-		if err = rdr.ReadMsg(p); err != nil {
+		if err = cs.readMsg(conn, br, p); err != nil {
 			if err == io.EOF {
 				return nil
 			}
 			return fmt.Errorf("ReadMsg: %s", err)
 		}
+		if err = cs.collect(conn, p); err != nil {
+			return err
+		}
+	}
+}
+
+// handleConnV2 handles a connection of version 2 of the protocol, whose
+// data is read from br.
+func (cs *CollectorServer) handleConnV2(conn net.Conn, br *bufio.Reader) error {
+	if err := cs.setDeadline(conn, false); err != nil {
+		return err
+	}
+	preamble := make([]byte, len(protocolPreamble))
+	if _, err := io.ReadFull(br, preamble); err != nil {
+		return fmt.Errorf("reading preamble: %s", err)
+	}
+	if string(preamble) != protocolPreamble {
+		return fmt.Errorf("invalid preamble %q", preamble)
+	}
+	wtr := pio.NewDelimitedWriter(conn)
+
+	var hello wire.Hello
+	if err := cs.readMsg(conn, br, &hello); err != nil {
+		return fmt.Errorf("ReadMsg: %s", err)
+	}
+	if hello.GetVersion() < protocolVersion {
+		return fmt.Errorf("unsupported protocol version %d", hello.GetVersion())
+	}
+	ack := &wire.HelloAck{Version: proto.Uint32(protocolVersion)}
+	for _, c := range hello.Compression {
+		if c == gzipCompression {
+			ack.Compression = proto.String(c)
+			break
+		}
+	}
+	if cs.Debug {
+		md := make(map[string]string, len(hello.Metadata))
+		for _, m := range hello.Metadata {
+			md[m.GetKey()] = m.GetValue()
+		}
+		cs.log().Printf("Client %s: %s on %s (pid %d), protocol version %d, compression %q, metadata %v", conn.RemoteAddr(), hello.GetClient(), hello.GetHostname(), hello.GetPid(), protocolVersion, ack.GetCompression(), md)
+	}
+	if err := cs.writeMsg(conn, wtr, ack); err != nil {
+		return fmt.Errorf("WriteMsg: %s", err)
+	}
 
-		spanID := spanIDFromWire(p.Spanid)
+	for {
+		var b wire.Batch
+		if err := cs.readMsg(conn, br, &b); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("ReadMsg: %s", err)
+		}
+		packets, err := decodeBatch(&b, cs.MaxMessageSize)
+		if err != nil {
+			return err
+		}
 		if cs.Debug || cs.Trace {
-			cs.log().Printf("Client %s: received span %v with %d annotations", conn.RemoteAddr(), spanID, len(p.Annotation))
+			cs.log().Printf("Client %s: received batch %d of %d spans", conn.RemoteAddr(), b.GetSequence(), len(packets))
 		}
-		if cs.Trace {
-			for i, ann := range p.Annotation {
-				cs.log().Printf("Client %s: span %v: annotation %d: %s=%q", conn.RemoteAddr(), p.Spanid.Span, i, *ann.Key, ann.Value)
+
+		// Acknowledge the batch once it is collected, or with the error
+		// that stopped it from being collected. The client may then send
+		// it again, and so the packets of the batch that were collected
+		// before the error may be collected twice.
+		ack := &wire.Ack{Sequence: b.Sequence}
+		for _, p := range packets {
+			if err := cs.collect(conn, p); err != nil {
+				cs.log().Printf("Client %s: batch %d: %s", conn.RemoteAddr(), b.GetSequence(), err)
+				ack.Error = proto.String(err.Error())
+				break
 			}
 		}
+		if err := cs.writeMsg(conn, wtr, ack); err != nil {
+			return fmt.Errorf("WriteMsg: %s", err)
+		}
+	}
+}
+
+// waitForMsg waits for the next message on conn, whose data is read from
+// br, for no longer than IdleTimeout. It returns io.EOF if the connection
+// is closed, idle for too long, or the server is shut down first.
+func (cs *CollectorServer) waitForMsg(conn net.Conn, br *bufio.Reader) error {
+	if br.Buffered() > 0 {
+		return nil
+	}
+	if err := cs.setDeadline(conn, true); err != nil {
+		return err
+	}
+	_, err := br.Peek(1)
+
+	cs.mu.Lock()
+	cs.conns[conn] = false
+	shutdown := cs.shutdown
+	cs.mu.Unlock()
+	if err == nil {
+		return nil
+	}
+	if shutdown {
+		return io.EOF
+	}
+	if ne, ok := err.(net.Error); ok && ne.Timeout() {
+		if cs.Debug {
+			cs.log().Printf("Client %s: closing idle connection", conn.RemoteAddr())
+		}
+		return io.EOF
+	}
+	return err
+}
+
+// setDeadline sets the read deadline of conn to wait for the next message
+// if idle, or to read the rest of a message if not, and records whether
+// conn is idle. It returns io.EOF if conn is to wait while the server is
+// shut down.
+func (cs *CollectorServer) setDeadline(conn net.Conn, idle bool) error {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	if idle && cs.shutdown {
+		return io.EOF
+	}
+	cs.conns[conn] = idle
+	timeout := cs.ReadTimeout
+	if idle {
+		timeout = cs.IdleTimeout
+	}
+	var deadline time.Time
+	if timeout != 0 {
+		deadline = time.Now().Add(timeout)
+	}
+	return conn.SetReadDeadline(deadline)
+}
+
+// readMsg reads a delimited message from conn, whose data is read from br.
+// It returns io.EOF if there is no next message (see waitForMsg), and an
+// error if the message is larger than MaxMessageSize or takes longer than
+// ReadTimeout to receive.
+func (cs *CollectorServer) readMsg(conn net.Conn, br *bufio.Reader, m proto.Message) error {
+	if err := cs.waitForMsg(conn, br); err != nil {
+		return err
+	}
+	if err := cs.setDeadline(conn, false); err != nil {
+		return err
+	}
+	size, err := binary.ReadUvarint(br)
+	if err != nil {
+		return err
+	}
+	if size > uint64(cs.MaxMessageSize) {
+		return fmt.Errorf("message of %d bytes is larger than the limit of %d bytes", size, cs.MaxMessageSize)
+	}
+	buf := make([]byte, size)
+	if _, err := io.ReadFull(br, buf); err != nil {
+		return err
+	}
+	return proto.Unmarshal(buf, m)
+}
+
+// writeMsg writes a delimited message to conn with w, waiting no longer than
+// WriteTimeout.
+func (cs *CollectorServer) writeMsg(conn net.Conn, w pio.Writer, m proto.Message) error {
+	var deadline time.Time
+	if cs.WriteTimeout != 0 {
+		deadline = time.Now().Add(cs.WriteTimeout)
+	}
+	if err := conn.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	return w.WriteMsg(m)
+}
 
-		if err = cs.c.Collect(spanID, annotationsFromWire(p.Annotation)...); err != nil {
-			return fmt.Errorf("Collect %v: %s", spanID, err)
+// collect collects the span of the packet received from conn.
+func (cs *CollectorServer) collect(conn net.Conn, p *wire.CollectPacket) error {
+	spanID := spanIDFromWire(p.Spanid)
+	if cs.Debug || cs.Trace {
+		cs.log().Printf("Client %s: received span %v with %d annotations", conn.RemoteAddr(), spanID, len(p.Annotation))
+	}
+	if cs.Trace {
+		for i, ann := range p.Annotation {
+			cs.log().Printf("Client %s: span %v: annotation %d: %s=%q", conn.RemoteAddr(), p.Spanid.Span, i, *ann.Key, ann.Value)
 		}
 	}
+
+	if err := cs.c.Collect(spanID, annotationsFromWire(p.Annotation)...); err != nil {
+		return fmt.Errorf("Collect %v: %s", spanID, err)
+	}
+	return nil
 }
 
 func (cs *CollectorServer) log() *log.Logger {
@@ -1,10 +1,14 @@
 package appdash
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
 	"net"
 	"reflect"
 	"sync"
@@ -36,6 +40,7 @@ func TestCollectorServer(t *testing.T) {
 
 	cs := NewServer(l, mc)
 	go cs.Start()
+	defer cs.Close()
 
 	cc := &collectorT{t, NewRemoteCollector(l.Addr().String())}
 
@@ -92,6 +97,7 @@ func TestCollectorServer_stress(t *testing.T) {
 
 	cs := NewServer(l, mc)
 	go cs.Start()
+	defer cs.Close()
 
 	cc := &collectorT{t, NewRemoteCollector(l.Addr().String())}
 	// cc.Collector.(*RemoteCollector).Debug = true
@@ -135,6 +141,7 @@ func BenchmarkRemoteCollector1000(b *testing.B) {
 		return nil
 	}))
 	go cs.Start()
+	defer cs.Close()
 
 	c := NewRemoteCollector(l.Addr().String())
 	// cc.Collector.(*RemoteCollector).Debug = true
@@ -171,6 +178,7 @@ func TestTLSCollectorServer(t *testing.T) {
 
 	cs := NewServer(l, mc)
 	go cs.Start()
+	defer cs.Close()
 
 	cc := &collectorT{t, NewTLSRemoteCollector(l.Addr().String(), &localhostTLSConfig)}
 	cc.MustCollect(SpanID{1, 2, 3})
@@ -185,6 +193,150 @@ func TestTLSCollectorServer(t *testing.T) {
 	}
 }
 
+// blockingCollector is a Collector that blocks until it is released, and
+// records whether it was flushed.
+type blockingCollector struct {
+	packetRecorder
+	entered, release chan struct{}
+	flushed          bool
+}
+
+func (c *blockingCollector) Collect(span SpanID, anns ...Annotation) error {
+	c.entered <- struct{}{}
+	<-c.release
+	return c.packetRecorder.Collect(span, anns...)
+}
+
+func (c *blockingCollector) Flush() error {
+	c.flushed = true
+	return nil
+}
+
+func TestCollectorServer_Shutdown(t *testing.T) {
+	bc := &blockingCollector{entered: make(chan struct{}), release: make(chan struct{})}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := NewServer(l, bc)
+	started := make(chan struct{})
+	go func() {
+		cs.Start()
+		close(started)
+	}()
+
+	// An idle connection doesn't delay the shutdown.
+	idle, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer idle.Close()
+
+	rc := NewRemoteCollector(l.Addr().String())
+	defer rc.Close()
+	collected := make(chan error)
+	go func() { collected <- rc.CollectBatch([]Span{{ID: SpanID{1, 2, 3}}}) }()
+	<-bc.entered
+
+	shutdown := make(chan error)
+	go func() { shutdown <- cs.Shutdown(context.Background()) }()
+	time.Sleep(20 * time.Millisecond)
+	select {
+	case err := <-shutdown:
+		t.Fatalf("Shutdown returned %v before the span was collected", err)
+	default:
+	}
+
+	// The span being collected is acknowledged before the server shuts
+	// down.
+	close(bc.release)
+	if err := <-collected; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-shutdown; err != nil {
+		t.Fatal(err)
+	}
+	if !bc.flushed {
+		t.Error("the collector was not flushed")
+	}
+	<-started
+	if _, err := idle.Read(make([]byte, 1)); err != io.EOF {
+		t.Errorf("got %v reading the idle connection, want io.EOF", err)
+	}
+}
+
+func TestCollectorServer_Shutdown_timeout(t *testing.T) {
+	bc := &blockingCollector{entered: make(chan struct{}), release: make(chan struct{})}
+	defer close(bc.release)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := NewServer(l, bc)
+	cs.Log = log.New(ioutil.Discard, "", 0)
+	go cs.Start()
+
+	rc := NewRemoteCollector(l.Addr().String())
+	defer rc.Close()
+	go rc.Collect(SpanID{1, 2, 3})
+	<-bc.entered
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := cs.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if bc.flushed {
+		t.Error("the collector was flushed")
+	}
+}
+
+func TestCollectorServer_limits(t *testing.T) {
+	rec := &packetRecorder{}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := NewServer(l, rec)
+	cs.Log = log.New(ioutil.Discard, "", 0)
+	cs.MaxConns = 1
+	cs.MaxMessageSize = 100
+	cs.IdleTimeout = 200 * time.Millisecond
+	go cs.Start()
+	defer cs.Close()
+
+	// Connections wait to be accepted while there are MaxConns others,
+	// until the others are closed for being idle.
+	idle, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer idle.Close()
+	time.Sleep(10 * time.Millisecond)
+	rc := NewRemoteCollector(l.Addr().String())
+	rc.AckTimeout = 20 * time.Millisecond
+	if err := rc.Collect(SpanID{1, 2, 3}); err == nil {
+		t.Error("got no error while another connection was open")
+	}
+	if _, err := idle.Read(make([]byte, 1)); err != io.EOF {
+		t.Errorf("got %v reading the idle connection, want io.EOF", err)
+	}
+	rc.AckTimeout = time.Second
+	if err := rc.Collect(SpanID{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Messages larger than MaxMessageSize are rejected.
+	if err := rc.CollectBatch([]Span{{ID: SpanID{2, 3, 4}, Annotations: Annotations{{"k", make([]byte, 100)}}}}); err == nil {
+		t.Error("got no error for a message larger than MaxMessageSize")
+	}
+	for _, p := range rec.sorted() {
+		if spanIDFromWire(p.Spanid) == (SpanID{2, 3, 4}) {
+			t.Errorf("server collected %v, want only the small span", p)
+		}
+	}
+}
+
 func TestChunkedCollector(t *testing.T) {
 	var packets []*wire.CollectPacket
 	mc := collectorFunc(func(span SpanID, anns ...Annotation) error {
@@ -229,6 +381,15 @@ func TestChunkedCollector(t *testing.T) {
 	}
 }
 
+func TestChunkedCollector_stop(t *testing.T) {
+	cc := &ChunkedCollector{Collector: NewMemoryStore(), MinInterval: time.Hour}
+	cc.Stop() // before the collector is started
+	cc.Stop()
+	if err := cc.Collect(SpanID{1, 2, 3}); err == nil {
+		t.Error("got no error collecting after Stop")
+	}
+}
+
 func TestChunkedCollectorFlushTimeout(t *testing.T) {
 	mc := collectorFunc(func(span SpanID, anns ...Annotation) error {
 		time.Sleep(200 * time.Millisecond) // Slow collector
@@ -254,6 +415,40 @@ func TestChunkedCollectorFlushTimeout(t *testing.T) {
 	}
 }
 
+func TestChunkedCollectorFlushTimeout_batch(t *testing.T) {
+	bc := &slowBatchCollector{delay: 200 * time.Millisecond}
+	cc := &ChunkedCollector{
+		Collector:    bc,
+		MinInterval:  time.Hour, // only flush manually
+		FlushTimeout: 300 * time.Millisecond,
+	}
+
+	for i := 0; i < 3*flushBatchSize; i++ {
+		cc.Collect(NewRootSpanID(), Annotation{"k1", []byte("v1")})
+	}
+
+	if err := cc.Flush(); err != ErrQueueDropped {
+		t.Fatal("got", err, "expected", ErrQueueDropped)
+	}
+	if bc.batches != 2 {
+		t.Errorf("got %d batches collected, want 2 (before the timeout)", bc.batches)
+	}
+}
+
+// slowBatchCollector is a BatchCollector that takes a while to collect each
+// batch.
+type slowBatchCollector struct {
+	Collector
+	delay   time.Duration
+	batches int
+}
+
+func (c *slowBatchCollector) CollectBatch(spans []Span) error {
+	time.Sleep(c.delay)
+	c.batches++
+	return nil
+}
+
 // collectorFunc implements the Collector interface by calling the function.
 type collectorFunc func(SpanID, ...Annotation) error
 
@@ -350,3 +545,48 @@ type byTraceID []*wire.CollectPacket
 func (bt byTraceID) Len() int           { return len(bt) }
 func (bt byTraceID) Swap(i, j int)      { bt[i], bt[j] = bt[j], bt[i] }
 func (bt byTraceID) Less(i, j int) bool { return *bt[i].Spanid.Trace < *bt[j].Spanid.Trace }
+
+// failingListener is a net.Listener whose Accept returns errs, one per call,
+// and then fails as if it was closed.
+type failingListener struct {
+	net.Listener
+	errs []error
+}
+
+// temporaryError is a net.Error that is temporary.
+type temporaryError struct{}
+
+func (temporaryError) Error() string   { return "temporary error" }
+func (temporaryError) Timeout() bool   { return false }
+func (temporaryError) Temporary() bool { return true }
+
+func (l *failingListener) Accept() (net.Conn, error) {
+	if len(l.errs) == 0 {
+		return nil, errors.New("use of closed network connection")
+	}
+	err := l.errs[0]
+	l.errs = l.errs[1:]
+	return nil, err
+}
+
+func TestCollectorServer_acceptErrors(t *testing.T) {
+	l := &failingListener{errs: []error{temporaryError{}, temporaryError{}}}
+	cs := NewServer(l, &packetRecorder{})
+	cs.Log = log.New(ioutil.Discard, "", 0)
+
+	// Start retries temporary errors, and returns once the listener fails
+	// (rather than retrying it forever).
+	done := make(chan struct{})
+	go func() {
+		cs.Start()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Start didn't return after the listener failed")
+	}
+	if len(l.errs) != 0 {
+		t.Errorf("got %d temporary errors left, want them retried", len(l.errs))
+	}
+}
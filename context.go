@@ -0,0 +1,67 @@
+package appdash
+
+import "context"
+
+// contextKey is the type of the context keys used by this package, so that
+// they do not collide with those of other packages.
+type contextKey int
+
+// spanKey is the context key for the current span, whose value is either a
+// SpanID or a *Recorder.
+const spanKey contextKey = 0
+
+// NewContextWithSpanID returns a copy of ctx that carries the given span,
+// replacing any span or Recorder that ctx already carries.
+func NewContextWithSpanID(ctx context.Context, span SpanID) context.Context {
+	return context.WithValue(ctx, spanKey, span)
+}
+
+// NewContextWithRecorder returns a copy of ctx that carries the given
+// Recorder and its span, replacing any span or Recorder that ctx already
+// carries.
+//
+// Recorders are not safe for concurrent use, so code that shares a context
+// across goroutines should record events on child Recorders (see
+// NewChildRecorderFromContext) rather than on the carried Recorder itself.
+func NewContextWithRecorder(ctx context.Context, rec *Recorder) context.Context {
+	return context.WithValue(ctx, spanKey, rec)
+}
+
+// SpanIDFromContext returns the span carried by ctx, either directly or by
+// way of its Recorder. The boolean reports whether ctx carries a span at all.
+func SpanIDFromContext(ctx context.Context) (SpanID, bool) {
+	switch v := ctx.Value(spanKey).(type) {
+	case SpanID:
+		return v, true
+	case *Recorder:
+		return v.SpanID, true
+	}
+	return SpanID{}, false
+}
+
+// RecorderFromContext returns the Recorder carried by ctx, or nil if it does
+// not carry one.
+func RecorderFromContext(ctx context.Context) *Recorder {
+	rec, _ := ctx.Value(spanKey).(*Recorder)
+	return rec
+}
+
+// NewChildRecorderFromContext returns a Recorder for a new child span of the
+// span carried by ctx, which records to the collector c. If ctx carries no
+// span, a Recorder for a new root span is returned instead.
+//
+// If c is nil, the collector of the Recorder carried by ctx is used. If ctx
+// does not carry a Recorder either, NewChildRecorderFromContext returns nil.
+func NewChildRecorderFromContext(ctx context.Context, c Collector) *Recorder {
+	if c == nil {
+		rec := RecorderFromContext(ctx)
+		if rec == nil {
+			return nil
+		}
+		return rec.Child()
+	}
+	if parent, ok := SpanIDFromContext(ctx); ok {
+		return NewRecorder(NewSpanID(parent), c)
+	}
+	return NewRecorder(NewRootSpanID(), c)
+}
@@ -0,0 +1,64 @@
+package appdash
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSpanIDFromContext(t *testing.T) {
+	if span, ok := SpanIDFromContext(context.Background()); ok {
+		t.Errorf("got span %v in empty context, want none", span)
+	}
+
+	span := SpanID{1, 2, 3}
+	ctx := NewContextWithSpanID(context.Background(), span)
+	if got, ok := SpanIDFromContext(ctx); !ok || got != span {
+		t.Errorf("got span %v (%v), want %v", got, ok, span)
+	}
+	if rec := RecorderFromContext(ctx); rec != nil {
+		t.Errorf("got Recorder %v, want nil", rec)
+	}
+
+	rec := NewRecorder(SpanID{4, 5, 6}, NewLocalCollector(NewMemoryStore()))
+	ctx = NewContextWithRecorder(ctx, rec)
+	if got, ok := SpanIDFromContext(ctx); !ok || got != rec.SpanID {
+		t.Errorf("got span %v (%v), want %v", got, ok, rec.SpanID)
+	}
+	if got := RecorderFromContext(ctx); got != rec {
+		t.Errorf("got Recorder %v, want %v", got, rec)
+	}
+}
+
+func TestNewChildRecorderFromContext(t *testing.T) {
+	ms := NewMemoryStore()
+	c := NewLocalCollector(ms)
+
+	if rec := NewChildRecorderFromContext(context.Background(), nil); rec != nil {
+		t.Errorf("got Recorder %v with no collector, want nil", rec)
+	}
+	if rec := NewChildRecorderFromContext(context.Background(), c); rec == nil || !rec.SpanID.IsRoot() {
+		t.Errorf("got Recorder %v, want a root span Recorder", rec)
+	}
+
+	parent := SpanID{1, 2, 0}
+	ctx := NewContextWithSpanID(context.Background(), parent)
+	if rec := NewChildRecorderFromContext(ctx, nil); rec != nil {
+		t.Errorf("got Recorder %v with no collector, want nil", rec)
+	}
+	rec := NewChildRecorderFromContext(ctx, c)
+	if rec.Trace != parent.Trace || rec.Parent != parent.Span {
+		t.Errorf("got span %v, want a child of %v", rec.SpanID, parent)
+	}
+
+	// With a Recorder in the context, its collector is used.
+	ctx = NewContextWithRecorder(context.Background(), NewRecorder(parent, c))
+	rec = NewChildRecorderFromContext(ctx, nil)
+	if rec.Trace != parent.Trace || rec.Parent != parent.Span {
+		t.Errorf("got span %v, want a child of %v", rec.SpanID, parent)
+	}
+	rec.Name("child")
+	rec.Finish()
+	if _, err := ms.Trace(parent.Trace); err != nil {
+		t.Errorf("child span was not collected: %s", err)
+	}
+}
@@ -0,0 +1,123 @@
+package appdash
+
+import (
+	"sort"
+	"time"
+)
+
+// A CriticalPathSegment is a time range during which a span was on the
+// critical path of a trace.
+type CriticalPathSegment struct {
+	Span       SpanID
+	Start, End time.Time
+}
+
+// CriticalPath returns the critical path of the trace: the spans that
+// determined its end-to-end latency, and when. When a span's children run
+// concurrently, only the child that finished last before the span (or the
+// next child on the path) resumed is on the path; the time a span spends
+// waiting on none of its children is its own. The segments are in order of
+// time, and their durations add up to that of the trace.
+//
+// The times of a span are those of its timespan events. Spans without them
+// are not on the path, and the times of children are clipped to those of
+// their parents. It returns nil if the trace's root span has no timespan
+// events.
+func (t *Trace) CriticalPath() ([]CriticalPathSegment, error) {
+	start, end, ok, err := t.spanTimes()
+	if err != nil || !ok {
+		return nil, err
+	}
+	var segs []CriticalPathSegment
+	if err := t.criticalPath(start, end, &segs); err != nil {
+		return nil, err
+	}
+
+	// The segments were found from the end of the trace backwards.
+	for i, j := 0, len(segs)-1; i < j; i, j = i+1, j-1 {
+		segs[i], segs[j] = segs[j], segs[i]
+	}
+	return segs, nil
+}
+
+// CriticalPathTimes returns how long each span of the trace was on its
+// critical path. Spans that were not on it are omitted.
+func (t *Trace) CriticalPathTimes() (map[SpanID]time.Duration, error) {
+	segs, err := t.CriticalPath()
+	if err != nil {
+		return nil, err
+	}
+	times := make(map[SpanID]time.Duration)
+	for _, s := range segs {
+		times[s.Span] += s.End.Sub(s.Start)
+	}
+	return times, nil
+}
+
+// criticalPathChild is a child span and its times.
+type criticalPathChild struct {
+	t          *Trace
+	start, end time.Time
+}
+
+// criticalPath appends the critical path of t between start and end to segs,
+// latest segment first.
+func (t *Trace) criticalPath(start, end time.Time, segs *[]CriticalPathSegment) error {
+	var children []criticalPathChild
+	for _, sub := range t.Sub {
+		s, e, ok, err := sub.spanTimes()
+		if err != nil {
+			return err
+		}
+		if ok {
+			children = append(children, criticalPathChild{sub, s, e})
+		}
+	}
+	sort.Sort(criticalPathChildrenByEnd(children))
+
+	add := func(s, e time.Time) {
+		if e.After(s) {
+			*segs = append(*segs, CriticalPathSegment{Span: t.Span.ID, Start: s, End: e})
+		}
+	}
+	cursor := end
+	for _, c := range children {
+		if !cursor.After(start) {
+			break
+		}
+		cs, ce := c.start, c.end
+		if cs.Before(start) {
+			cs = start
+		}
+		if ce.After(cursor) {
+			ce = cursor
+		}
+		if !ce.After(cs) {
+			continue // the child didn't run before the cursor
+		}
+		add(ce, cursor)
+		if err := c.t.criticalPath(cs, ce, segs); err != nil {
+			return err
+		}
+		cursor = cs
+	}
+	add(start, cursor)
+	return nil
+}
+
+// spanTimes returns the start and end times of the trace's root span, from
+// its timespan events. ok is false if it has none.
+func (t *Trace) spanTimes() (start, end time.Time, ok bool, err error) {
+	var events []Event
+	if err := UnmarshalEvents(t.Span.Annotations, &events); err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+	start, end, ok = findTraceTimes(events)
+	return start, end, ok, nil
+}
+
+type criticalPathChildrenByEnd []criticalPathChild
+
+func (v criticalPathChildrenByEnd) Len() int           { return len(v) }
+func (v criticalPathChildrenByEnd) Less(i, j int) bool { return v[i].end.After(v[j].end) }
+func (v criticalPathChildrenByEnd) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
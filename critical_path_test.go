@@ -0,0 +1,74 @@
+package appdash
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTrace_CriticalPath(t *testing.T) {
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	ms := func(n int) time.Time { return base.Add(time.Duration(n) * time.Millisecond) }
+	span := func(id SpanID, start, end int, sub ...*Trace) *Trace {
+		anns, err := MarshalEvent(Timespan{S: ms(start), E: ms(end)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &Trace{Span: Span{ID: id, Annotations: anns}, Sub: sub}
+	}
+	var (
+		root = SpanID{1, 1, 0}
+		a    = SpanID{1, 2, 1}
+		b    = SpanID{1, 3, 1}
+		c    = SpanID{1, 4, 3}
+		d    = SpanID{1, 5, 1}
+	)
+	// b runs concurrently with a and finishes last, so only the part of a
+	// before b started is on the path. d has no times.
+	tr := span(root, 0, 100,
+		span(a, 10, 60),
+		span(b, 20, 90, span(c, 30, 80)),
+		&Trace{Span: Span{ID: d}},
+	)
+
+	segs, err := tr.CriticalPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []CriticalPathSegment{
+		{root, ms(0), ms(10)},
+		{a, ms(10), ms(20)},
+		{b, ms(20), ms(30)},
+		{c, ms(30), ms(80)},
+		{b, ms(80), ms(90)},
+		{root, ms(90), ms(100)},
+	}
+	if !reflect.DeepEqual(segs, want) {
+		t.Errorf("got critical path %v, want %v", segs, want)
+	}
+
+	times, err := tr.CriticalPathTimes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantTimes := map[SpanID]time.Duration{
+		root: 20 * time.Millisecond,
+		a:    10 * time.Millisecond,
+		b:    20 * time.Millisecond,
+		c:    50 * time.Millisecond,
+	}
+	if !reflect.DeepEqual(times, wantTimes) {
+		t.Errorf("got critical path times %v, want %v", times, wantTimes)
+	}
+
+	// The times of children are clipped to those of their parents.
+	skewed := span(root, 0, 100, span(a, -10, 120))
+	if segs, err := skewed.CriticalPath(); err != nil || !reflect.DeepEqual(segs, []CriticalPathSegment{{a, ms(0), ms(100)}}) {
+		t.Errorf("got critical path %v (err %v), want all of a within the root", segs, err)
+	}
+
+	// Traces without times have no critical path.
+	if segs, err := (&Trace{Span: Span{ID: root}}).CriticalPath(); err != nil || segs != nil {
+		t.Errorf("got critical path %v (err %v), want none", segs, err)
+	}
+}
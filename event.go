@@ -127,6 +127,7 @@ func init() {
 	RegisterEvent(msgEvent{})
 	RegisterEvent(timespanEvent{})
 	RegisterEvent(Timespan{})
+	RegisterEvent(ErrorEvent{})
 }
 
 // UnmarshalEvents unmarshals all events found in anns into
@@ -221,3 +222,27 @@ type logEvent struct {
 func (logEvent) Schema() string { return "log" }
 
 func (e *logEvent) Timestamp() time.Time { return e.Time }
+
+// errorSchema is the schema of ErrorEvent.
+const errorSchema = "Error"
+
+// An ErrorEvent records that the operation of a span failed. Such spans are
+// highlighted in the web UI, and their traces match the WithErrors filter of
+// TracesOpts.
+type ErrorEvent struct {
+	Message string `trace:"Error.Message"` // the error message
+	Type    string `trace:"Error.Type"`    // the kind of error, such as the error's Go type
+	Stack   string `trace:"Error.Stack"`   // the stack trace, if any
+}
+
+// Schema returns the constant "Error".
+func (ErrorEvent) Schema() string { return errorSchema }
+
+// Important implements the ImportantEvent interface.
+func (ErrorEvent) Important() []string { return []string{"Error.Message", "Error.Type"} }
+
+// Error returns an ErrorEvent describing err, whose type is the Go type of
+// err (such as "*os.PathError").
+func Error(err error) ErrorEvent {
+	return ErrorEvent{Message: err.Error(), Type: fmt.Sprintf("%T", err)}
+}
@@ -12,12 +12,9 @@ import (
 	"sourcegraph.com/sourcegraph/appdash/traceapp"
 
 	"github.com/codegangsta/negroni"
-	"github.com/gorilla/context"
 	"github.com/gorilla/mux"
 )
 
-const CtxSpanID = 0
-
 var collector appdash.Collector
 
 func main() {
@@ -76,9 +73,6 @@ func main() {
 	collector = appdash.NewLocalCollector(store)
 	tracemw := httptrace.Middleware(collector, &httptrace.MiddlewareConfig{
 		RouteName: func(r *http.Request) string { return r.URL.Path },
-		SetContextSpan: func(r *http.Request, spanID appdash.SpanID) {
-			context.Set(r, CtxSpanID, spanID)
-		},
 	})
 	router := mux.NewRouter()
 	router.HandleFunc("/", Home)
@@ -90,7 +84,7 @@ func main() {
 }
 
 func Home(w http.ResponseWriter, r *http.Request) {
-	span := context.Get(r, CtxSpanID).(appdash.SpanID)
+	span, _ := appdash.SpanIDFromContext(r.Context())
 	httpClient := &http.Client{
 		Transport: &httptrace.Transport{
 			Recorder: appdash.NewRecorder(span, collector),
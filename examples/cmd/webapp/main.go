@@ -17,17 +17,12 @@ import (
 	"sourcegraph.com/sourcegraph/appdash/traceapp"
 
 	"github.com/codegangsta/negroni"
-	"github.com/gorilla/context"
 	"github.com/gorilla/mux"
 )
 
-// Used to store the SpanID in a request's context (see gorilla/context docs
-// for more information).
-const CtxSpanID = 0
-
 // We want to create HTTP clients recording to this collector inside our Home
 // handler below, so we use a global variable (for simplicity sake) to store
-// the collector in use. We could also use gorilla/context to store it.
+// the collector in use.
 var collector appdash.Collector
 
 func main() {
@@ -43,6 +38,10 @@ func main() {
 		DeleteStore: memStore,
 	}
 
+	// Aggregate the durations of the traces that are collected, for the
+	// dashboard of the web UI (MemoryStore can't aggregate them itself).
+	aggregator := appdash.NewAggregatingCollector(store)
+
 	// Start the Appdash web UI on port 8700.
 	//
 	// This is the actual Appdash web UI -- usable as a Go package itself, We
@@ -60,6 +59,7 @@ func main() {
 	}
 	tapp.Store = store
 	tapp.Queryer = memStore
+	tapp.Aggregator = aggregator
 	log.Println("Appdash web UI running on HTTP :8700")
 	go func() {
 		log.Fatal(http.ListenAndServe(":8700", tapp))
@@ -71,8 +71,9 @@ func main() {
 	// A collector is responsible for collecting the information about traces
 	// (i.e. spans and annotations) and placing them into a store. In this app
 	// we use a local collector (we could also use a remote collector, sending
-	// the information to a remote Appdash collection server).
-	collector = appdash.NewLocalCollector(store)
+	// the information to a remote Appdash collection server). Spans are
+	// collected through the aggregator, which passes them on to the store.
+	collector = aggregator
 
 	// Create the appdash/httptrace middleware.
 	//
@@ -82,9 +83,6 @@ func main() {
 	// wanted to.
 	tracemw := httptrace.Middleware(collector, &httptrace.MiddlewareConfig{
 		RouteName: func(r *http.Request) string { return r.URL.Path },
-		SetContextSpan: func(r *http.Request, spanID appdash.SpanID) {
-			context.Set(r, CtxSpanID, spanID)
-		},
 	})
 
 	// Setup our router (for information, see the gorilla/mux docs):
@@ -101,9 +99,10 @@ func main() {
 
 // Home is the homepage handler for our app.
 func Home(w http.ResponseWriter, r *http.Request) {
-	// Grab the span from the gorilla context. We do this so that we can grab
-	// the span.Trace ID and link directly to the trace on the web-page itself!
-	span := context.Get(r, CtxSpanID).(appdash.SpanID)
+	// Grab the span from the request's context, where the middleware stored
+	// it. We do this so that we can grab the span.Trace ID and link directly
+	// to the trace on the web-page itself!
+	span, _ := appdash.SpanIDFromContext(r.Context())
 
 	// We're going to make some API requests, so we create a HTTP client using
 	// a appdash/httptrace transport here. The transport will inform Appdash of
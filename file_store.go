@@ -0,0 +1,745 @@
+package appdash
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"sourcegraph.com/sourcegraph/appdash/internal/wire"
+)
+
+const (
+	fileStoreLogName = "spans.log" // name of the log file inside a FileStore's directory
+
+	// fileStoreHeaderSize is the size of a log record's header: a one byte
+	// record kind, a four byte payload length, and a four byte CRC-32 checksum
+	// of the payload.
+	fileStoreHeaderSize = 1 + 4 + 4
+
+	// fileStoreMaxRecordSize is the maximum size of a log record's payload.
+	fileStoreMaxRecordSize = maxMessageSize
+)
+
+// Log record kinds.
+const (
+	fileRecordCollect byte = 'c' // payload is a protobuf wire.CollectPacket
+	fileRecordDelete  byte = 'd' // payload is a big-endian uint64 trace ID
+)
+
+// errFileStoreCorrupt is returned when reading a log record that is
+// incomplete or whose checksum does not match.
+var errFileStoreCorrupt = errors.New("FileStore: corrupt log record")
+
+// A FileStore is a persistent Store, Queryer and DeleteStore that appends
+// spans to a log file on disk.
+//
+// Only indexes of the log (by trace ID, root span start time and root span
+// name) are held in memory; annotations are read back from disk when a trace
+// is requested. The indexes are rebuilt by scanning the log when the store is
+// opened. Any incomplete record at the end of the log (e.g. as the result of
+// a crash while writing it) is discarded, and corrupt records elsewhere are
+// skipped.
+//
+// Deleted traces are not removed from the log until it is compacted, which
+// happens automatically once enough of the log is garbage (see
+// CompactMinBytes) or manually by calling Compact.
+type FileStore struct {
+	// CompactMinBytes is the minimum number of bytes of deleted records the
+	// log must contain before it is automatically compacted by Delete. The log
+	// is only compacted automatically when the deleted records also make up
+	// the majority of the log. If zero, the log is never compacted
+	// automatically.
+	//
+	// Default CompactMinBytes (set by OpenFileStore) = 16 * 1024 * 1024 (16 MB).
+	CompactMinBytes int64
+
+	// Log is the logger to use for errors and warnings.
+	Log *log.Logger
+
+	dir string
+	f   *os.File // log file, opened for reading and appending
+	end int64    // offset of the end of the log
+
+	traces  map[ID]*fileTrace          // trace ID -> trace index entry
+	byStart []*fileTrace               // traces sorted by root span start time
+	byName  map[string]map[ID]struct{} // root span name -> set of trace IDs
+
+	liveBytes, deadBytes int64 // sizes of live and deleted records in the log
+
+	mu sync.Mutex // protects all of the above, except Log.
+}
+
+// fileTrace is the in-memory index entry of a trace in a FileStore.
+type fileTrace struct {
+	id      ID
+	offsets []int64 // offsets of the trace's collect records, in log order
+	size    int64   // total size of the trace's collect records
+
+	name       string    // root span name
+	start, end time.Time // root span times
+	hasTimes   bool      // whether start and end are known
+}
+
+func (t *fileTrace) duration() time.Duration { return t.end.Sub(t.start) }
+
+func (t *fileTrace) key() traceKey {
+	return traceKey{id: t.id, start: t.start, duration: t.duration(), hasTimes: t.hasTimes}
+}
+
+// Compile-time "implements" check.
+var _ interface {
+	DeleteStore
+	Queryer
+} = (*FileStore)(nil)
+
+// OpenFileStore opens (or creates) the FileStore stored in the given
+// directory, rebuilding its indexes from the log on disk.
+func OpenFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	fs := &FileStore{
+		CompactMinBytes: 16 * 1024 * 1024, // 16 MB
+		Log:             log.New(os.Stderr, "appdash: FileStore: ", log.LstdFlags),
+		dir:             dir,
+	}
+	if err := fs.open(); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+// open opens the log file and rebuilds the indexes from it. It must be
+// called with fs.mu held (or before fs is shared).
+func (fs *FileStore) open() error {
+	l, err := fs.openLog(filepath.Join(fs.dir, fileStoreLogName))
+	if err != nil {
+		return err
+	}
+	fs.use(l)
+	return nil
+}
+
+// openLog opens the named log file and rebuilds its indexes, returning a
+// FileStore that holds them. fs is not modified.
+func (fs *FileStore) openLog(name string) (*FileStore, error) {
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	l := &FileStore{
+		Log:    fs.Log,
+		dir:    fs.dir,
+		f:      f,
+		traces: map[ID]*fileTrace{},
+		byName: map[string]map[ID]struct{}{},
+	}
+	if err := l.replay(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return l, nil
+}
+
+// use replaces the log and indexes of fs with those of l, which was returned
+// by openLog. It must be called with fs.mu held (or before fs is shared).
+func (fs *FileStore) use(l *FileStore) {
+	fs.f, fs.end = l.f, l.end
+	fs.traces, fs.byStart, fs.byName = l.traces, l.byStart, l.byName
+	fs.liveBytes, fs.deadBytes = l.liveBytes, l.deadBytes
+}
+
+// replay rebuilds the indexes by reading the log from the start. It must be
+// called with fs.mu held (or before fs is shared).
+//
+// A damaged record that runs to the end of the log (e.g. as the result of a
+// crash while writing it) is discarded. A corrupt record in the middle of
+// the log is skipped if its header is intact; otherwise, the records after
+// it can't be found and replay fails.
+func (fs *FileStore) replay() error {
+	info, err := fs.f.Stat()
+	if err != nil {
+		return err
+	}
+	size := info.Size()
+	for fs.end < size {
+		kind, payload, n, err := fs.readRecord(fs.end)
+		if err == errFileStoreCorrupt && n > 0 && fs.end+n < size {
+			fs.Log.Printf("skipping corrupt log record of %d bytes at offset %d", n, fs.end)
+			fs.deadBytes += n
+			fs.end += n
+			continue
+		}
+		if err == errFileStoreCorrupt && n == 0 {
+			// The header is invalid, so the record's size is unknown. Unless
+			// the rest of the log is zeros, which a crash while extending the
+			// file may leave, the log is damaged beyond what can be recovered
+			// automatically.
+			zero, err := fs.isZero(fs.end, size)
+			if err != nil {
+				return err
+			}
+			if !zero {
+				return fmt.Errorf("FileStore: corrupt log record header at offset %d of %s", fs.end, fs.f.Name())
+			}
+		}
+		if err == errFileStoreCorrupt || err == io.ErrUnexpectedEOF {
+			// The tail of the log is incomplete or corrupt, most likely due to
+			// a crash while it was being written. Discard it.
+			fs.Log.Printf("discarding %d bytes of incomplete log data at offset %d", size-fs.end, fs.end)
+			return fs.f.Truncate(fs.end)
+		} else if err != nil {
+			return err
+		}
+		if err := fs.index(kind, payload, fs.end, n); err != nil {
+			return err
+		}
+		fs.end += n
+	}
+	return nil
+}
+
+// isZero reports whether the bytes of the log from off to end are all zero.
+func (fs *FileStore) isZero(off, end int64) (bool, error) {
+	buf := make([]byte, 32*1024)
+	for off < end {
+		if int64(len(buf)) > end-off {
+			buf = buf[:end-off]
+		}
+		if _, err := fs.f.ReadAt(buf, off); err != nil {
+			return false, err
+		}
+		for _, b := range buf {
+			if b != 0 {
+				return false, nil
+			}
+		}
+		off += int64(len(buf))
+	}
+	return true, nil
+}
+
+// readRecord reads the log record at the given offset, returning its kind,
+// payload and total size in bytes. If the record's header is valid but its
+// checksum does not match, errFileStoreCorrupt is returned along with its
+// size.
+func (fs *FileStore) readRecord(off int64) (kind byte, payload []byte, n int64, err error) {
+	var hdr [fileStoreHeaderSize]byte
+	if _, err := fs.f.ReadAt(hdr[:], off); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, 0, err
+	}
+	kind = hdr[0]
+	size := binary.BigEndian.Uint32(hdr[1:5])
+	sum := binary.BigEndian.Uint32(hdr[5:9])
+	if size > fileStoreMaxRecordSize || (kind != fileRecordCollect && kind != fileRecordDelete) {
+		return 0, nil, 0, errFileStoreCorrupt
+	}
+	payload = make([]byte, size)
+	if _, err := fs.f.ReadAt(payload, off+fileStoreHeaderSize); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, 0, err
+	}
+	if crc32.ChecksumIEEE(payload) != sum {
+		return 0, nil, fileStoreHeaderSize + int64(size), errFileStoreCorrupt
+	}
+	return kind, payload, fileStoreHeaderSize + int64(size), nil
+}
+
+// appendRecord appends a record to the end of the log, returning its offset
+// and total size in bytes. It must be called with fs.mu held.
+func (fs *FileStore) appendRecord(kind byte, payload []byte) (off, n int64, err error) {
+	if len(payload) > fileStoreMaxRecordSize {
+		return 0, 0, fmt.Errorf("FileStore: record of %d bytes exceeds maximum size of %d bytes", len(payload), fileStoreMaxRecordSize)
+	}
+	buf := make([]byte, fileStoreHeaderSize+len(payload))
+	buf[0] = kind
+	binary.BigEndian.PutUint32(buf[1:5], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[5:9], crc32.ChecksumIEEE(payload))
+	copy(buf[fileStoreHeaderSize:], payload)
+
+	// Write the whole record at once, so that a crash leaves at most one
+	// incomplete record at the end of the log.
+	off = fs.end
+	if _, err := fs.f.WriteAt(buf, off); err != nil {
+		return 0, 0, err
+	}
+	fs.end += int64(len(buf))
+	return off, int64(len(buf)), nil
+}
+
+// index updates the indexes with the given record, which is located at off
+// and is n bytes long. It must be called with fs.mu held.
+func (fs *FileStore) index(kind byte, payload []byte, off, n int64) error {
+	switch kind {
+	case fileRecordDelete:
+		if len(payload) != 8 {
+			return errFileStoreCorrupt
+		}
+		fs.deadBytes += n
+		fs.unindex(ID(binary.BigEndian.Uint64(payload)))
+		return nil
+
+	case fileRecordCollect:
+		p := &wire.CollectPacket{}
+		if err := proto.Unmarshal(payload, p); err != nil {
+			return err
+		}
+		return fs.indexSpan(spanIDFromWire(p.Spanid), annotationsFromWire(p.Annotation), off, n)
+	}
+	return errFileStoreCorrupt
+}
+
+// indexSpan adds a collect record for the given span to the indexes. It must
+// be called with fs.mu held.
+func (fs *FileStore) indexSpan(id SpanID, anns Annotations, off, n int64) error {
+	t, present := fs.traces[id.Trace]
+	if !present {
+		t = &fileTrace{id: id.Trace}
+		fs.traces[id.Trace] = t
+	}
+	t.offsets = append(t.offsets, off)
+	t.size += n
+	fs.liveBytes += n
+
+	if !id.IsRoot() {
+		if !present {
+			fs.insertByStart(t)
+		}
+		return nil
+	}
+
+	// Index the root span's name and times.
+	if name := string(anns.get("Name")); name != "" && name != t.name {
+		fs.removeByName(t)
+		t.name = name
+		if fs.byName[name] == nil {
+			fs.byName[name] = map[ID]struct{}{}
+		}
+		fs.byName[name][t.id] = struct{}{}
+	}
+	var events []Event
+	if err := UnmarshalEvents(anns, &events); err != nil {
+		return err
+	}
+	if start, end, ok := findTraceTimes(events); ok {
+		if present {
+			fs.removeByStart(t)
+		}
+		if !t.hasTimes || start.Before(t.start) {
+			t.start = start
+		}
+		if !t.hasTimes || end.After(t.end) {
+			t.end = end
+		}
+		t.hasTimes = true
+		fs.insertByStart(t)
+	} else if !present {
+		fs.insertByStart(t)
+	}
+	return nil
+}
+
+// unindex removes the given trace from the indexes. It must be called with
+// fs.mu held.
+func (fs *FileStore) unindex(id ID) {
+	t, ok := fs.traces[id]
+	if !ok {
+		return
+	}
+	delete(fs.traces, id)
+	fs.removeByStart(t)
+	fs.removeByName(t)
+	fs.liveBytes -= t.size
+	fs.deadBytes += t.size
+}
+
+// fileTraceLess orders traces by start time, with traces without times sorted
+// first, and ties broken by trace ID.
+func fileTraceLess(a, b *fileTrace) bool {
+	if a.hasTimes != b.hasTimes {
+		return !a.hasTimes
+	}
+	if !a.start.Equal(b.start) {
+		return a.start.Before(b.start)
+	}
+	return a.id < b.id
+}
+
+// searchByStart returns the index in fs.byStart at which t is (or would be)
+// located.
+func (fs *FileStore) searchByStart(t *fileTrace) int {
+	return sort.Search(len(fs.byStart), func(i int) bool {
+		return !fileTraceLess(fs.byStart[i], t)
+	})
+}
+
+func (fs *FileStore) insertByStart(t *fileTrace) {
+	i := fs.searchByStart(t)
+	fs.byStart = append(fs.byStart, nil)
+	copy(fs.byStart[i+1:], fs.byStart[i:])
+	fs.byStart[i] = t
+}
+
+func (fs *FileStore) removeByStart(t *fileTrace) {
+	i := fs.searchByStart(t)
+	if i < len(fs.byStart) && fs.byStart[i] == t {
+		fs.byStart = append(fs.byStart[:i], fs.byStart[i+1:]...)
+	}
+}
+
+func (fs *FileStore) removeByName(t *fileTrace) {
+	if t.name == "" {
+		return
+	}
+	ids := fs.byName[t.name]
+	delete(ids, t.id)
+	if len(ids) == 0 {
+		delete(fs.byName, t.name)
+	}
+}
+
+// Collect implements the Collector interface by appending the span and its
+// annotations to the log.
+func (fs *FileStore) Collect(id SpanID, as ...Annotation) error {
+	payload, err := proto.Marshal(newCollectPacket(id, as))
+	if err != nil {
+		return err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.f == nil {
+		return errors.New("FileStore is closed")
+	}
+	off, n, err := fs.appendRecord(fileRecordCollect, payload)
+	if err != nil {
+		return err
+	}
+	return fs.indexSpan(id, as, off, n)
+}
+
+// Trace implements the Store interface by reading the trace with the given ID
+// from the log.
+func (fs *FileStore) Trace(id ID) (*Trace, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.traceNoLock(id)
+}
+
+func (fs *FileStore) traceNoLock(id ID) (*Trace, error) {
+	t, ok := fs.traces[id]
+	if !ok || fs.f == nil {
+		return nil, ErrTraceNotFound
+	}
+
+	// Build the trace tree exactly as MemoryStore would, by replaying the
+	// collections of the trace's spans into a temporary one.
+	ms := NewMemoryStore()
+	for _, off := range t.offsets {
+		_, payload, _, err := fs.readRecord(off)
+		if err != nil {
+			return nil, err
+		}
+		p := &wire.CollectPacket{}
+		if err := proto.Unmarshal(payload, p); err != nil {
+			return nil, err
+		}
+		if err := ms.collectNoLock(spanIDFromWire(p.Spanid), annotationsFromWire(p.Annotation)...); err != nil {
+			return nil, err
+		}
+	}
+	return ms.traceNoLock(id)
+}
+
+// Traces implements the Queryer interface. The trace ID, root span name,
+// timespan and duration filters and the ordering of opts are evaluated using
+// the in-memory indexes, so that only candidate traces are read from disk.
+// Unless another order is specified, traces are returned most recent first.
+func (fs *FileStore) Traces(opts TracesOpts) ([]*Trace, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	// Select the candidate traces using the most specific index available.
+	var candidates []*fileTrace
+	switch {
+	case len(opts.TraceIDs) > 0:
+		seen := make(map[ID]struct{}, len(opts.TraceIDs))
+		for _, id := range opts.TraceIDs {
+			if _, dup := seen[id]; dup {
+				continue
+			}
+			seen[id] = struct{}{}
+			if t, ok := fs.traces[id]; ok {
+				candidates = append(candidates, t)
+			}
+		}
+	case opts.Name != "":
+		for id := range fs.byName[opts.Name] {
+			candidates = append(candidates, fs.traces[id])
+		}
+	default:
+		candidates = fs.byStart
+		if opts.Timespan != (Timespan{}) {
+			// Narrow the candidates down to those starting within the
+			// timespan.
+			lo, hi := 0, len(candidates)
+			if !opts.Timespan.S.IsZero() {
+				lo = sort.Search(hi, func(i int) bool {
+					t := candidates[i]
+					return t.hasTimes && !t.start.Before(opts.Timespan.S)
+				})
+			}
+			if !opts.Timespan.E.IsZero() {
+				hi = sort.Search(hi, func(i int) bool {
+					t := candidates[i]
+					return t.hasTimes && t.start.After(opts.Timespan.E)
+				})
+			}
+			if lo > hi {
+				lo = hi
+			}
+			candidates = candidates[lo:hi]
+		}
+	}
+
+	// Filter the candidates by their indexed properties.
+	// Note that candidates may be fs.byStart itself, so it mustn't be
+	// modified.
+	matched := make([]*fileTrace, 0, len(candidates))
+	for _, t := range candidates {
+		if opts.Name != "" && t.name != opts.Name {
+			continue
+		}
+		if opts.Timespan != (Timespan{}) {
+			if !t.hasTimes ||
+				(!opts.Timespan.S.IsZero() && t.start.Before(opts.Timespan.S)) ||
+				(!opts.Timespan.E.IsZero() && t.start.After(opts.Timespan.E)) {
+				continue
+			}
+		}
+		if opts.MinDuration != 0 || opts.MaxDuration != 0 {
+			if !t.hasTimes ||
+				(opts.MinDuration != 0 && t.duration() < opts.MinDuration) ||
+				(opts.MaxDuration != 0 && t.duration() > opts.MaxDuration) {
+				continue
+			}
+		}
+		matched = append(matched, t)
+	}
+
+	// Order the candidates.
+	order := opts.Order
+	if order == OrderDefault {
+		order = OrderNewest
+	}
+	sortFileTraces(matched, order)
+
+	// Read candidates from disk, applying the remaining filters, until we
+	// have read enough to satisfy the offset and limit.
+	annFilters := opts.annotationFilters()
+	rest := TracesOpts{Annotations: annFilters, Errors: opts.Errors}
+	var (
+		traces  []*Trace
+		skipped int
+	)
+	for _, t := range matched {
+		if opts.Limit > 0 && len(traces) == opts.Limit {
+			break
+		}
+		tr, err := fs.traceNoLock(t.id)
+		if err != nil {
+			return nil, err
+		}
+		if len(annFilters) > 0 || opts.Errors != AnyErrors {
+			if !(&traceInfo{Trace: tr}).match(&rest, annFilters) {
+				continue
+			}
+		}
+		if skipped < opts.Offset {
+			skipped++
+			continue
+		}
+		traces = append(traces, tr)
+	}
+	return traces, nil
+}
+
+// sortFileTraces sorts the traces in the given order, in the same manner as
+// sortTraceInfos.
+func sortFileTraces(ts []*fileTrace, order TracesOrder) {
+	if less := traceOrderLess(order); less != nil {
+		sort.Sort(fileTraceSorter{ts, less})
+	}
+}
+
+type fileTraceSorter struct {
+	ts   []*fileTrace
+	less func(a, b *traceKey) bool
+}
+
+func (s fileTraceSorter) Len() int      { return len(s.ts) }
+func (s fileTraceSorter) Swap(i, j int) { s.ts[i], s.ts[j] = s.ts[j], s.ts[i] }
+func (s fileTraceSorter) Less(i, j int) bool {
+	a, b := s.ts[i].key(), s.ts[j].key()
+	return s.less(&a, &b)
+}
+
+// StartTimes returns the start times of the root spans of the stored traces,
+// by trace ID. The start times of traces whose root span's times are unknown
+// are zero.
+func (fs *FileStore) StartTimes() map[ID]time.Time {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	starts := make(map[ID]time.Time, len(fs.traces))
+	for id, t := range fs.traces {
+		starts[id] = t.start
+	}
+	return starts
+}
+
+// Delete implements the DeleteStore interface by appending deletion records
+// for the given traces to the log. If enough of the log is garbage afterwards,
+// it is compacted.
+func (fs *FileStore) Delete(traces ...ID) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.f == nil {
+		return errors.New("FileStore is closed")
+	}
+	for _, id := range traces {
+		if _, ok := fs.traces[id]; !ok {
+			continue
+		}
+		var payload [8]byte
+		binary.BigEndian.PutUint64(payload[:], uint64(id))
+		_, n, err := fs.appendRecord(fileRecordDelete, payload[:])
+		if err != nil {
+			return err
+		}
+		fs.deadBytes += n
+		fs.unindex(id)
+	}
+	if fs.CompactMinBytes > 0 && fs.deadBytes >= fs.CompactMinBytes && fs.deadBytes > fs.liveBytes {
+		return fs.compactNoLock()
+	}
+	return nil
+}
+
+// Compact rewrites the log without the records of deleted traces, reclaiming
+// their disk space.
+func (fs *FileStore) Compact() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.f == nil {
+		return errors.New("FileStore is closed")
+	}
+	return fs.compactNoLock()
+}
+
+func (fs *FileStore) compactNoLock() error {
+	start := time.Now()
+	tmp, err := os.Create(filepath.Join(fs.dir, fileStoreLogName+".compact"))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	// Copy the live collect records, preserving their order in the log.
+	var offsets []int64
+	for _, t := range fs.traces {
+		offsets = append(offsets, t.offsets...)
+	}
+	sort.Sort(int64s(offsets))
+	var buf []byte
+	for _, off := range offsets {
+		kind, payload, _, err := fs.readRecord(off)
+		if err != nil {
+			tmp.Close()
+			return err
+		}
+		buf = append(buf[:0], kind, 0, 0, 0, 0, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(buf[1:5], uint32(len(payload)))
+		binary.BigEndian.PutUint32(buf[5:9], crc32.ChecksumIEEE(payload))
+		buf = append(buf, payload...)
+		if _, err := tmp.Write(buf); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	// Index the compacted log before it replaces the log, so that the store
+	// keeps using the old log if anything fails.
+	l, err := fs.openLog(tmp.Name())
+	if err != nil {
+		return err
+	}
+	err = fs.f.Close()
+	fs.f = nil
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(fs.dir, fileStoreLogName))
+	}
+	if err != nil {
+		l.f.Close()
+		if err2 := fs.open(); err2 != nil {
+			fs.Log.Printf("reopening log after failed compaction: %s", err2)
+		}
+		return err
+	}
+	dead := fs.deadBytes
+	fs.use(l)
+	fs.Log.Printf("compacted log, reclaiming %d bytes (took %s)", dead, time.Since(start))
+	return nil
+}
+
+// Sync commits the log to stable storage.
+func (fs *FileStore) Sync() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.f == nil {
+		return errors.New("FileStore is closed")
+	}
+	return fs.f.Sync()
+}
+
+// Close syncs and closes the log. After closing, the store may no longer be
+// used.
+func (fs *FileStore) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.f == nil {
+		return nil
+	}
+	err := fs.f.Sync()
+	if err2 := fs.f.Close(); err == nil {
+		err = err2
+	}
+	fs.f = nil
+	return err
+}
+
+type int64s []int64
+
+func (s int64s) Len() int           { return len(s) }
+func (s int64s) Less(i, j int) bool { return s[i] < s[j] }
+func (s int64s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
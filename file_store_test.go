@@ -0,0 +1,298 @@
+package appdash
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestFileStore opens a FileStore in a new temporary directory. The
+// returned func closes the store and removes the directory.
+func newTestFileStore(t *testing.T) (*FileStore, func()) {
+	dir, err := ioutil.TempDir("", "appdash-filestore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs, err := OpenFileStore(dir)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	fs.Log.SetOutput(ioutil.Discard)
+	return fs, func() {
+		fs.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// reopen closes the FileStore and opens it again from disk.
+func (fs *FileStore) reopen(t *testing.T) *FileStore {
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+	fs2, err := OpenFileStore(fs.dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs2.Log.SetOutput(ioutil.Discard)
+	return fs2
+}
+
+func TestFileStore_Collect(t *testing.T) {
+	fs, done := newTestFileStore(t)
+	defer done()
+	st := storeT{t, fs}
+
+	if x, err := fs.Trace(1); err != ErrTraceNotFound {
+		t.Errorf("Trace(1): got trace %+v and err %#v, want ErrTraceNotFound", x, err)
+	}
+
+	st.MustCollect(SpanID{1, 2, 1}, Annotation{Key: "k2"}) // child before root
+	st.MustCollect(SpanID{1, 1, 0}, Annotation{Key: "k1"})
+	st.MustCollect(SpanID{1, 2, 1}, Annotation{Key: "k3"})
+	st.MustCollect(SpanID{2, 1, 0})
+	want1 := &Trace{
+		Span: Span{ID: SpanID{1, 1, 0}, Annotations: Annotations{{Key: "k1"}}},
+		Sub: []*Trace{
+			{Span: Span{ID: SpanID{1, 2, 1}, Annotations: Annotations{{Key: "k2"}, {Key: "k3"}}}},
+		},
+	}
+	want2 := &Trace{Span: Span{ID: SpanID{2, 1, 0}}}
+	check := func(st storeT) {
+		if x := st.MustTrace(1); !reflect.DeepEqual(x, want1) {
+			t.Errorf("Trace(1): got trace %+v, want %+v", x, want1)
+		}
+		if x := st.MustTrace(2); !reflect.DeepEqual(x, want2) {
+			t.Errorf("Trace(2): got trace %+v, want %+v", x, want2)
+		}
+	}
+	check(st)
+
+	t.Log("reopen")
+	fs = fs.reopen(t)
+	check(storeT{t, fs})
+}
+
+func TestFileStore_Traces_opts(t *testing.T) {
+	fs, done := newTestFileStore(t)
+	defer done()
+	testTracesOpts(t, fs)
+
+	t.Log("reopen")
+	fs = fs.reopen(t)
+	testTracesOptsQueries(t, fs)
+}
+
+func TestFileStore_StartTimes(t *testing.T) {
+	fs, done := newTestFileStore(t)
+	defer done()
+	st := storeT{t, fs}
+
+	start := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	anns, err := MarshalEvent(Timespan{S: start, E: start.Add(time.Second)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	st.MustCollect(SpanID{1, 1, 0}, anns...)
+	st.MustCollect(SpanID{2, 1, 0})
+	fs = fs.reopen(t)
+	defer fs.Close()
+	want := map[ID]time.Time{1: start, 2: {}}
+	if got := fs.StartTimes(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got start times %v, want %v", got, want)
+	}
+}
+
+func TestFileStore_Delete(t *testing.T) {
+	fs, done := newTestFileStore(t)
+	defer done()
+	st := storeT{t, fs}
+
+	st.MustCollect(SpanID{1, 1, 0})
+	st.MustCollect(SpanID{1, 2, 1})
+	st.MustCollect(SpanID{2, 1, 0})
+	if err := fs.Delete(1, 3); err != nil {
+		t.Fatal(err)
+	}
+	check := func(fs *FileStore) {
+		if x, err := fs.Trace(1); err != ErrTraceNotFound {
+			t.Errorf("Trace(1): got trace %+v and err %#v, want ErrTraceNotFound", x, err)
+		}
+		traces, err := fs.Traces(TracesOpts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(traces) != 1 || traces[0].ID.Trace != 2 {
+			t.Errorf("Traces: got %v, want only trace 2", traces)
+		}
+	}
+	check(fs)
+
+	t.Log("reopen")
+	fs = fs.reopen(t)
+	check(fs)
+
+	t.Log("compact")
+	before, after := fs.liveBytes+fs.deadBytes, fs.liveBytes
+	if err := fs.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if fs.deadBytes != 0 || fs.end != after {
+		t.Errorf("after Compact: got %d dead bytes and %d byte log, want 0 dead bytes and %d byte log (was %d)", fs.deadBytes, fs.end, after, before)
+	}
+	check(fs)
+
+	t.Log("reopen")
+	fs = fs.reopen(t)
+	check(fs)
+}
+
+func TestFileStore_Delete_autoCompact(t *testing.T) {
+	fs, done := newTestFileStore(t)
+	defer done()
+	fs.CompactMinBytes = 1
+	st := storeT{t, fs}
+
+	st.MustCollect(SpanID{1, 1, 0})
+	st.MustCollect(SpanID{2, 1, 0})
+	if err := fs.Delete(1); err != nil {
+		t.Fatal(err)
+	}
+	if fs.deadBytes != 0 {
+		t.Errorf("got %d dead bytes, want 0 after automatic compaction", fs.deadBytes)
+	}
+	st.MustTrace(2)
+}
+
+func TestFileStore_recover(t *testing.T) {
+	fs, done := newTestFileStore(t)
+	defer done()
+	st := storeT{t, fs}
+
+	st.MustCollect(SpanID{1, 1, 0})
+	st.MustCollect(SpanID{2, 1, 0})
+	size := fs.end
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate a crash while writing the last record by truncating it, and
+	// then make sure it is discarded and that the store is still writable.
+	name := filepath.Join(fs.dir, fileStoreLogName)
+	if err := os.Truncate(name, size-2); err != nil {
+		t.Fatal(err)
+	}
+	fs, err := OpenFileStore(fs.dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs.Log.SetOutput(ioutil.Discard)
+	st = storeT{t, fs}
+	st.MustTrace(1)
+	if x, err := fs.Trace(2); err != ErrTraceNotFound {
+		t.Errorf("Trace(2): got trace %+v and err %#v, want ErrTraceNotFound", x, err)
+	}
+	st.MustCollect(SpanID{3, 1, 0})
+	st.MustTrace(3)
+
+	// Corrupt the last record's payload.
+	fs = fs.reopen(t)
+	size = fs.end
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(name, os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := make([]byte, 1)
+	if _, err := f.ReadAt(b, size-1); err != nil {
+		t.Fatal(err)
+	}
+	b[0] ^= 0xff
+	if _, err := f.WriteAt(b, size-1); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	fs, err = OpenFileStore(fs.dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs.Log.SetOutput(ioutil.Discard)
+	defer fs.Close()
+	storeT{t, fs}.MustTrace(1)
+	if x, err := fs.Trace(3); err != ErrTraceNotFound {
+		t.Errorf("Trace(3): got trace %+v and err %#v, want ErrTraceNotFound", x, err)
+	}
+}
+
+func TestFileStore_recover_middle(t *testing.T) {
+	fs, done := newTestFileStore(t)
+	defer done()
+	st := storeT{t, fs}
+
+	st.MustCollect(SpanID{1, 1, 0})
+	off := fs.end
+	st.MustCollect(SpanID{2, 1, 0})
+	st.MustCollect(SpanID{3, 1, 0})
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(fs.dir, fileStoreLogName)
+	flip := func(off int64) {
+		f, err := os.OpenFile(name, os.O_RDWR, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		b := make([]byte, 1)
+		if _, err := f.ReadAt(b, off); err != nil {
+			t.Fatal(err)
+		}
+		b[0] ^= 0xff
+		if _, err := f.WriteAt(b, off); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Corrupt the payload of the record in the middle of the log. Only that
+	// record is skipped.
+	flip(off + fileStoreHeaderSize)
+	fs, err := OpenFileStore(fs.dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fs.Log.SetOutput(ioutil.Discard)
+	st = storeT{t, fs}
+	st.MustTrace(1)
+	if x, err := fs.Trace(2); err != ErrTraceNotFound {
+		t.Errorf("Trace(2): got trace %+v and err %#v, want ErrTraceNotFound", x, err)
+	}
+	st.MustTrace(3)
+	st.MustCollect(SpanID{4, 1, 0})
+	fs = fs.reopen(t)
+	storeT{t, fs}.MustTrace(3)
+	storeT{t, fs}.MustTrace(4)
+	if err := fs.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Corrupt the header of that record, so that the records after it can't
+	// be found. The store must not be opened (and truncated).
+	flip(off)
+	info, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenFileStore(fs.dir); err == nil {
+		t.Fatal("OpenFileStore: got nil error, want the log's header corruption")
+	}
+	if info2, err := os.Stat(name); err != nil {
+		t.Fatal(err)
+	} else if info2.Size() != info.Size() {
+		t.Errorf("got log size %d, want %d", info2.Size(), info.Size())
+	}
+}
@@ -116,6 +116,10 @@ func isRedacted(name string) bool {
 type Transport struct {
 	// Recorder is the current span's recorder. A new child Recorder
 	// (with a new child SpanID) is created for each HTTP roundtrip.
+	//
+	// If nil, the Recorder carried by each request's context (see
+	// appdash.RecorderFromContext) is used instead, and requests whose
+	// context carries no Recorder are not traced.
 	*appdash.Recorder
 
 	// Transport is the underlying HTTP transport to use when making
@@ -124,6 +128,11 @@ type Transport struct {
 
 	SetName bool
 
+	// Propagators are the propagators used to pass the span along to the
+	// server in the request's headers. If nil, only the Span-ID header is
+	// set, as by []Propagator{SpanIDPropagator{}}.
+	Propagators []Propagator
+
 	// requests keeps clone request
 	reqMu    sync.Mutex
 	requests map[*http.Request]*http.Request
@@ -138,17 +147,28 @@ func (t *Transport) RoundTrip(original *http.Request) (*http.Response, error) {
 	t.setCloneRequest(original, req)
 	defer t.setCloneRequest(original, nil)
 
-	child := t.Recorder.Child()
+	rec := t.Recorder
+	if rec == nil {
+		rec = appdash.RecorderFromContext(original.Context())
+		if rec == nil {
+			return t.getTransport().RoundTrip(req)
+		}
+	}
+
+	child := rec.Child()
 	if t.SetName {
 		child.Name("Request " + req.URL.Host)
 	}
 
-	// New child span is created and set as HTTP header instead of using `child`
-	// in order to have a single span recording operation per httptrace event
-	// (HTTPClient or HTTPServer).
-	span := appdash.NewSpanID(t.Recorder.SpanID)
-
-	SetSpanIDHeader(req.Header, span)
+	// The Span-ID header passes a new sibling of `child` rather than `child`
+	// itself, in order to have a single span recording operation per
+	// httptrace event (HTTPClient or HTTPServer). Other propagators pass a
+	// new child of `child` instead (see injectSpan).
+	props := t.Propagators
+	if props == nil {
+		props = []Propagator{SpanIDPropagator{}}
+	}
+	injectSpan(original.Context(), appdash.NewSpanID(rec.SpanID), appdash.NewSpanID(child.SpanID), req.Header, props)
 
 	e := NewClientEvent(req)
 	e.ClientSend = time.Now()
@@ -160,8 +180,12 @@ func (t *Transport) RoundTrip(original *http.Request) (*http.Response, error) {
 	e.ClientRecv = time.Now()
 	if err == nil {
 		e.Response = responseInfo(resp)
+		if resp.StatusCode >= 500 {
+			child.Event(statusError(resp.StatusCode))
+		}
 	} else {
 		e.Response.StatusCode = -1
+		child.Fail(err)
 	}
 	child.Event(e)
 	child.Finish()
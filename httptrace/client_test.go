@@ -123,6 +123,94 @@ func TestTransport(t *testing.T) {
 	}
 }
 
+func TestTransport_spanIDPropagator(t *testing.T) {
+	// The Span-ID header passes the same span whether the SpanIDPropagator
+	// is used by default or configured, alone or with other propagators: a
+	// sibling of the client's span, under the recorder's span.
+	for _, props := range [][]Propagator{
+		nil,
+		{SpanIDPropagator{}},
+		{&SpanIDPropagator{}},
+		{TraceContextPropagator{}, SpanIDPropagator{}},
+	} {
+		rec := appdash.NewRecorder(appdash.SpanID{1, 2, 3}, appdash.NewLocalCollector(appdash.NewMemoryStore()))
+		mt := &mockTransport{resp: &http.Response{StatusCode: 200}}
+		transport := &Transport{Recorder: rec, Transport: mt, Propagators: props}
+		req, _ := http.NewRequest("GET", "http://example.com/foo", nil)
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+		spanID, err := appdash.ParseSpanID(mt.req.Header.Get("Span-ID"))
+		if err != nil {
+			t.Fatalf("%v: %s", props, err)
+		}
+		if spanID.Trace != 1 || spanID.Parent != 2 {
+			t.Errorf("%v: got Span-ID in header %+v, want a child of span 2", props, *spanID)
+		}
+	}
+}
+
+func TestTransport_contextRecorder(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	rec := appdash.NewRecorder(appdash.SpanID{1, 2, 3}, appdash.NewLocalCollector(ms))
+	mt := &mockTransport{resp: &http.Response{StatusCode: 200}}
+	transport := &Transport{Transport: mt}
+
+	// Requests whose context carries no Recorder are not traced.
+	req, _ := http.NewRequest("GET", "http://example.com/foo", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	if h := mt.req.Header.Get("Span-ID"); h != "" {
+		t.Errorf("got Span-ID header %q for untraced request, want none", h)
+	}
+
+	req = req.WithContext(appdash.NewContextWithRecorder(req.Context(), rec))
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	spanID, err := appdash.ParseSpanID(mt.req.Header.Get("Span-ID"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (appdash.SpanID{1, spanID.Span, 2}); *spanID != want {
+		t.Errorf("got Span-ID in header %+v, want %+v", *spanID, want)
+	}
+	if _, err := ms.Trace(1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTransport_error(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	rec := appdash.NewRecorder(appdash.SpanID{1, 2, 3}, appdash.NewLocalCollector(ms))
+	transport := &Transport{
+		Recorder:  rec,
+		Transport: &mockTransport{resp: &http.Response{StatusCode: 502}},
+	}
+	req, _ := http.NewRequest("GET", "http://example.com/foo", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	// The request's span is the root of the stored trace, as its parent
+	// wasn't collected.
+	trace, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !trace.Span.Failed() {
+		t.Fatalf("got span %v, want it to have failed", trace.Span)
+	}
+	var e appdash.ErrorEvent
+	if err := appdash.UnmarshalEvent(trace.Span.Annotations, &e); err != nil {
+		t.Fatal(err)
+	}
+	if want := (appdash.ErrorEvent{Message: "502 Bad Gateway", Type: "HTTP"}); e != want {
+		t.Errorf("got %+v, want %+v", e, want)
+	}
+}
+
 func TestCancelRequest(t *testing.T) {
 	ms := appdash.NewMemoryStore()
 	rec := appdash.NewRecorder(appdash.SpanID{1, 2, 3}, appdash.NewLocalCollector(ms))
@@ -88,6 +88,43 @@
 //      tracemw(w, r, appHandler)
 //  })
 //
+// Request Context
+//
+// The middleware stores the request's span in the context.Context of the
+// request it passes on to your handler, so that the rest of the handling
+// process can be traced as part of the same trace:
+//
+//  func appHandler(w http.ResponseWriter, r *http.Request) {
+//      // Record an operation as a child span of the request's span.
+//      rec := appdash.NewChildRecorderFromContext(r.Context(), nil)
+//      rec.Name("operation")
+//      defer rec.Finish()
+//
+//      // Outbound requests made with a Transport that has no Recorder of its
+//      // own are recorded as children of the span in their context.
+//      client := &http.Client{Transport: &httptrace.Transport{}}
+//      req, _ := http.NewRequest("GET", "http://example.com", nil)
+//      resp, err := client.Do(req.WithContext(r.Context()))
+//      ...
+//  }
+//
+// Propagation
+//
+// By default, spans are passed between services in the Span-ID header. To
+// interoperate with services instrumented with W3C Trace Context or Zipkin
+// B3, configure the middleware and transports with propagators; incoming
+// headers are tried in the order given:
+//
+//  props := []httptrace.Propagator{
+//      httptrace.TraceContextPropagator{},
+//      httptrace.B3Propagator{},
+//      httptrace.SpanIDPropagator{},
+//  }
+//  tracemw := httptrace.Middleware(collector, &httptrace.MiddlewareConfig{
+//      Propagators: props,
+//  })
+//  client := &http.Client{Transport: &httptrace.Transport{Propagators: props}}
+//
 // Other details such as outbound client requests, displaying the trace ID in
 // the webpage e.g. to let users give you their trace ID for troubleshooting,
 // and much more are covered in the example application provided at
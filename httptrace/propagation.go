@@ -0,0 +1,336 @@
+package httptrace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// A Propagator passes spans from clients to servers in HTTP headers of a
+// particular format, allowing traces to span services instrumented with
+// different tracing systems.
+//
+// Middleware and Transport use SpanIDPropagator (i.e. the Span-ID and
+// Parent-Span-ID headers) unless they are configured with other
+// propagators.
+type Propagator interface {
+	// Inject sets the headers in h that pass span along to the server of
+	// an outgoing request. The span is a new child of the client's span,
+	// under which the server should record its handling of the request.
+	// The context is that of the outgoing request, which may carry state
+	// extracted from the incoming request by Extract.
+	Inject(ctx context.Context, span appdash.SpanID, h http.Header)
+
+	// Extract returns the span under which the server should record its
+	// handling of an incoming request with headers h, and ok == true. If
+	// h does not contain the propagator's headers, ok is false. The
+	// returned context is derived from ctx and carries any state (such
+	// as W3C tracestate) that Inject should pass on to outgoing requests.
+	Extract(ctx context.Context, h http.Header) (newCtx context.Context, span appdash.SpanID, ok bool, err error)
+}
+
+// extractSpan returns the span described by the headers of the first of
+// the propagators that finds its headers in r, along with r's context
+// updated with the state extracted along with the span. If no propagator
+// finds its headers, a new root span is returned. Propagators that fail
+// to extract a span are logged and skipped.
+//
+// The returned shared is whether the span is the one that the client
+// passed in the Span-ID header (see SpanIDPropagator), in which it records
+// the request itself.
+func extractSpan(r *http.Request, props []Propagator) (ctx context.Context, span appdash.SpanID, shared bool) {
+	for _, p := range props {
+		ctx, span, ok, err := p.Extract(r.Context(), r.Header)
+		if err != nil {
+			log.Printf("Warning: invalid %T headers: %s. (Continuing with request handling.)", p, err)
+			continue
+		}
+		if ok {
+			switch p.(type) {
+			case SpanIDPropagator, *SpanIDPropagator:
+				shared = r.Header.Get(HeaderSpanID) != ""
+			}
+			return ctx, span, shared
+		}
+	}
+	return r.Context(), appdash.NewRootSpanID(), false
+}
+
+// injectSpan sets the headers of all of the propagators in h. The Span-ID
+// header passes sibling, a new sibling of the client's span, in which the
+// server records the request itself (see extractSpan). Other propagators
+// pass child, a new child of the client's span, as those that can't pass the
+// span itself, such as W3C Trace Context, only pass its parent, under which
+// the server creates a new child. Otherwise the server's span would be a
+// sibling of the client's.
+func injectSpan(ctx context.Context, sibling, child appdash.SpanID, h http.Header, props []Propagator) {
+	for _, p := range props {
+		switch p.(type) {
+		case SpanIDPropagator, *SpanIDPropagator:
+			p.Inject(ctx, sibling, h)
+		default:
+			p.Inject(ctx, child, h)
+		}
+	}
+}
+
+// propagationKey is the type of the context keys under which propagators
+// store state extracted from incoming requests.
+type propagationKey int
+
+const (
+	traceStateKey propagationKey = iota // W3C tracestate header value
+	traceFlagsKey                       // W3C trace-flags
+	b3SamplingKey                       // B3 sampling state ("0", "1" or "d")
+)
+
+// SpanIDPropagator is the Propagator for appdash's own Span-ID and
+// Parent-Span-ID headers (see SetSpanIDHeader and GetSpanID).
+type SpanIDPropagator struct{}
+
+// Inject implements the Propagator interface.
+func (SpanIDPropagator) Inject(ctx context.Context, span appdash.SpanID, h http.Header) {
+	SetSpanIDHeader(h, span)
+}
+
+// Extract implements the Propagator interface.
+func (SpanIDPropagator) Extract(ctx context.Context, h http.Header) (context.Context, appdash.SpanID, bool, error) {
+	spanID, fromHeader, err := getSpanID(h)
+	if err != nil || fromHeader == "" {
+		return ctx, appdash.SpanID{}, false, err
+	}
+	return ctx, *spanID, true, nil
+}
+
+// Header names used by TraceContextPropagator.
+const (
+	HeaderTraceParent = "traceparent"
+	HeaderTraceState  = "tracestate"
+)
+
+// TraceContextPropagator is the Propagator for the W3C Trace Context
+// traceparent and tracestate headers (https://www.w3.org/TR/trace-context/).
+//
+// Appdash trace IDs are 64 bits long, so they are sent as the low 64 bits of
+// the 128 bit W3C trace ID, and only the low 64 bits of incoming trace IDs
+// are kept. As the W3C parent ID is the ID of the client's span, Inject
+// sends the parent of the span it is given (which Transport creates as a
+// child of the span recording the request), and the server records its
+// handling of a request as a new child of the parent ID. The tracestate
+// header and the sampled flag of an incoming request are passed on to
+// outgoing requests made with its context.
+type TraceContextPropagator struct{}
+
+// Inject implements the Propagator interface.
+func (TraceContextPropagator) Inject(ctx context.Context, span appdash.SpanID, h http.Header) {
+	parent := span.Parent
+	if parent == 0 {
+		// W3C cannot express a root span to use as-is, so make the server's
+		// span a child of it instead.
+		parent = span.Span
+	}
+	flags, ok := ctx.Value(traceFlagsKey).(byte)
+	if !ok {
+		flags = 0x01 // sampled
+	}
+	h.Set(HeaderTraceParent, fmt.Sprintf("00-%016x%016x-%016x-%02x", 0, uint64(span.Trace), uint64(parent), flags))
+	if state, _ := ctx.Value(traceStateKey).(string); state != "" {
+		h.Set(HeaderTraceState, state)
+	}
+}
+
+// Extract implements the Propagator interface.
+func (TraceContextPropagator) Extract(ctx context.Context, h http.Header) (context.Context, appdash.SpanID, bool, error) {
+	tp := h.Get(HeaderTraceParent)
+	if tp == "" {
+		return ctx, appdash.SpanID{}, false, nil
+	}
+	trace, parent, flags, err := parseTraceParent(tp)
+	if err != nil {
+		return ctx, appdash.SpanID{}, false, err
+	}
+	ctx = context.WithValue(ctx, traceFlagsKey, flags)
+	if state := strings.Join(h[http.CanonicalHeaderKey(HeaderTraceState)], ","); state != "" {
+		ctx = context.WithValue(ctx, traceStateKey, state)
+	}
+	return ctx, appdash.NewSpanID(appdash.SpanID{Trace: trace, Span: parent}), true, nil
+}
+
+// parseTraceParent parses a W3C traceparent header value.
+func parseTraceParent(s string) (trace, parent appdash.ID, flags byte, err error) {
+	// version "-" trace-id "-" parent-id "-" trace-flags, where later
+	// versions may append fields after another "-".
+	if len(s) < 55 || (len(s) > 55 && s[55] != '-') || s[2] != '-' || s[35] != '-' || s[52] != '-' {
+		return 0, 0, 0, errors.New("malformed traceparent")
+	}
+	version, err := parseLowerHex(s[0:2])
+	if err != nil || version == 0xff || (version == 0 && len(s) != 55) {
+		return 0, 0, 0, errors.New("unsupported traceparent version")
+	}
+	hi, err1 := parseLowerHex(s[3:19])
+	lo, err2 := parseLowerHex(s[19:35])
+	p, err3 := parseLowerHex(s[36:52])
+	f, err4 := parseLowerHex(s[53:55])
+	for _, err := range []error{err1, err2, err3, err4} {
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("malformed traceparent: %s", err)
+		}
+	}
+	if hi == 0 && lo == 0 {
+		return 0, 0, 0, errors.New("invalid traceparent trace ID")
+	}
+	if p == 0 {
+		return 0, 0, 0, errors.New("invalid traceparent parent ID")
+	}
+	if lo == 0 {
+		lo = hi
+	}
+	return appdash.ID(lo), appdash.ID(p), byte(f), nil
+}
+
+// parseLowerHex parses a string of lowercase hexadecimal digits, as W3C
+// Trace Context requires.
+func parseLowerHex(s string) (uint64, error) {
+	if strings.ToLower(s) != s {
+		return 0, fmt.Errorf("%q is not lowercase hex", s)
+	}
+	return strconv.ParseUint(s, 16, 64)
+}
+
+// Header names used by B3Propagator.
+const (
+	HeaderB3TraceID      = "X-B3-TraceId"
+	HeaderB3SpanID       = "X-B3-SpanId"
+	HeaderB3ParentSpanID = "X-B3-ParentSpanId"
+	HeaderB3Sampled      = "X-B3-Sampled"
+	HeaderB3Flags        = "X-B3-Flags"
+	HeaderB3             = "b3"
+)
+
+// B3Propagator is the Propagator for the B3 headers used by Zipkin
+// (https://github.com/openzipkin/b3-propagation).
+//
+// B3 shares span IDs between clients and servers in the same way as
+// appdash, so spans map directly onto B3 trace, span and parent span IDs.
+// Only the low 64 bits of 128 bit B3 trace IDs are kept. Both the multiple
+// X-B3-* headers and the single b3 header are extracted, and the sampling
+// state of an incoming request is passed on to outgoing requests made with
+// its context.
+type B3Propagator struct {
+	// SingleHeader, if true, causes the single b3 header to be injected
+	// instead of the multiple X-B3-* headers.
+	SingleHeader bool
+}
+
+// Inject implements the Propagator interface.
+func (p B3Propagator) Inject(ctx context.Context, span appdash.SpanID, h http.Header) {
+	sampling, _ := ctx.Value(b3SamplingKey).(string)
+	if sampling == "" {
+		sampling = "1"
+	}
+	if p.SingleHeader {
+		v := fmt.Sprintf("%016x-%016x-%s", uint64(span.Trace), uint64(span.Span), sampling)
+		if span.Parent != 0 {
+			v += fmt.Sprintf("-%016x", uint64(span.Parent))
+		}
+		h.Set(HeaderB3, v)
+		return
+	}
+	h.Set(HeaderB3TraceID, fmt.Sprintf("%016x", uint64(span.Trace)))
+	h.Set(HeaderB3SpanID, fmt.Sprintf("%016x", uint64(span.Span)))
+	if span.Parent != 0 {
+		h.Set(HeaderB3ParentSpanID, fmt.Sprintf("%016x", uint64(span.Parent)))
+	}
+	if sampling == "d" {
+		h.Set(HeaderB3Flags, "1")
+	} else {
+		h.Set(HeaderB3Sampled, sampling)
+	}
+}
+
+// Extract implements the Propagator interface.
+func (B3Propagator) Extract(ctx context.Context, h http.Header) (context.Context, appdash.SpanID, bool, error) {
+	var traceID, spanID, parentID, sampling string
+	if b3 := h.Get(HeaderB3); b3 != "" {
+		fields := strings.Split(b3, "-")
+		if len(fields) == 1 {
+			// Only a sampling decision, without a span.
+			return ctx, appdash.SpanID{}, false, nil
+		}
+		if len(fields) > 4 {
+			return ctx, appdash.SpanID{}, false, errors.New("malformed b3 header")
+		}
+		traceID, spanID = fields[0], fields[1]
+		if len(fields) > 2 {
+			sampling = fields[2]
+		}
+		if len(fields) > 3 {
+			parentID = fields[3]
+		}
+	} else {
+		traceID, spanID = h.Get(HeaderB3TraceID), h.Get(HeaderB3SpanID)
+		if traceID == "" && spanID == "" {
+			return ctx, appdash.SpanID{}, false, nil
+		}
+		parentID = h.Get(HeaderB3ParentSpanID)
+		switch {
+		case h.Get(HeaderB3Flags) == "1":
+			sampling = "d"
+		case h.Get(HeaderB3Sampled) == "true":
+			sampling = "1"
+		case h.Get(HeaderB3Sampled) == "false":
+			sampling = "0"
+		default:
+			sampling = h.Get(HeaderB3Sampled)
+		}
+	}
+
+	var (
+		span appdash.SpanID
+		err  error
+	)
+	if span.Trace, err = parseB3ID(traceID, true); err != nil {
+		return ctx, appdash.SpanID{}, false, fmt.Errorf("invalid B3 trace ID: %s", err)
+	}
+	if span.Span, err = parseB3ID(spanID, false); err != nil {
+		return ctx, appdash.SpanID{}, false, fmt.Errorf("invalid B3 span ID: %s", err)
+	}
+	if parentID != "" {
+		if span.Parent, err = parseB3ID(parentID, false); err != nil {
+			return ctx, appdash.SpanID{}, false, fmt.Errorf("invalid B3 parent span ID: %s", err)
+		}
+	}
+	switch sampling {
+	case "":
+	case "0", "1", "d":
+		ctx = context.WithValue(ctx, b3SamplingKey, sampling)
+	default:
+		return ctx, appdash.SpanID{}, false, fmt.Errorf("invalid B3 sampling state %q", sampling)
+	}
+	return ctx, span, true, nil
+}
+
+// parseB3ID parses a 64 bit B3 ID or, if trace is true, a 64 or 128 bit B3
+// trace ID (of which the low 64 bits are returned).
+func parseB3ID(s string, trace bool) (appdash.ID, error) {
+	if trace && len(s) == 32 {
+		s = s[16:]
+	}
+	if len(s) == 0 || len(s) > 16 {
+		return 0, fmt.Errorf("%q has invalid length", s)
+	}
+	id, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		return 0, fmt.Errorf("%q is zero", s)
+	}
+	return appdash.ID(id), nil
+}
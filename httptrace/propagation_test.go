@@ -0,0 +1,291 @@
+package httptrace
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+func TestTraceContextPropagator(t *testing.T) {
+	var p TraceContextPropagator
+	h := http.Header{}
+	h.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	h.Add("tracestate", "congo=t61rcWkgMzE")
+	h.Add("tracestate", "rojo=00f067aa0ba902b7")
+	ctx, span, ok, err := p.Extract(context.Background(), h)
+	if err != nil || !ok {
+		t.Fatalf("Extract: got ok %v, err %v", ok, err)
+	}
+	if span.Trace != 0xa3ce929d0e0e4736 || span.Parent != 0x00f067aa0ba902b7 || span.Span == 0 {
+		t.Errorf("Extract: got span %v, want a new child of the parent ID", span)
+	}
+
+	// The state and flags of the incoming request are passed on.
+	out := http.Header{}
+	p.Inject(ctx, appdash.SpanID{Trace: span.Trace, Span: 3, Parent: span.Span}, out)
+	want := http.Header{
+		"Traceparent": {"00-0000000000000000a3ce929d0e0e4736-" + span.Span.String() + "-00"},
+		"Tracestate":  {"congo=t61rcWkgMzE,rojo=00f067aa0ba902b7"},
+	}
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("Inject: got headers %v, want %v", out, want)
+	}
+
+	out = http.Header{}
+	p.Inject(context.Background(), appdash.SpanID{Trace: 1, Span: 2, Parent: 3}, out)
+	if got, want := out.Get("traceparent"), "00-00000000000000000000000000000001-0000000000000003-01"; got != want {
+		t.Errorf("Inject: got traceparent %q, want %q", got, want)
+	}
+
+	for _, tp := range []string{
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+	} {
+		h := http.Header{"Traceparent": {tp}}
+		if _, _, _, err := p.Extract(context.Background(), h); err == nil {
+			t.Errorf("Extract(%q): got nil error, want error", tp)
+		}
+	}
+	if _, _, ok, err := p.Extract(context.Background(), http.Header{}); ok || err != nil {
+		t.Errorf("Extract with no headers: got ok %v, err %v", ok, err)
+	}
+}
+
+func TestB3Propagator(t *testing.T) {
+	span := appdash.SpanID{Trace: 1, Span: 2, Parent: 3}
+	for _, p := range []B3Propagator{{}, {SingleHeader: true}} {
+		h := http.Header{}
+		p.Inject(context.Background(), span, h)
+		_, got, ok, err := p.Extract(context.Background(), h)
+		if err != nil || !ok {
+			t.Fatalf("%+v: Extract: got ok %v, err %v", p, ok, err)
+		}
+		if got != span {
+			t.Errorf("%+v: got span %v after round trip, want %v", p, got, span)
+		}
+	}
+
+	tests := []struct {
+		h    http.Header
+		want appdash.SpanID
+		out  http.Header // headers injected for a child span
+	}{
+		{
+			h: http.Header{
+				"X-B3-Traceid": {"80f198ee56343ba864fe8b2a57d3eff7"},
+				"X-B3-Spanid":  {"e457b5a2e4d86bd1"},
+				"X-B3-Sampled": {"0"},
+			},
+			want: appdash.SpanID{Trace: 0x64fe8b2a57d3eff7, Span: 0xe457b5a2e4d86bd1},
+			out: http.Header{
+				"X-B3-Traceid":      {"64fe8b2a57d3eff7"},
+				"X-B3-Spanid":       {"0000000000000001"},
+				"X-B3-Parentspanid": {"e457b5a2e4d86bd1"},
+				"X-B3-Sampled":      {"0"},
+			},
+		},
+		{
+			h:    http.Header{"B3": {"80f198ee56343ba864fe8b2a57d3eff7-e457b5a2e4d86bd1-d-05e3ac9a4f6e3b90"}},
+			want: appdash.SpanID{Trace: 0x64fe8b2a57d3eff7, Span: 0xe457b5a2e4d86bd1, Parent: 0x05e3ac9a4f6e3b90},
+			out: http.Header{
+				"X-B3-Traceid":      {"64fe8b2a57d3eff7"},
+				"X-B3-Spanid":       {"0000000000000001"},
+				"X-B3-Parentspanid": {"e457b5a2e4d86bd1"},
+				"X-B3-Flags":        {"1"},
+			},
+		},
+	}
+	var p B3Propagator
+	for _, test := range tests {
+		ctx, got, ok, err := p.Extract(context.Background(), test.h)
+		if err != nil || !ok {
+			t.Fatalf("Extract(%v): got ok %v, err %v", test.h, ok, err)
+		}
+		if got != test.want {
+			t.Errorf("Extract(%v): got span %v, want %v", test.h, got, test.want)
+		}
+		out := http.Header{}
+		p.Inject(ctx, appdash.SpanID{Trace: got.Trace, Span: 1, Parent: got.Span}, out)
+		if !reflect.DeepEqual(out, test.out) {
+			t.Errorf("Inject after Extract(%v): got headers %v, want %v", test.h, out, test.out)
+		}
+	}
+
+	for _, h := range []http.Header{
+		{"B3": {"0"}},
+		{},
+	} {
+		if _, _, ok, err := p.Extract(context.Background(), h); ok || err != nil {
+			t.Errorf("Extract(%v): got ok %v, err %v, want neither", h, ok, err)
+		}
+	}
+	for _, h := range []http.Header{
+		{"B3": {"zz-e457b5a2e4d86bd1"}},
+		{"X-B3-Traceid": {"80f198ee56343ba8"}},
+		{"X-B3-Traceid": {"80f198ee56343ba8"}, "X-B3-Spanid": {"e457b5a2e4d86bd1"}, "X-B3-Sampled": {"maybe"}},
+	} {
+		if _, _, _, err := p.Extract(context.Background(), h); err == nil {
+			t.Errorf("Extract(%v): got nil error, want error", h)
+		}
+	}
+}
+
+func TestMiddleware_propagators(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	c := appdash.NewLocalCollector(ms)
+	mw := Middleware(c, &MiddlewareConfig{
+		Propagators: []Propagator{B3Propagator{}, TraceContextPropagator{}, SpanIDPropagator{}},
+	})
+
+	tests := []struct {
+		h    http.Header
+		want appdash.SpanID
+	}{
+		{
+			h: http.Header{
+				"Span-Id":      {appdash.SpanID{Trace: 1, Span: 2, Parent: 3}.String()},
+				"X-B3-Traceid": {"0000000000000004"},
+				"X-B3-Spanid":  {"0000000000000005"},
+			},
+			want: appdash.SpanID{Trace: 4, Span: 5},
+		},
+		{
+			h: http.Header{
+				"Span-Id":     {appdash.SpanID{Trace: 1, Span: 2, Parent: 3}.String()},
+				"Traceparent": {"00-00000000000000000000000000000006-0000000000000007-01"},
+			},
+			want: appdash.SpanID{Trace: 6, Parent: 7},
+		},
+		{
+			// Invalid headers are skipped.
+			h: http.Header{
+				"Span-Id":     {appdash.SpanID{Trace: 1, Span: 2, Parent: 3}.String()},
+				"Traceparent": {"invalid"},
+			},
+			want: appdash.SpanID{Trace: 1, Span: 2, Parent: 3},
+		},
+	}
+	for _, test := range tests {
+		req, _ := http.NewRequest("GET", "http://example.com/foo", nil)
+		req.Header = test.h
+		var got appdash.SpanID
+		mw(httptest.NewRecorder(), req, func(w http.ResponseWriter, r *http.Request) {
+			got, _ = appdash.SpanIDFromContext(r.Context())
+		})
+		if test.want.Span == 0 {
+			// A new child of the given parent span is created.
+			test.want.Span = got.Span
+		}
+		if got != test.want {
+			t.Errorf("headers %v: got span %v, want %v", test.h, got, test.want)
+		}
+	}
+}
+
+func TestExtractSpan_shared(t *testing.T) {
+	props := []Propagator{B3Propagator{}, TraceContextPropagator{}, SpanIDPropagator{}}
+	tests := []struct {
+		h          http.Header
+		wantShared bool
+	}{
+		{h: http.Header{"Span-Id": {appdash.SpanID{Trace: 1, Span: 2, Parent: 3}.String()}}, wantShared: true},
+		{h: http.Header{"Parent-Span-Id": {appdash.SpanID{Trace: 1, Span: 2}.String()}}},
+		{h: http.Header{"Traceparent": {"00-00000000000000000000000000000001-0000000000000002-01"}}},
+		{h: http.Header{"X-B3-Traceid": {"0000000000000001"}, "X-B3-Spanid": {"0000000000000002"}}},
+		{h: http.Header{"Span-Id": {"invalid"}}},
+		{h: http.Header{}},
+	}
+	for _, test := range tests {
+		req, _ := http.NewRequest("GET", "http://example.com/foo", nil)
+		req.Header = test.h
+		if _, _, shared := extractSpan(req, props); shared != test.wantShared {
+			t.Errorf("headers %v: got shared %v, want %v", test.h, shared, test.wantShared)
+		}
+	}
+}
+
+func TestTransport_propagators(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	rec := appdash.NewRecorder(appdash.SpanID{1, 2, 3}, appdash.NewLocalCollector(ms))
+	mt := &mockTransport{resp: &http.Response{StatusCode: 200}}
+	transport := &Transport{
+		Recorder:    rec,
+		Transport:   mt,
+		Propagators: []Propagator{TraceContextPropagator{}, B3Propagator{}},
+	}
+	req, _ := http.NewRequest("GET", "http://example.com/foo", nil)
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+	trace, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := trace.Span.ID // the span in which the request is recorded
+
+	if h := mt.req.Header.Get("Span-ID"); h != "" {
+		t.Errorf("got Span-ID header %q, want none", h)
+	}
+	if got, want := mt.req.Header.Get("traceparent"), "00-00000000000000000000000000000001-"+client.Span.String()+"-01"; got != want {
+		t.Errorf("got traceparent %q, want %q", got, want)
+	}
+	_, span, ok, err := B3Propagator{}.Extract(context.Background(), mt.req.Header)
+	if err != nil || !ok {
+		t.Fatalf("Extract: got ok %v, err %v", ok, err)
+	}
+	if span.Trace != 1 || span.Parent != client.Span {
+		t.Errorf("got B3 span %v, want a child of %v", span, client)
+	}
+}
+
+// TestPropagators_traceContext tests that with W3C Trace Context, the span
+// in which a server records a request is a child of the span in which the
+// client records it.
+func TestPropagators_traceContext(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	c := appdash.NewLocalCollector(ms)
+	props := []Propagator{TraceContextPropagator{}}
+
+	mw := Middleware(c, &MiddlewareConfig{Propagators: props})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mw(w, r, func(http.ResponseWriter, *http.Request) {})
+	}))
+	defer srv.Close()
+
+	rec := appdash.NewRecorder(appdash.NewRootSpanID(), c)
+	client := &http.Client{Transport: &Transport{Recorder: rec, Propagators: props}}
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	rec.Finish()
+
+	trace, err := ms.Trace(rec.SpanID.Trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trace.Sub) != 1 {
+		t.Fatalf("got %d children of the root span, want 1 (the client's span)", len(trace.Sub))
+	}
+	clientSpan := trace.Sub[0]
+	var ce ClientEvent
+	if err := appdash.UnmarshalEvent(clientSpan.Annotations, &ce); err != nil || ce.ClientSend.IsZero() {
+		t.Fatalf("got no client event in span %v (error %v)", clientSpan.ID, err)
+	}
+	if len(clientSpan.Sub) != 1 {
+		t.Fatalf("got %d children of the client's span, want 1 (the server's span)", len(clientSpan.Sub))
+	}
+	var se ServerEvent
+	if err := appdash.UnmarshalEvent(clientSpan.Sub[0].Annotations, &se); err != nil || se.ServerRecv.IsZero() {
+		t.Errorf("got no server event in span %v (error %v)", clientSpan.Sub[0].ID, err)
+	}
+}
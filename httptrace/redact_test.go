@@ -0,0 +1,64 @@
+package httptrace
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+func TestRedactingCollector_httpEvents(t *testing.T) {
+	r := &http.Request{
+		Method: "GET",
+		URL:    &url.URL{Path: "/foo"},
+		Header: http.Header{
+			"Cookie":              []string{"session=secret"},
+			"Proxy-Authorization": []string{"Basic seeecret"},
+			"Accept":              []string{"application/json"},
+		},
+	}
+	resp := http.Header{"Set-Cookie": []string{"session=secret"}}
+
+	ce := NewClientEvent(r)
+	ce.Response.Headers = redactHeaders(resp, nil)
+	se := NewServerEvent(r)
+	se.Response.Headers = redactHeaders(resp, nil)
+
+	ms := appdash.NewMemoryStore()
+	rc := appdash.NewRedactingCollector(ms, appdash.DefaultRedactedKeys)
+	span := appdash.SpanID{Trace: 1, Span: 2}
+	for _, e := range []appdash.Event{ce, se} {
+		anns, err := appdash.MarshalEvent(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := rc.Collect(span, anns...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	trace, err := ms.Trace(span.Trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	for _, a := range trace.Annotations {
+		got[a.Key] = string(a.Value)
+	}
+	for _, key := range []string{
+		"Client.Request.Headers.Cookie",
+		"Client.Request.Headers.Proxy-Authorization",
+		"Client.Response.Headers.Set-Cookie",
+		"Server.Request.Headers.Cookie",
+		"Server.Request.Headers.Proxy-Authorization",
+		"Server.Response.Headers.Set-Cookie",
+	} {
+		if v := got[key]; v != appdash.RedactedValue {
+			t.Errorf("got %s=%q, want it redacted", key, v)
+		}
+	}
+	if v := got["Client.Request.Headers.Accept"]; v != "application/json" {
+		t.Errorf("got Accept header %q, want it kept", v)
+	}
+}
@@ -1,6 +1,7 @@
 package httptrace
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 	"time"
@@ -38,6 +39,15 @@ func responseInfo(r *http.Response) ResponseInfo {
 	}
 }
 
+// statusError returns an ErrorEvent describing a response with the given
+// (5xx) status code.
+func statusError(code int) appdash.ErrorEvent {
+	return appdash.ErrorEvent{
+		Message: fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		Type:    "HTTP",
+	}
+}
+
 // ServerEvent records an HTTP server request handling event.
 type ServerEvent struct {
 	Request    RequestInfo  `trace:"Server.Request"`
@@ -65,14 +75,42 @@ func (e ServerEvent) End() time.Time { return e.ServerSend }
 // Middleware creates a new http.Handler middleware
 // (negroni-compliant) that records incoming HTTP requests to the
 // collector c as "HTTPServer"-schema events.
+//
+// Unless conf.DisableContext is set, the request passed to the next
+// handler carries the span's Recorder in its context, so that handlers
+// can use appdash.SpanIDFromContext or
+// appdash.NewChildRecorderFromContext to trace their own operations as
+// part of the request. Handlers may record events on the Recorder
+// returned by appdash.RecorderFromContext, but must not call its Finish
+// method; the middleware does so once the next handler returns.
 func Middleware(c appdash.Collector, conf *MiddlewareConfig) func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
 	return func(rw http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
-		spanID, spanFromHeader, err := getSpanID(r.Header)
-		if err != nil {
-			log.Printf("Warning: invalid %s header: %s. (Continuing with request handling.)", spanFromHeader, err)
+		var (
+			spanID              *appdash.SpanID
+			usingProvidedSpanID bool
+		)
+		if conf.Propagators == nil {
+			var (
+				spanFromHeader string
+				err            error
+			)
+			spanID, spanFromHeader, err = getSpanID(r.Header)
+			if err != nil {
+				log.Printf("Warning: invalid %s header: %s. (Continuing with request handling.)", spanFromHeader, err)
+				newSpanID := appdash.NewRootSpanID()
+				spanID = &newSpanID
+			}
+			usingProvidedSpanID = err == nil && spanFromHeader == HeaderSpanID
+		} else {
+			ctx, span, shared := extractSpan(r, conf.Propagators)
+			r = r.WithContext(ctx)
+			spanID, usingProvidedSpanID = &span, shared
 		}
-		usingProvidedSpanID := (spanFromHeader == HeaderSpanID)
 
+		rec := appdash.NewRecorder(*spanID, c)
+		if !conf.DisableContext {
+			r = r.WithContext(appdash.NewContextWithRecorder(r.Context(), rec))
+		}
 		if conf.SetContextSpan != nil {
 			conf.SetContextSpan(r, *spanID)
 		}
@@ -95,8 +133,10 @@ func Middleware(c appdash.Collector, conf *MiddlewareConfig) func(rw http.Respon
 		}
 		e.Response = responseInfo(rr.partialResponse())
 		e.ServerSend = time.Now()
+		if e.Response.StatusCode >= 500 && !rec.Failed() {
+			rec.Event(statusError(e.Response.StatusCode))
+		}
 
-		rec := appdash.NewRecorder(*spanID, c)
 		if e.Route != "" {
 			rec.Name("Serve " + e.Route)
 		} else {
@@ -121,7 +161,24 @@ type MiddlewareConfig struct {
 	// either taken from the client request header or created anew) in
 	// the HTTP request context, so it may be used by other parts of
 	// the handling process.
+	//
+	// It is only needed for applications that store request-scoped
+	// values elsewhere than in the request's context.Context, which
+	// carries the span unless DisableContext is set.
 	SetContextSpan func(*http.Request, appdash.SpanID)
+
+	// DisableContext, if true, prevents the middleware from adding the
+	// span's Recorder to the request's context.Context.
+	DisableContext bool
+
+	// Propagators, if non-nil, are the propagators used to extract the
+	// span from the request's headers, in order of priority: the span is
+	// taken from the first propagator whose headers are present. If no
+	// propagator's headers are present, a new root span is created.
+	//
+	// If nil, the span is taken from the Span-ID or Parent-Span-ID headers
+	// (see GetSpanID).
+	Propagators []Propagator
 }
 
 // responseInfoRecorder is an http.ResponseWriter that records a
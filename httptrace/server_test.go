@@ -1,6 +1,7 @@
 package httptrace
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -168,6 +169,56 @@ func TestMiddleware_createNewSpan(t *testing.T) {
 	}
 }
 
+func TestMiddleware_contextSpan(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	c := appdash.NewLocalCollector(ms)
+
+	req, _ := http.NewRequest("GET", "http://example.com/foo", nil)
+	spanID := appdash.SpanID{1, 2, 3}
+	SetSpanIDHeader(req.Header, spanID)
+
+	var (
+		contextSpan appdash.SpanID
+		child       *appdash.Recorder
+	)
+	mw := Middleware(c, &MiddlewareConfig{})
+	mw(httptest.NewRecorder(), req, func(w http.ResponseWriter, r *http.Request) {
+		var ok bool
+		contextSpan, ok = appdash.SpanIDFromContext(r.Context())
+		if !ok {
+			t.Fatal("request context carries no span")
+		}
+		appdash.RecorderFromContext(r.Context()).Msg("msg")
+		child = appdash.NewChildRecorderFromContext(r.Context(), nil)
+		child.Finish()
+	})
+
+	if contextSpan != spanID {
+		t.Errorf("got context span %v, want %v", contextSpan, spanID)
+	}
+	if child.Trace != spanID.Trace || child.Parent != spanID.Span {
+		t.Errorf("got child span %v, want a child of %v", child.SpanID, spanID)
+	}
+	trace, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg := trace.Span.Annotations.StringMap()["Msg"]; msg != "msg" {
+		t.Errorf("got Msg event %q recorded on the context Recorder, want %q", msg, "msg")
+	}
+	if len(trace.Sub) != 1 || trace.Sub[0].Span.ID != child.SpanID {
+		t.Errorf("got sub-traces %v, want child span %v", trace.Sub, child.SpanID)
+	}
+
+	t.Log("DisableContext")
+	mw = Middleware(c, &MiddlewareConfig{DisableContext: true})
+	mw(httptest.NewRecorder(), req, func(w http.ResponseWriter, r *http.Request) {
+		if span, ok := appdash.SpanIDFromContext(r.Context()); ok {
+			t.Errorf("got context span %v, want none", span)
+		}
+	})
+}
+
 func TestServerEvent_unmarshal(t *testing.T) {
 	m := map[string]string{
 		"":                                "/foo",
@@ -214,6 +265,61 @@ func TestServerEvent_unmarshal(t *testing.T) {
 	}
 }
 
+func TestMiddleware_error(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	c := appdash.NewLocalCollector(ms)
+	var spanID appdash.SpanID
+	mw := Middleware(c, &MiddlewareConfig{
+		SetContextSpan: func(r *http.Request, id appdash.SpanID) { spanID = id },
+	})
+
+	for _, test := range []struct {
+		handler     http.HandlerFunc
+		wantFailed  bool
+		wantMessage string
+	}{
+		{
+			handler: func(w http.ResponseWriter, r *http.Request) {},
+		},
+		{
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			},
+			wantFailed:  true,
+			wantMessage: "503 Service Unavailable",
+		},
+		{
+			// Errors recorded by the handler take precedence.
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				appdash.RecorderFromContext(r.Context()).Fail(errors.New("boom"))
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			wantFailed:  true,
+			wantMessage: "boom",
+		},
+	} {
+		req, _ := http.NewRequest("GET", "http://example.com/foo", nil)
+		mw(httptest.NewRecorder(), req, test.handler)
+		trace, err := ms.Trace(spanID.Trace)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := trace.Span.Failed(); got != test.wantFailed {
+			t.Errorf("got failed %v, want %v", got, test.wantFailed)
+		}
+		if !test.wantFailed {
+			continue
+		}
+		var e appdash.ErrorEvent
+		if err := appdash.UnmarshalEvent(trace.Span.Annotations, &e); err != nil {
+			t.Fatal(err)
+		}
+		if e.Message != test.wantMessage {
+			t.Errorf("got error message %q, want %q", e.Message, test.wantMessage)
+		}
+	}
+}
+
 func mapToAnnotations(m map[string]string) appdash.Annotations {
 	anns := make(appdash.Annotations, 0, len(m))
 	for k, v := range m {
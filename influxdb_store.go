@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -255,17 +256,29 @@ func mustJSONInt64(x interface{}) int64 {
 	return v
 }
 
-// Aggregate implements the Aggregator interface.
-func (in *InfluxDBStore) Aggregate(start, end time.Duration) ([]*AggregatedResult, error) {
+// Aggregate implements the Aggregator interface. Aggregating root spans or
+// all spans by name uses the data downsampled by continuous queries (see the
+// createContinuousQueries method), and so is fast; aggregations grouped by
+// other annotations query every span within the time range.
+func (in *InfluxDBStore) Aggregate(opts AggregateOpts) ([]*AggregatedResult, error) {
+	if opts.GroupBy != "" {
+		return in.aggregateSpans(opts)
+	}
+	downsampled, nslowest := "downsampled_root_spans", "nslowest_root_spans"
+	if opts.AllSpans {
+		downsampled, nslowest = "downsampled_spans", "nslowest_spans"
+	}
+
 	// Find the mean (average), minimum, maximum, std. deviation, and count of
-	// all spans. For details on how this works see the createContinuousQueries
-	// method.
-	q := `SELECT MEAN("mean"),MIN("min"),MAX("max"),MEAN("stddev"),SUM("count") from downsampled_spans`
-	q += fmt.Sprintf(
+	// the spans. For details on how this works see the
+	// createContinuousQueries method.
+	now := time.Now()
+	where := fmt.Sprintf(
 		" WHERE time >= '%s' AND time <= '%s'",
-		time.Now().Add(start).UTC().Format(time.RFC3339Nano),
-		time.Now().Add(end).UTC().Format(time.RFC3339Nano),
+		now.Add(opts.Start).UTC().Format(time.RFC3339Nano),
+		now.Add(opts.End).UTC().Format(time.RFC3339Nano),
 	)
+	q := `SELECT MEAN("mean"),MIN("min"),MAX("max"),MEAN("stddev"),SUM("count") from ` + downsampled + where
 	q += ` GROUP BY "name"`
 	result, err := in.executeOneQuery(q)
 	if err != nil {
@@ -274,6 +287,7 @@ func (in *InfluxDBStore) Aggregate(start, end time.Duration) ([]*AggregatedResul
 
 	// Populate the results.
 	results := make([]*AggregatedResult, len(result.Series))
+	byName := make(map[string]*AggregatedResult, len(result.Series))
 	for i, row := range result.Series {
 		v := row.Values[0]
 		mean, min, max, stddev, count := v[1], v[2], v[3], v[4], v[5]
@@ -285,33 +299,58 @@ func (in *InfluxDBStore) Aggregate(start, end time.Duration) ([]*AggregatedResul
 
 		results[i] = &AggregatedResult{
 			RootSpanName: row.Tags["name"],
+			Group:        row.Tags["name"],
 			Average:      time.Duration(mustJSONFloat64(mean) * float64(time.Second)),
 			Min:          time.Duration(mustJSONFloat64(min) * float64(time.Second)),
 			Max:          time.Duration(mustJSONFloat64(max) * float64(time.Second)),
 			StdDev:       time.Duration(mustJSONFloat64(stddev) * float64(time.Second)),
 			Samples:      mustJSONInt64(count),
 		}
+		byName[row.Tags["name"]] = results[i]
 	}
 	if len(result.Series) == 0 {
 		return nil, nil
 	}
 
-	n := 5
-	if n > len(result.Series) {
-		n = len(result.Series)
+	// The downsampled data only has the moments of the durations, so
+	// estimate their distributions from the spans themselves.
+	q = `SELECT "duration" FROM spans` + where
+	if !opts.AllSpans {
+		q += fmt.Sprintf(" AND parent_id='%s'", zeroID)
+	}
+	q += ` GROUP BY "name"`
+	result, err = in.executeOneQuery(q)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range result.Series {
+		r, ok := byName[row.Tags["name"]]
+		if !ok {
+			continue
+		}
+		var sketch DurationSketch
+		for _, v := range row.Values {
+			if v[1] != nil {
+				sketch.Add(time.Duration(mustJSONFloat64(v[1]) * float64(time.Second)))
+			}
+		}
+		r.setDistribution(&sketch)
 	}
 
 	// Add in the N-slowest trace IDs for each span.
 	//
 	// TODO(slimsag): make N a pagination parameter instead.
-	q = fmt.Sprintf(`SELECT TOP("top",%d),trace_id FROM nslowest_spans GROUP BY "name"`, n)
+	q = fmt.Sprintf(`SELECT TOP("top",%d),trace_id FROM %s GROUP BY "name"`, defaultSlowest, nslowest)
 	result, err = in.executeOneQuery(q)
 	if err != nil {
 		return nil, err
 	}
-	for rowIndex, row := range result.Series {
-		if row.Tags["name"] != results[rowIndex].RootSpanName {
-			panic("expectation violated") // never happens, just for sanity.
+	for _, row := range result.Series {
+		// The slowest spans are not restricted to the time range, so they
+		// may have names that no span within it has.
+		r, ok := byName[row.Tags["name"]]
+		if !ok {
+			continue
 		}
 		for _, fields := range row.Values {
 			for i, field := range fields {
@@ -321,7 +360,7 @@ func (in *InfluxDBStore) Aggregate(start, end time.Duration) ([]*AggregatedResul
 					if err != nil {
 						panic(err) // never happens, just for sanity.
 					}
-					results[rowIndex].Slowest = append(results[rowIndex].Slowest, id)
+					r.Slowest = append(r.Slowest, id)
 				}
 			}
 		}
@@ -329,8 +368,81 @@ func (in *InfluxDBStore) Aggregate(start, end time.Duration) ([]*AggregatedResul
 	return results, nil
 }
 
+// aggregateSpans aggregates the spans within the time range of opts, which
+// unlike the downsampled data can be grouped by any annotation and
+// restricted to root spans.
+func (in *InfluxDBStore) aggregateSpans(opts AggregateOpts) ([]*AggregatedResult, error) {
+	key := opts.GroupBy
+	if key == "" {
+		key = "Name" // the span name annotation
+	}
+	q := fmt.Sprintf(
+		`SELECT "duration",trace_id,"%s" FROM spans WHERE time >= '%s' AND time <= '%s'`,
+		influxDBEscapeIdentifier(key),
+		time.Now().Add(opts.Start).UTC().Format(time.RFC3339Nano),
+		time.Now().Add(opts.End).UTC().Format(time.RFC3339Nano),
+	)
+	if !opts.AllSpans {
+		q += fmt.Sprintf(" AND parent_id='%s'", zeroID)
+	}
+	result, err := in.executeOneQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	byGroup := make(map[string]*aggStats)
+	for _, row := range result.Series {
+		columns := make(map[string]int, len(row.Columns))
+		for i, c := range row.Columns {
+			columns[c] = i
+		}
+		for _, v := range row.Values {
+			// Only spans with both a name and a duration have the duration
+			// field (see the Collect method).
+			duration := v[columns["duration"]]
+			if duration == nil {
+				continue
+			}
+			id, err := ParseID(v[columns["trace_id"]].(string))
+			if err != nil {
+				return nil, err
+			}
+			// Spans without the annotation are not grouped by it.
+			var group string
+			if i, ok := columns[key]; ok {
+				group, _ = v[i].(string)
+			}
+			if group == "" {
+				continue
+			}
+			stats, ok := byGroup[group]
+			if !ok {
+				stats = &aggStats{}
+				byGroup[group] = stats
+			}
+			stats.add(id, time.Duration(mustJSONFloat64(duration)*float64(time.Second)), defaultSlowest)
+		}
+	}
+
+	results := make([]*AggregatedResult, 0, len(byGroup))
+	for group, stats := range byGroup {
+		r := stats.result(group)
+		if opts.GroupBy == "" {
+			r.RootSpanName = group
+		}
+		results = append(results, r)
+	}
+	sort.Sort(aggregatedResultsByGroup(results))
+	return results, nil
+}
+
+// Traces implements the Queryer interface. Filters on the root span's time,
+// trace ID and name are performed by InfluxDB, while the remaining filters of
+// opts are applied to the queried traces, which are then queried a page at
+// a time. Unless another order is specified, traces are returned most recent
+// first. If opts.Limit is 0, at most a page of traces is returned when
+// InfluxDB can paginate them, and all matching traces otherwise.
 func (in *InfluxDBStore) Traces(opts TracesOpts) ([]*Trace, error) {
-	traces := make([]*Trace, 0)
 	rootSpansQuery := fmt.Sprintf("SELECT * FROM spans WHERE parent_id='%s'", zeroID)
 
 	// Extends `rootSpansQuery` to add time range filter using the start/end values from `opts.Timespan`.
@@ -340,6 +452,13 @@ func (in *InfluxDBStore) Traces(opts TracesOpts) ([]*Trace, error) {
 		rootSpansQuery += fmt.Sprintf(" AND time >= '%s' AND time <= '%s'", start, end)
 	}
 
+	// Extends `rootSpansQuery` to add a filter to include only those traces whose root span has the name `opts.Name`.
+	// The "Name" field (the span name annotation) is used rather than the "name" tag, because the tag is only set
+	// for spans which also have a duration.
+	if opts.Name != "" {
+		rootSpansQuery += fmt.Sprintf(` AND "Name" = '%s'`, influxDBEscapeString(opts.Name))
+	}
+
 	// Extends `rootSpansQuery` to add a filter to include only those traces present in `opts.TraceIDs`.
 	traceIDsLen := len(opts.TraceIDs)
 	if traceIDsLen > 0 {
@@ -352,10 +471,72 @@ func (in *InfluxDBStore) Traces(opts TracesOpts) ([]*Trace, error) {
 			}
 		}
 		rootSpansQuery += ")"
-	} else { // Otherwise continue limiting the number of traces to be returned.
-		rootSpansQuery += fmt.Sprintf(" LIMIT %d", in.tracesPerPage)
 	}
 
+	// If every filter was expressed in the query above, InfluxDB can order
+	// and paginate the root spans itself.
+	filterOpts := opts
+	filterOpts.Timespan, filterOpts.TraceIDs, filterOpts.Name = Timespan{}, nil, ""
+	if opts.Order == OrderDefault {
+		filterOpts.Order = OrderNewest
+	}
+	if influxDBQueryable(filterOpts) {
+		rootSpansQuery += " ORDER BY time"
+		if filterOpts.Order == OrderNewest {
+			rootSpansQuery += " DESC"
+		}
+		limit := opts.Limit
+		if limit == 0 && traceIDsLen == 0 {
+			// Otherwise continue limiting the number of traces to be returned.
+			limit = in.tracesPerPage
+		}
+		if limit > 0 {
+			rootSpansQuery += fmt.Sprintf(" LIMIT %d", limit)
+		}
+		if opts.Offset > 0 {
+			rootSpansQuery += fmt.Sprintf(" OFFSET %d", opts.Offset)
+		}
+		traces, err := in.tracesOfRootSpans(rootSpansQuery)
+		if err != nil {
+			return nil, err
+		}
+		filterOpts.Offset, filterOpts.Limit = 0, 0
+		return filterTraces(traces, filterOpts)
+	}
+
+	// Otherwise the root spans are queried a page of tracesPerPage at a
+	// time, and their traces filtered, until the requested page of traces
+	// is complete. Traces in order of duration can only be paginated once
+	// all matching traces were read.
+	rootSpansQuery += " ORDER BY time"
+	if filterOpts.Order != OrderOldest {
+		rootSpansQuery += " DESC"
+	}
+	byTime := filterOpts.Order == OrderNewest || filterOpts.Order == OrderOldest
+	pageOpts := filterOpts
+	pageOpts.Offset, pageOpts.Limit = 0, 0
+	var matched []*Trace
+	for offset := 0; ; offset += in.tracesPerPage {
+		page, err := in.tracesOfRootSpans(fmt.Sprintf("%s LIMIT %d OFFSET %d", rootSpansQuery, in.tracesPerPage, offset))
+		if err != nil {
+			return nil, err
+		}
+		m, err := filterTraces(page, pageOpts)
+		if err != nil {
+			return nil, err
+		}
+		matched = append(matched, m...)
+		if len(page) < in.tracesPerPage || (byTime && opts.Limit > 0 && len(matched) >= opts.Offset+opts.Limit) {
+			break
+		}
+	}
+	return filterTraces(matched, filterOpts)
+}
+
+// tracesOfRootSpans returns the traces of the root spans selected by the
+// given query, in no particular order.
+func (in *InfluxDBStore) tracesOfRootSpans(rootSpansQuery string) ([]*Trace, error) {
+	traces := make([]*Trace, 0)
 	rootSpansResult, err := in.executeOneQuery(rootSpansQuery)
 	if err != nil {
 		return nil, err
@@ -412,9 +593,15 @@ func (in *InfluxDBStore) Traces(opts TracesOpts) ([]*Trace, error) {
 	// Cache to keep track all trace children of root traces to be returned.
 	children := make(map[ID][]*Trace, 0) // Span.ID.Trace -> []*Trace
 
-	childrenSpans, err := spansFromRow(childrenSpansResult.Series[0])
-	if err != nil {
-		return nil, err
+	if childrenSpansResult.Err != nil {
+		return nil, childrenSpansResult.Err
+	}
+	var childrenSpans []*Span
+	if len(childrenSpansResult.Series) > 0 {
+		childrenSpans, err = spansFromRow(childrenSpansResult.Series[0])
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Iterates over `childrenSpans` to fill `children` cache.
@@ -446,6 +633,38 @@ func (in *InfluxDBStore) Traces(opts TracesOpts) ([]*Trace, error) {
 	return traces, nil
 }
 
+// influxDBQueryable reports whether the given options, excluding those which
+// are expressed in the root spans query by InfluxDBStore.Traces, need no
+// further filtering -- i.e. whether InfluxDB can order and paginate the
+// results itself.
+func influxDBQueryable(opts TracesOpts) bool {
+	if opts.Order != OrderNewest && opts.Order != OrderOldest {
+		return false
+	}
+	return opts.MinDuration == 0 && opts.MaxDuration == 0 &&
+		len(opts.annotationFilters()) == 0 && opts.Errors == AnyErrors
+}
+
+// influxDBEscapeString escapes s for use as a single-quoted string literal
+// in an InfluxDB query.
+func influxDBEscapeString(s string) string {
+	return influxDBStringEscaper.Replace(s)
+}
+
+// influxDBStringEscaper escapes backslashes, and then the single quotes that
+// would otherwise end a string literal.
+var influxDBStringEscaper = strings.NewReplacer(`\`, `\\`, "'", `\'`)
+
+// influxDBEscapeIdentifier escapes s for use as a double-quoted identifier in
+// an InfluxDB query.
+func influxDBEscapeIdentifier(s string) string {
+	return influxDBIdentifierEscaper.Replace(s)
+}
+
+// influxDBIdentifierEscaper escapes backslashes, and then the double quotes
+// that would otherwise end an identifier.
+var influxDBIdentifierEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
 // Close flushes the last batch to InfluxDB and shuts down the InfluxDBStore.
 func (in *InfluxDBStore) Close() error {
 	close(in.flusherStopChan)
@@ -512,6 +731,17 @@ func (in *InfluxDBStore) createContinuousQueries() error {
 	// timeframe (1 N-slowest trace over 1m), but this is good enough in
 	// general.
 	q = fmt.Sprintf(`CREATE CONTINUOUS QUERY cq_nslowest_spans_1m ON %s RESAMPLE EVERY 1m BEGIN SELECT TOP("duration",1),trace_id,span_id,parent_id INTO nslowest_spans FROM spans GROUP BY time(1m), "name" END`, in.dbName)
+	if err := in.executeQueryNoResults(q); err != nil {
+		return err
+	}
+
+	// The same again for only the root spans, which the Dashboard shows by
+	// default.
+	q = fmt.Sprintf(`CREATE CONTINUOUS QUERY cq_downsampled_root_spans_1m ON %s RESAMPLE EVERY 1m BEGIN SELECT MEAN("duration"),MIN("duration"),MAX("duration"),STDDEV("duration"),COUNT("duration") INTO downsampled_root_spans FROM spans WHERE parent_id='%s' GROUP BY time(1m), "name" END`, in.dbName, zeroID)
+	if err := in.executeQueryNoResults(q); err != nil {
+		return err
+	}
+	q = fmt.Sprintf(`CREATE CONTINUOUS QUERY cq_nslowest_root_spans_1m ON %s RESAMPLE EVERY 1m BEGIN SELECT TOP("duration",1),trace_id,span_id,parent_id INTO nslowest_root_spans FROM spans WHERE parent_id='%s' GROUP BY time(1m), "name" END`, in.dbName, zeroID)
 	return in.executeQueryNoResults(q)
 }
 
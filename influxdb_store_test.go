@@ -5,6 +5,9 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/services/continuous_querier"
 )
 
 func TestAddChildren(t *testing.T) {
@@ -208,6 +211,15 @@ func TestInfluxDBStore(t *testing.T) {
 				},
 			},
 		},
+		&Trace{
+			Span: Span{
+				ID: SpanID{3, 300, 0},
+				Annotations: Annotations{
+					Annotation{Key: "Name", Value: []byte(`x\' OR "Name" != '`)},
+					Annotation{Key: "_schema:name"},
+				},
+			},
+		},
 	}
 
 	var (
@@ -281,6 +293,160 @@ func TestInfluxDBStore(t *testing.T) {
 		}
 		mustBeEqual(gotTrace, want)
 	}
+
+	// InfluxDBStore.Traces(...) filtering tests.
+	filterCases := []struct {
+		opts TracesOpts
+		want int
+	}{
+		{TracesOpts{TraceIDs: []ID{2}}, 1},
+		{TracesOpts{Name: "/"}, 2},
+		{TracesOpts{Name: "/missing"}, 0},
+		{TracesOpts{Name: `x\' OR "Name" != '`}, 1},
+		{TracesOpts{Name: `x\`}, 0},
+		{TracesOpts{Limit: 1}, 1},
+		{TracesOpts{Offset: 1, Limit: 1}, 1},
+		{TracesOpts{Annotations: map[string]string{"Name": "localhost:8699/sub2"}}, 1},
+		{TracesOpts{Errors: WithErrors}, 0},
+	}
+	for i, c := range filterCases {
+		gotTraces, err := store.Traces(c.opts)
+		if err != nil {
+			t.Fatalf("case %d: unexpected error: %+v", i, err)
+		}
+		if len(gotTraces) != c.want {
+			t.Fatalf("case %d: unexpected quantity of traces, got: %v, want: %v", i, len(gotTraces), c.want)
+		}
+		for _, gotTrace := range gotTraces {
+			want, found := tracesMap[gotTrace.ID.Trace]
+			if !found {
+				t.Fatal("trace not found")
+			}
+			mustBeEqual(gotTrace, want)
+		}
+	}
+
+	// Traces that InfluxDB can't filter are queried a page at a time.
+	store.tracesPerPage = 1
+	pagedCases := []struct {
+		opts TracesOpts
+		want int
+	}{
+		{TracesOpts{Annotations: map[string]string{"Name": "/"}}, 2},
+		{TracesOpts{Annotations: map[string]string{"Name": "/"}, Limit: 1}, 1},
+		{TracesOpts{Annotations: map[string]string{"Name": "/"}, Offset: 1, Limit: 5}, 1},
+		{TracesOpts{Annotations: map[string]string{"Name": "/"}, Offset: 2, Limit: 1}, 0},
+		{TracesOpts{Order: OrderSlowest, Offset: 1}, 2},
+		{TracesOpts{Order: OrderOldest, Errors: WithoutErrors, Limit: 2}, 2},
+	}
+	for i, c := range pagedCases {
+		gotTraces, err := store.Traces(c.opts)
+		if err != nil {
+			t.Fatalf("paged case %d: unexpected error: %+v", i, err)
+		}
+		if len(gotTraces) != c.want {
+			t.Fatalf("paged case %d: unexpected quantity of traces, got: %v, want: %v", i, len(gotTraces), c.want)
+		}
+		for _, gotTrace := range gotTraces {
+			want, found := tracesMap[gotTrace.ID.Trace]
+			if !found {
+				t.Fatal("trace not found")
+			}
+			mustBeEqual(gotTrace, want)
+		}
+	}
+}
+
+func TestInfluxDBStore_Aggregate(t *testing.T) {
+	store, err := newTestInfluxDBStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	now := time.Now()
+	collect := func(id SpanID, name string, d time.Duration, anns ...Annotation) {
+		for _, e := range []Event{spanName{name}, Timespan{S: now, E: now.Add(d)}} {
+			as, err := MarshalEvent(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			anns = append(anns, as...)
+		}
+		if err := store.Collect(id, anns...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	route := func(r string) Annotation { return Annotation{Key: "Server.Route", Value: []byte(r)} }
+	collect(SpanID{1, 1, 0}, "/a", 100*time.Millisecond, route("a"))
+	collect(SpanID{1, 2, 1}, "query", 50*time.Millisecond, Annotation{Key: "Tag", Value: []byte("users")})
+	collect(SpanID{2, 3, 0}, "/a", 300*time.Millisecond, route("a"))
+	collect(SpanID{3, 4, 0}, "/b", 1*time.Second)
+	if err := store.flush(); err != nil {
+		t.Fatal(err)
+	}
+	runContinuousQueries(t, store, now)
+
+	for _, c := range []struct {
+		opts    AggregateOpts
+		want    []string
+		samples []int64
+	}{
+		{AggregateOpts{Start: -time.Hour, End: time.Hour}, []string{"/a", "/b"}, []int64{2, 1}},
+		{AggregateOpts{Start: -time.Hour, End: time.Hour, AllSpans: true}, []string{"/a", "/b", "query"}, []int64{2, 1, 1}},
+		{AggregateOpts{Start: -time.Hour, End: time.Hour, GroupBy: "Server.Route"}, []string{"a"}, []int64{2}},
+		{AggregateOpts{Start: -time.Hour, End: time.Hour, GroupBy: "Tag", AllSpans: true}, []string{"users"}, []int64{1}},
+		{AggregateOpts{Start: -time.Hour, End: time.Hour, GroupBy: "Missing"}, nil, nil},
+		{AggregateOpts{Start: -time.Hour, End: time.Hour, GroupBy: `Tag\" FROM spans --`}, nil, nil},
+	} {
+		results, err := store.Aggregate(c.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var groups []string
+		var samples []int64
+		for _, r := range results {
+			groups = append(groups, r.Group)
+			samples = append(samples, r.Samples)
+
+			// The percentiles are estimated from the same spans.
+			if r.Sketch == nil || r.Sketch.Count() != r.Samples || len(r.Histogram) == 0 {
+				t.Errorf("%+v: group %q: got sketch %v and histogram %v, want %d samples", c.opts, r.Group, r.Sketch, r.Histogram, r.Samples)
+			} else if r.P50 < r.Min*98/100 || r.P99 > r.Max*102/100 || r.P50 > r.P99 {
+				t.Errorf("%+v: group %q: got percentiles %s/%s/%s/%s, want them within %s-%s", c.opts, r.Group, r.P50, r.P90, r.P95, r.P99, r.Min, r.Max)
+			}
+		}
+		if !reflect.DeepEqual(groups, c.want) || !reflect.DeepEqual(samples, c.samples) {
+			t.Errorf("%+v: got groups %q with samples %v, want %q with %v", c.opts, groups, samples, c.want, c.samples)
+		}
+	}
+}
+
+// runContinuousQueries downsamples the spans collected by the store in the
+// minute of the given time, as its continuous queries do once the minute has
+// passed.
+func runContinuousQueries(t *testing.T, store *InfluxDBStore, now time.Time) {
+	for _, svc := range store.server.Services {
+		cqs, ok := svc.(*continuous_querier.Service)
+		if !ok {
+			continue
+		}
+		dbi, err := cqs.MetaClient.Database(store.dbName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range dbi.ContinuousQueries {
+			if err := cqs.ExecuteContinuousQuery(dbi, &dbi.ContinuousQueries[i], now.Truncate(time.Minute).Add(time.Minute)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+	t.Fatal("no continuous query service")
 }
 
 func benchmarkInfluxDBStoreCollect(b *testing.B, n int) {
@@ -10,6 +10,12 @@ It is generated from these files:
 
 It has these top-level messages:
 	CollectPacket
+	Hello
+	Metadata
+	HelloAck
+	Batch
+	BatchPayload
+	Ack
 */
 package wire
 
@@ -114,3 +120,201 @@ func (m *CollectPacket_Annotation) GetValue() []byte {
 	}
 	return nil
 }
+
+// Hello is the first message sent by a client, describing itself and the
+// features it supports.
+type Hello struct {
+	// version is the latest version of the protocol that the client supports.
+	Version *uint32 `protobuf:"varint,1,req,name=version" json:"version,omitempty"`
+	// client is the name and version of the client library.
+	Client *string `protobuf:"bytes,2,opt,name=client" json:"client,omitempty"`
+	// hostname and pid identify the client's process.
+	Hostname *string `protobuf:"bytes,3,opt,name=hostname" json:"hostname,omitempty"`
+	Pid      *int64  `protobuf:"varint,4,opt,name=pid" json:"pid,omitempty"`
+	// compression is the compression algorithms that the client supports
+	// for batches, in order of preference.
+	Compression []string `protobuf:"bytes,5,rep,name=compression" json:"compression,omitempty"`
+	// metadata is any other information about the client, such as the
+	// attributes of the service that it records spans for.
+	Metadata         []*Metadata `protobuf:"bytes,6,rep,name=metadata" json:"metadata,omitempty"`
+	XXX_unrecognized []byte      `json:"-"`
+}
+
+func (m *Hello) Reset()         { *m = Hello{} }
+func (m *Hello) String() string { return proto.CompactTextString(m) }
+func (*Hello) ProtoMessage()    {}
+
+func (m *Hello) GetVersion() uint32 {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return 0
+}
+
+func (m *Hello) GetClient() string {
+	if m != nil && m.Client != nil {
+		return *m.Client
+	}
+	return ""
+}
+
+func (m *Hello) GetHostname() string {
+	if m != nil && m.Hostname != nil {
+		return *m.Hostname
+	}
+	return ""
+}
+
+func (m *Hello) GetPid() int64 {
+	if m != nil && m.Pid != nil {
+		return *m.Pid
+	}
+	return 0
+}
+
+func (m *Hello) GetCompression() []string {
+	if m != nil {
+		return m.Compression
+	}
+	return nil
+}
+
+func (m *Hello) GetMetadata() []*Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// Metadata is a key-value pair of client metadata.
+type Metadata struct {
+	Key              *string `protobuf:"bytes,1,req,name=key" json:"key,omitempty"`
+	Value            *string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Metadata) Reset()         { *m = Metadata{} }
+func (m *Metadata) String() string { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()    {}
+
+func (m *Metadata) GetKey() string {
+	if m != nil && m.Key != nil {
+		return *m.Key
+	}
+	return ""
+}
+
+func (m *Metadata) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+// HelloAck is the server's reply to a Hello.
+type HelloAck struct {
+	// version is the version of the protocol that the server chose.
+	Version *uint32 `protobuf:"varint,1,req,name=version" json:"version,omitempty"`
+	// compression is the compression algorithm that the client may use for
+	// batches, if any.
+	Compression      *string `protobuf:"bytes,2,opt,name=compression" json:"compression,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *HelloAck) Reset()         { *m = HelloAck{} }
+func (m *HelloAck) String() string { return proto.CompactTextString(m) }
+func (*HelloAck) ProtoMessage()    {}
+
+func (m *HelloAck) GetVersion() uint32 {
+	if m != nil && m.Version != nil {
+		return *m.Version
+	}
+	return 0
+}
+
+func (m *HelloAck) GetCompression() string {
+	if m != nil && m.Compression != nil {
+		return *m.Compression
+	}
+	return ""
+}
+
+// Batch is a batch of spans sent by a client.
+type Batch struct {
+	// sequence is the number of the batch, which the server acknowledges.
+	Sequence *uint64 `protobuf:"varint,1,req,name=sequence" json:"sequence,omitempty"`
+	// compression is the algorithm that payload is compressed with, if any.
+	Compression *string `protobuf:"bytes,2,opt,name=compression" json:"compression,omitempty"`
+	// payload is a BatchPayload, compressed if compression is set.
+	Payload          []byte `protobuf:"bytes,3,req,name=payload" json:"payload,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Batch) Reset()         { *m = Batch{} }
+func (m *Batch) String() string { return proto.CompactTextString(m) }
+func (*Batch) ProtoMessage()    {}
+
+func (m *Batch) GetSequence() uint64 {
+	if m != nil && m.Sequence != nil {
+		return *m.Sequence
+	}
+	return 0
+}
+
+func (m *Batch) GetCompression() string {
+	if m != nil && m.Compression != nil {
+		return *m.Compression
+	}
+	return ""
+}
+
+func (m *Batch) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// BatchPayload is the spans of a Batch.
+type BatchPayload struct {
+	Packet           []*CollectPacket `protobuf:"bytes,1,rep,name=packet" json:"packet,omitempty"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *BatchPayload) Reset()         { *m = BatchPayload{} }
+func (m *BatchPayload) String() string { return proto.CompactTextString(m) }
+func (*BatchPayload) ProtoMessage()    {}
+
+func (m *BatchPayload) GetPacket() []*CollectPacket {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+// Ack is the server's reply to a Batch, once it has collected its spans.
+type Ack struct {
+	// sequence is the sequence of the batch.
+	Sequence *uint64 `protobuf:"varint,1,req,name=sequence" json:"sequence,omitempty"`
+	// error, if set, is why the server failed to collect the batch.
+	Error            *string `protobuf:"bytes,2,opt,name=error" json:"error,omitempty"`
+	XXX_unrecognized []byte  `json:"-"`
+}
+
+func (m *Ack) Reset()         { *m = Ack{} }
+func (m *Ack) String() string { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()    {}
+
+func (m *Ack) GetSequence() uint64 {
+	if m != nil && m.Sequence != nil {
+		return *m.Sequence
+	}
+	return 0
+}
+
+func (m *Ack) GetError() string {
+	if m != nil && m.Error != nil {
+		return *m.Error
+	}
+	return ""
+}
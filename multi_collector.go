@@ -0,0 +1,401 @@
+package appdash
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash/internal/wire"
+)
+
+// errNoCollectorServer is returned by MultiRemoteCollector when all of its
+// servers are failing.
+var errNoCollectorServer = errors.New("no collector server is available (all are failing)")
+
+// shardReplicas is the number of points of each server on the hash ring of
+// a MultiRemoteCollector that shards spans by trace.
+const shardReplicas = 100
+
+// multiDialTimeout is the DialTimeout of the collectors created by
+// NewMultiRemoteCollector, so that an unreachable server doesn't hold up
+// failing over to the next.
+const multiDialTimeout = 5 * time.Second
+
+// NewMultiRemoteCollector creates a collector that sends data to the first
+// available of several collector servers (created with NewServer). Its
+// collectors have a DialTimeout of 5 seconds.
+func NewMultiRemoteCollector(addrs []string) *MultiRemoteCollector {
+	m := newMultiRemoteCollector()
+	for _, addr := range addrs {
+		rc := NewRemoteCollector(addr)
+		rc.DialTimeout = multiDialTimeout
+		m.Collectors = append(m.Collectors, rc)
+	}
+	return m
+}
+
+// NewTLSMultiRemoteCollector creates a MultiRemoteCollector that uses TLS.
+func NewTLSMultiRemoteCollector(addrs []string, tlsConfig *tls.Config) *MultiRemoteCollector {
+	m := newMultiRemoteCollector()
+	for _, addr := range addrs {
+		rc := NewTLSRemoteCollector(addr, tlsConfig)
+		rc.DialTimeout = multiDialTimeout
+		m.Collectors = append(m.Collectors, rc)
+	}
+	return m
+}
+
+func newMultiRemoteCollector() *MultiRemoteCollector {
+	return &MultiRemoteCollector{
+		MinBackoff: time.Second,
+		MaxBackoff: time.Minute,
+	}
+}
+
+// A MultiRemoteCollector sends data to one of several collector servers,
+// failing over to the next when one fails.
+//
+// Servers are tried in order of preference: the order of Collectors, or, if
+// ShardByTrace is set, the order of a consistent hash ring starting from the
+// span's trace ID. A server that fails is not tried again until a backoff
+// elapses, which doubles with each consecutive failure, from MinBackoff up
+// to MaxBackoff.
+type MultiRemoteCollector struct {
+	// Collectors are the collectors of the servers to send data to, in order
+	// of preference. Their Spool is not used, and their Resource, which is
+	// only sent in the handshake with the server, is set to Resource if it
+	// is nil.
+	Collectors []*RemoteCollector
+
+	// ShardByTrace is whether to send all spans of a trace to the same
+	// server (while it is available), by choosing the server with a
+	// consistent hash of the trace ID. Adding or removing a server only
+	// moves the traces of that server.
+	ShardByTrace bool
+
+	// MinBackoff is how long to wait before trying a server again after it
+	// first fails.
+	//
+	// Default MinBackoff (set by NewMultiRemoteCollector) = time.Second.
+	MinBackoff time.Duration
+
+	// MaxBackoff is the longest to wait before trying a failing server
+	// again.
+	//
+	// Default MaxBackoff (set by NewMultiRemoteCollector) = time.Minute.
+	MaxBackoff time.Duration
+
+	// Resource, if non-nil, identifies the service that records the spans
+	// (see RemoteCollector.Resource).
+	Resource *Resource
+
+	// Spool, if non-nil, is where spans are kept while no server is
+	// available (see RemoteCollector.Spool).
+	Spool *Spool
+
+	// Log is the logger to use for errors and warnings. If nil, a new
+	// logger is created.
+	Log   *log.Logger
+	logMu sync.Mutex
+
+	mu     sync.Mutex       // guards the fields below
+	health []*serverHealth  // health of each of Collectors
+	ring   []shardRingPoint // hash ring of Collectors, sorted by hash
+}
+
+// serverHealth is the health of a server of a MultiRemoteCollector.
+type serverHealth struct {
+	failures int       // number of consecutive failures
+	retryAt  time.Time // when to try the server again, if failing
+	lastErr  error     // error of the last failure
+}
+
+// shardRingPoint is a point on the hash ring of a MultiRemoteCollector.
+type shardRingPoint struct {
+	hash   uint64
+	server int // index of the server in Collectors
+}
+
+// CollectorHealth is the health of a server of a MultiRemoteCollector.
+type CollectorHealth struct {
+	Addr     string    // address of the server
+	Healthy  bool      // whether the server is tried
+	Failures int       // number of consecutive failures
+	RetryAt  time.Time // when the server is tried again, if it is not healthy
+	LastErr  error     // the error of the last failure, if any
+}
+
+// Collect implements the Collector interface by sending the events that
+// occured in the span to one of the collector servers.
+func (m *MultiRemoteCollector) Collect(span SpanID, anns ...Annotation) error {
+	return m.CollectBatch([]Span{{ID: span, Annotations: anns}})
+}
+
+// CollectBatch implements the BatchCollector interface by sending the spans
+// to the collector servers. It fails if all of the servers that spans are
+// sent to fail (and they can't be spooled).
+func (m *MultiRemoteCollector) CollectBatch(spans []Span) error {
+	packets := make([]*wire.CollectPacket, len(spans))
+	for i, s := range spans {
+		packets[i] = newCollectPacket(s.ID, m.Resource.annotate(s.Annotations))
+	}
+	batches, err := splitBatch(packets)
+	if err != nil {
+		return err
+	}
+
+	if m.Spool != nil {
+		return m.Spool.collect(batches, m.send)
+	}
+	for _, b := range batches {
+		if err := m.send(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// send sends the batch of packets to the servers. If sharding by trace, the
+// packets of each server are sent separately, and if only some of them can't
+// be sent, send returns an *undeliveredError with those packets.
+func (m *MultiRemoteCollector) send(batch []*wire.CollectPacket) error {
+	if !m.ShardByTrace {
+		m.mu.Lock()
+		m.init()
+		m.mu.Unlock()
+		return m.sendTo(0, batch)
+	}
+
+	var (
+		shards  []int
+		byShard = map[int][]*wire.CollectPacket{}
+	)
+	m.mu.Lock()
+	m.init()
+	for _, p := range batch {
+		shard := m.shardNoLock(ID(p.Spanid.GetTrace()))
+		if _, present := byShard[shard]; !present {
+			shards = append(shards, shard)
+		}
+		byShard[shard] = append(byShard[shard], p)
+	}
+	m.mu.Unlock()
+
+	var (
+		errs        []error
+		undelivered []*wire.CollectPacket
+	)
+	for _, shard := range shards {
+		err := m.sendTo(shard, byShard[shard])
+		if err == nil {
+			continue
+		}
+		errs = append(errs, err)
+		if _, ok := err.(*ackError); !ok {
+			undelivered = append(undelivered, byShard[shard]...)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	err := errs[0]
+	if len(errs) > 1 {
+		err = fmt.Errorf("MultiRemoteCollector: multiple errors: %v", errs)
+	}
+	if undelivered != nil && len(undelivered) < len(batch) {
+		return &undeliveredError{err: err, packets: undelivered}
+	}
+	return err
+}
+
+// undeliveredError is the error of sending a batch of packets of which only
+// some were delivered. The others were collected by their servers, or failed
+// to be (see ackError), and must not be sent again.
+type undeliveredError struct {
+	err     error
+	packets []*wire.CollectPacket // the packets that weren't delivered
+}
+
+func (e *undeliveredError) Error() string {
+	return e.err.Error()
+}
+
+// sendTo sends the batch of packets to the first healthy server in order of
+// preference, beginning at the given position of the order.
+func (m *MultiRemoteCollector) sendTo(start int, batch []*wire.CollectPacket) error {
+	var err error
+	for _, i := range m.servers(start) {
+		rc := m.Collectors[i]
+		err = rc.sendBatch(batch)
+		if err == nil {
+			m.succeeded(i)
+			return nil
+		}
+		if _, ok := err.(*ackError); ok {
+			return err // the server is up, but failed to collect the batch
+		}
+		m.failed(i, err)
+	}
+	if err == nil {
+		err = errNoCollectorServer
+	}
+	return err
+}
+
+// init initializes the health and hash ring of the servers. It must be
+// called with m.mu held.
+func (m *MultiRemoteCollector) init() {
+	if len(m.health) == len(m.Collectors) {
+		return
+	}
+	m.health = make([]*serverHealth, len(m.Collectors))
+	m.ring = nil
+	for i, rc := range m.Collectors {
+		m.health[i] = &serverHealth{}
+		if rc.Resource == nil {
+			rc.Resource = m.Resource // for the handshake
+		}
+		for r := 0; r < shardReplicas; r++ {
+			h := fnv.New64a()
+			fmt.Fprintf(h, "%s#%d", rc.addr, r)
+			m.ring = append(m.ring, shardRingPoint{hash: mixHash(h.Sum64()), server: i})
+		}
+	}
+	sort.Sort(shardRingByHash(m.ring))
+}
+
+// shardNoLock returns the position on the hash ring of the trace. It must
+// be called with m.mu held.
+func (m *MultiRemoteCollector) shardNoLock(trace ID) int {
+	hash := mixHash(uint64(trace))
+	i := sort.Search(len(m.ring), func(i int) bool { return m.ring[i].hash >= hash })
+	if i == len(m.ring) {
+		i = 0
+	}
+	return i
+}
+
+// servers returns the indexes of the healthy servers, in order of
+// preference, beginning at the given position of the order (an index of
+// Collectors, or of the hash ring if sharding by trace).
+func (m *MultiRemoteCollector) servers(start int) []int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	var (
+		servers []int
+		seen    = make(map[int]bool, len(m.Collectors))
+	)
+	add := func(i int) {
+		if !seen[i] && !now.Before(m.health[i].retryAt) {
+			servers = append(servers, i)
+		}
+		seen[i] = true
+	}
+	if m.ShardByTrace {
+		for j := 0; j < len(m.ring) && len(seen) < len(m.Collectors); j++ {
+			add(m.ring[(start+j)%len(m.ring)].server)
+		}
+	} else {
+		for j := range m.Collectors {
+			add((start + j) % len(m.Collectors))
+		}
+	}
+	return servers
+}
+
+func (m *MultiRemoteCollector) succeeded(i int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h := m.health[i]
+	if h.failures > 0 {
+		m.log().Printf("Collector server %s recovered after %d failures", m.Collectors[i].addr, h.failures)
+	}
+	h.failures, h.retryAt, h.lastErr = 0, time.Time{}, nil
+}
+
+func (m *MultiRemoteCollector) failed(i int, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h := m.health[i]
+	h.failures++
+	h.lastErr = err
+	backoff := m.MinBackoff
+	for n := 1; n < h.failures && backoff < m.MaxBackoff; n++ {
+		backoff *= 2
+	}
+	if backoff > m.MaxBackoff {
+		backoff = m.MaxBackoff
+	}
+	h.retryAt = time.Now().Add(backoff)
+	m.log().Printf("Collector server %s failed (%s), retrying in %s", m.Collectors[i].addr, err, backoff)
+}
+
+// Health returns the health of each of the servers, in the order of
+// Collectors.
+func (m *MultiRemoteCollector) Health() []CollectorHealth {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.init()
+	now := time.Now()
+	health := make([]CollectorHealth, len(m.Collectors))
+	for i, rc := range m.Collectors {
+		h := m.health[i]
+		health[i] = CollectorHealth{
+			Addr:     rc.addr,
+			Healthy:  !now.Before(h.retryAt),
+			Failures: h.failures,
+			LastErr:  h.lastErr,
+		}
+		if !health[i].Healthy {
+			health[i].RetryAt = h.retryAt
+		}
+	}
+	return health
+}
+
+// Close closes the connections to the servers.
+func (m *MultiRemoteCollector) Close() error {
+	var err error
+	for _, rc := range m.Collectors {
+		if err2 := rc.Close(); err2 != nil && err == nil {
+			err = err2
+		}
+	}
+	return err
+}
+
+func (m *MultiRemoteCollector) log() *log.Logger {
+	m.logMu.Lock()
+	defer m.logMu.Unlock()
+	if m.Log == nil {
+		m.Log = log.New(os.Stderr, "MultiRemoteCollector: ", log.LstdFlags|log.Lmicroseconds)
+	}
+	return m.Log
+}
+
+// mixHash returns x with its bits mixed (by the finalizer of MurmurHash3),
+// so that similar values, such as small trace IDs or the FNV hashes of
+// similar strings, are spread evenly on the hash ring. It differs from
+// mixID, so that the traces kept by a ProbabilisticSampler are spread evenly
+// too.
+func mixHash(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
+
+type shardRingByHash []shardRingPoint
+
+func (v shardRingByHash) Len() int           { return len(v) }
+func (v shardRingByHash) Less(i, j int) bool { return v[i].hash < v[j].hash }
+func (v shardRingByHash) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
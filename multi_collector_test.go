@@ -0,0 +1,113 @@
+package appdash
+
+import (
+	"io/ioutil"
+	"log"
+	"net"
+	"testing"
+	"time"
+)
+
+// unusedAddr returns an address that nothing listens on.
+func unusedAddr(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func TestMultiRemoteCollector_failover(t *testing.T) {
+	rec := &packetRecorder{}
+	cs := startCollectorServer(t, rec)
+	defer cs.Close()
+
+	m := NewMultiRemoteCollector([]string{unusedAddr(t), cs.l.Addr().String()})
+	m.Log = log.New(ioutil.Discard, "", 0)
+	m.MinBackoff = time.Minute
+	m.MaxBackoff = time.Hour
+	defer m.Close()
+
+	for _, id := range []ID{1, 2} {
+		if err := m.Collect(SpanID{id, id, 0}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := rec.sorted(); len(got) != 2 {
+		t.Errorf("second server collected %v, want both spans", got)
+	}
+	h := m.Health()
+	if h[0].Healthy || h[0].Failures != 1 || h[0].LastErr == nil || !h[1].Healthy {
+		t.Errorf("got health %+v, want the first server to be failing once", h)
+	}
+
+	// Once the backoff elapses, the first server is tried again.
+	m.mu.Lock()
+	m.health[0].retryAt = time.Now()
+	m.mu.Unlock()
+	if err := m.Collect(SpanID{3, 3, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if h := m.Health(); h[0].Healthy || h[0].Failures != 2 || !h[0].RetryAt.After(time.Now().Add(m.MinBackoff)) {
+		t.Errorf("got health %+v, want the first server to back off longer", h)
+	}
+}
+
+func TestMultiRemoteCollector_allFailing(t *testing.T) {
+	m := NewMultiRemoteCollector([]string{unusedAddr(t), unusedAddr(t)})
+	m.Log = log.New(ioutil.Discard, "", 0)
+	if err := m.Collect(SpanID{1, 1, 0}); err == nil {
+		t.Fatal("got no error with no server available")
+	}
+	if err := m.Collect(SpanID{1, 1, 0}); err != errNoCollectorServer {
+		t.Errorf("got error %v, want %v while backing off", err, errNoCollectorServer)
+	}
+}
+
+func TestMultiRemoteCollector_shardByTrace(t *testing.T) {
+	var (
+		recs  []*packetRecorder
+		addrs []string
+	)
+	for i := 0; i < 3; i++ {
+		rec := &packetRecorder{}
+		cs := startCollectorServer(t, rec)
+		defer cs.Close()
+		recs = append(recs, rec)
+		addrs = append(addrs, cs.l.Addr().String())
+	}
+	m := NewMultiRemoteCollector(addrs)
+	m.ShardByTrace = true
+	defer m.Close()
+
+	var spans []Span
+	for trace := ID(1); trace <= 30; trace++ {
+		for span := ID(1); span <= 3; span++ {
+			spans = append(spans, Span{ID: SpanID{trace, span, 0}})
+		}
+	}
+	if err := m.CollectBatch(spans); err != nil {
+		t.Fatal(err)
+	}
+
+	// Each trace is collected by only one server, and each server collects
+	// some traces.
+	servers := map[ID]int{}
+	for i, rec := range recs {
+		got := rec.sorted()
+		if len(got) == 0 {
+			t.Errorf("server %d collected no spans", i)
+		}
+		for _, p := range got {
+			trace := ID(p.Spanid.GetTrace())
+			if s, present := servers[trace]; present && s != i {
+				t.Errorf("trace %v was collected by servers %d and %d", trace, s, i)
+			}
+			servers[trace] = i
+		}
+	}
+	if len(servers) != 30 {
+		t.Errorf("got %d traces collected, want 30", len(servers))
+	}
+}
@@ -0,0 +1,235 @@
+package otlp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+	"sourcegraph.com/sourcegraph/appdash/sqltrace"
+)
+
+// ResourceAttributePrefix is the prefix of the keys of the annotations that
+// hold the attributes of the resource (such as the service) that recorded a
+// span. For example, the OTLP service.name attribute becomes the
+// "Resource.service.name" annotation, which is the appdash.ServiceKey of
+// spans recorded with an appdash.Resource.
+const ResourceAttributePrefix = appdash.ResourcePrefix
+
+// collection is an appdash span converted from OTLP, ready to be collected.
+type collection struct {
+	span appdash.SpanID
+	anns appdash.Annotations
+}
+
+// convert converts the spans in req to appdash spans.
+func convert(req *exportTraceServiceRequest) ([]collection, error) {
+	var cs []collection
+	for _, rs := range req.ResourceSpans {
+		var resAnns appdash.Annotations
+		for _, kv := range rs.Resource.Attributes {
+			resAnns = append(resAnns, appdash.Annotation{Key: ResourceAttributePrefix + kv.Key, Value: []byte(kv.Value.String())})
+		}
+		for _, ss := range append(rs.ScopeSpans, rs.InstrumentationLibrarySpans...) {
+			for _, s := range ss.Spans {
+				span, anns, err := convertSpan(s, ss.Scope.Name)
+				if err != nil {
+					return nil, err
+				}
+				cs = append(cs, collection{span, append(anns, resAnns...)})
+			}
+		}
+	}
+	return cs, nil
+}
+
+// Attribute names of the OpenTelemetry semantic conventions. Both the
+// current names and those they replaced are used.
+var (
+	attrHTTPMethod     = []string{"http.request.method", "http.method"}
+	attrHTTPStatusCode = []string{"http.response.status_code", "http.status_code"}
+	attrHTTPRoute      = []string{"http.route"}
+	attrURLFull        = []string{"url.full", "http.url"}
+	attrURLPath        = []string{"url.path"}
+	attrURLQuery       = []string{"url.query"}
+	attrHTTPTarget     = []string{"http.target"}
+	attrHost           = []string{"server.address", "http.host", "net.host.name", "net.peer.name"}
+	attrDBStatement    = []string{"db.query.text", "db.statement"}
+	attrDBSystem       = []string{"db.system.name", "db.system"}
+)
+
+// convertSpan converts an OTLP span, recorded by the instrumentation scope
+// with the given name, to an appdash span and its annotations.
+func convertSpan(s *span, scope string) (appdash.SpanID, appdash.Annotations, error) {
+	var (
+		span appdash.SpanID
+		err  error
+	)
+	if span.Trace, err = idFromBytes(s.TraceID); err != nil {
+		return span, nil, fmt.Errorf("invalid trace ID: %s", err)
+	}
+	if span.Span, err = idFromBytes(s.SpanID); err != nil {
+		return span, nil, fmt.Errorf("invalid span ID: %s", err)
+	}
+	if len(s.ParentSpanID) > 0 {
+		if span.Parent, err = idFromBytes(s.ParentSpanID); err != nil {
+			return span, nil, fmt.Errorf("invalid parent span ID: %s", err)
+		}
+	}
+
+	attrs := make(map[string]string, len(s.Attributes))
+	for _, kv := range s.Attributes {
+		attrs[kv.Key] = kv.Value.String()
+	}
+	start, end := timeFromNanos(s.StartTimeUnixNano), timeFromNanos(s.EndTimeUnixNano)
+
+	var events []appdash.Event
+	if s.Name != "" {
+		events = append(events, appdash.SpanName(s.Name))
+	}
+	isHTTP := has(attrs, attrHTTPMethod)
+	switch {
+	case s.Kind == spanKindServer && isHTTP:
+		e := httptrace.ServerEvent{ServerRecv: start, ServerSend: end}
+		e.Request, e.Response = takeHTTPAttrs(attrs)
+		e.Route = take(attrs, attrHTTPRoute)
+		events = append(events, e)
+	case s.Kind == spanKindClient && isHTTP:
+		e := httptrace.ClientEvent{ClientSend: start, ClientRecv: end}
+		e.Request, e.Response = takeHTTPAttrs(attrs)
+		events = append(events, e)
+	case s.Kind == spanKindClient && has(attrs, attrDBStatement):
+		e := sqltrace.SQLEvent{ClientSend: start, ClientRecv: end}
+		e.SQL = take(attrs, attrDBStatement)
+		e.Tag = take(attrs, attrDBSystem)
+		if s.Status.Code == statusCodeError {
+			e.Error = s.Status.Message
+		}
+		events = append(events, e)
+	case s.StartTimeUnixNano != 0:
+		events = append(events, appdash.Timespan{S: start, E: end})
+	}
+
+	if s.Status.Code == statusCodeError {
+		events = append(events, appdash.ErrorEvent{Message: s.Status.Message, Type: "OTLP"})
+	}
+	events = append(events, SpanEvent{
+		Kind:          s.Kind.String(),
+		StatusCode:    s.Status.Code.String(),
+		StatusMessage: s.Status.Message,
+		Scope:         scope,
+	})
+
+	for _, e := range s.Events {
+		msg := e.Name
+		for _, kv := range e.Attributes {
+			msg += fmt.Sprintf(" %s=%s", kv.Key, kv.Value.String())
+		}
+		events = append(events, appdash.LogWithTimestamp(msg, timeFromNanos(e.TimeUnixNano)))
+	}
+
+	var anns appdash.Annotations
+	for _, e := range events {
+		as, err := appdash.MarshalEvent(e)
+		if err != nil {
+			return span, nil, err
+		}
+		anns = append(anns, as...)
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		anns = append(anns, appdash.Annotation{Key: k, Value: []byte(attrs[k])})
+	}
+	return span, anns, nil
+}
+
+// idFromBytes converts a 16 byte OTLP trace ID or an 8 byte OTLP span ID to
+// an appdash ID. Only the low 64 bits of trace IDs are kept.
+func idFromBytes(b []byte) (appdash.ID, error) {
+	if len(b) != 8 && len(b) != 16 {
+		return 0, fmt.Errorf("got %d bytes, want 8 or 16", len(b))
+	}
+	id := appdash.ID(binary.BigEndian.Uint64(b[len(b)-8:]))
+	if id == 0 {
+		return 0, errors.New("ID is zero")
+	}
+	return id, nil
+}
+
+// timeFromNanos returns the time ns nanoseconds after the epoch, or the
+// zero time if ns is zero.
+func timeFromNanos(ns jsonUint64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(ns)).UTC()
+}
+
+// has reports whether attrs contains any of the attributes named.
+func has(attrs map[string]string, names []string) bool {
+	for _, name := range names {
+		if _, ok := attrs[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// take removes the attributes named from attrs, and returns the value of
+// the first of them that attrs contains.
+func take(attrs map[string]string, names []string) string {
+	var v string
+	found := false
+	for _, name := range names {
+		if a, ok := attrs[name]; ok {
+			if !found {
+				v, found = a, true
+			}
+			delete(attrs, name)
+		}
+	}
+	return v
+}
+
+// takeHTTPAttrs removes the HTTP request and response attributes from
+// attrs, and returns the request and response they describe.
+func takeHTTPAttrs(attrs map[string]string) (httptrace.RequestInfo, httptrace.ResponseInfo) {
+	var (
+		req  httptrace.RequestInfo
+		resp httptrace.ResponseInfo
+	)
+	req.Method = take(attrs, attrHTTPMethod)
+	req.Host = take(attrs, attrHost)
+	full := take(attrs, attrURLFull)
+	path, query := take(attrs, attrURLPath), take(attrs, attrURLQuery)
+	switch target := take(attrs, attrHTTPTarget); {
+	case path != "":
+		req.URI = path
+		if query != "" {
+			req.URI += "?" + query
+		}
+	case target != "":
+		req.URI = target
+	case full != "":
+		if u, err := url.Parse(full); err == nil {
+			req.URI = u.RequestURI()
+			if req.Host == "" {
+				req.Host = u.Host
+			}
+		}
+	}
+	if code, err := strconv.Atoi(strings.TrimSpace(take(attrs, attrHTTPStatusCode))); err == nil {
+		resp.StatusCode = code
+	}
+	return req, resp
+}
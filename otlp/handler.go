@@ -0,0 +1,143 @@
+package otlp
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// TracesPath is the path of the OTLP/HTTP endpoint that receives traces.
+const TracesPath = "/v1/traces"
+
+// Content types of the OTLP/HTTP encodings.
+const (
+	contentTypeProtobuf = "application/x-protobuf"
+	contentTypeJSON     = "application/json"
+)
+
+// Handler is an http.Handler for the OTLP/HTTP trace endpoint (see
+// TracesPath). It accepts POST requests with a protobuf or JSON encoded
+// ExportTraceServiceRequest, optionally gzipped, and collects the spans to
+// its Collector.
+type Handler struct {
+	// Collector receives the spans.
+	Collector appdash.Collector
+
+	// MaxBodySize is the maximum size of a request body, after
+	// decompression, in bytes.
+	//
+	// Default MaxBodySize = 16 * 1024 * 1024 (16 MB).
+	MaxBodySize int64
+}
+
+// NewHandler returns a Handler that collects the spans it receives to c.
+func NewHandler(c appdash.Collector) *Handler {
+	return &Handler{
+		Collector:   c,
+		MaxBodySize: 16 * 1024 * 1024, // 16 MB
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	supported := contentType == contentTypeProtobuf || contentType == contentTypeJSON
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		if !supported {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		writeError(w, contentType, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if !supported {
+		http.Error(w, fmt.Sprintf("unsupported content type %q", r.Header.Get("Content-Type")), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			writeError(w, contentType, http.StatusBadRequest, err.Error())
+			return
+		}
+		defer gz.Close()
+		body = gz
+	}
+	if h.MaxBodySize > 0 {
+		body = io.LimitReader(body, h.MaxBodySize+1)
+	}
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		writeError(w, contentType, http.StatusBadRequest, err.Error())
+		return
+	}
+	if h.MaxBodySize > 0 && int64(len(data)) > h.MaxBodySize {
+		writeError(w, contentType, http.StatusRequestEntityTooLarge, "request body too large")
+		return
+	}
+
+	var req exportTraceServiceRequest
+	if contentType == contentTypeProtobuf {
+		err = unmarshalProto(data, &req)
+	} else {
+		err = json.Unmarshal(data, &req)
+	}
+	if err != nil {
+		writeError(w, contentType, http.StatusBadRequest, fmt.Sprintf("invalid request: %s", err))
+		return
+	}
+	// Convert all of the spans before collecting any, so that requests with
+	// invalid spans are rejected without collecting part of them.
+	cs, err := convert(&req)
+	if err != nil {
+		writeError(w, contentType, http.StatusBadRequest, fmt.Sprintf("invalid span: %s", err))
+		return
+	}
+	for _, c := range cs {
+		if err := h.Collector.Collect(c.span, c.anns...); err != nil {
+			writeError(w, contentType, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+	}
+
+	// Reply with an empty ExportTraceServiceResponse.
+	w.Header().Set("Content-Type", contentType)
+	if contentType == contentTypeJSON {
+		io.WriteString(w, "{}")
+	}
+}
+
+// writeError replies with a google.rpc.Status message in the encoding of the
+// request, as OTLP/HTTP requires.
+func writeError(w http.ResponseWriter, contentType string, code int, message string) {
+	// The google.rpc.Code that best matches the HTTP status code.
+	rpcCode := 3 // INVALID_ARGUMENT
+	switch code {
+	case http.StatusServiceUnavailable:
+		rpcCode = 14 // UNAVAILABLE
+	case http.StatusMethodNotAllowed:
+		rpcCode = 12 // UNIMPLEMENTED
+	case http.StatusRequestEntityTooLarge:
+		rpcCode = 8 // RESOURCE_EXHAUSTED
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(code)
+	if contentType == contentTypeJSON {
+		json.NewEncoder(w).Encode(struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}{rpcCode, message})
+		return
+	}
+	w.Write(appendProtoStatus(nil, rpcCode, message))
+}
@@ -0,0 +1,278 @@
+// Package otlp implements a receiver for the OpenTelemetry protocol
+// (OTLP), so that services instrumented with OpenTelemetry SDKs can report
+// traces to appdash.
+//
+// Handler accepts OTLP/HTTP trace export requests (POST /v1/traces), in
+// either the binary protobuf or the JSON encoding, and collects the spans
+// they contain to an appdash Collector.
+//
+// OTLP spans are converted to the events of the httptrace and sqltrace
+// packages where possible: SERVER and CLIENT spans with HTTP attributes
+// become HTTPServer and HTTPClient events, and CLIENT spans with a database
+// statement become SQL events. Span events become log events, and the
+// remaining span and resource attributes are kept as plain annotations.
+package otlp
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// The types below mirror the messages of the OTLP trace protocol
+// (opentelemetry/proto/collector/trace/v1/trace_service.proto and the
+// messages it uses), with the fields that appdash uses. Their JSON tags
+// follow the OTLP/JSON encoding.
+
+type exportTraceServiceRequest struct {
+	ResourceSpans []*resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource      `json:"resource"`
+	ScopeSpans []*scopeSpans `json:"scopeSpans"`
+
+	// InstrumentationLibrarySpans is the name of ScopeSpans before OTLP
+	// 0.15, which older SDKs still send.
+	InstrumentationLibrarySpans []*scopeSpans `json:"instrumentationLibrarySpans"`
+}
+
+type resource struct {
+	Attributes []*keyValue `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope instrumentationScope `json:"scope"`
+	Spans []*span              `json:"spans"`
+}
+
+type instrumentationScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type span struct {
+	TraceID           hexBytes    `json:"traceId"`
+	SpanID            hexBytes    `json:"spanId"`
+	ParentSpanID      hexBytes    `json:"parentSpanId"`
+	Name              string      `json:"name"`
+	Kind              spanKind    `json:"kind"`
+	StartTimeUnixNano jsonUint64  `json:"startTimeUnixNano"`
+	EndTimeUnixNano   jsonUint64  `json:"endTimeUnixNano"`
+	Attributes        []*keyValue `json:"attributes"`
+	Events            []*event    `json:"events"`
+	Status            status      `json:"status"`
+}
+
+type event struct {
+	TimeUnixNano jsonUint64  `json:"timeUnixNano"`
+	Name         string      `json:"name"`
+	Attributes   []*keyValue `json:"attributes"`
+}
+
+type status struct {
+	Message string     `json:"message"`
+	Code    statusCode `json:"code"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string       `json:"stringValue"`
+	BoolValue   *bool         `json:"boolValue"`
+	IntValue    *jsonInt64    `json:"intValue"`
+	DoubleValue *float64      `json:"doubleValue"`
+	ArrayValue  *arrayValue   `json:"arrayValue"`
+	KvlistValue *keyValueList `json:"kvlistValue"`
+	BytesValue  []byte        `json:"bytesValue"`
+}
+
+type arrayValue struct {
+	Values []*anyValue `json:"values"`
+}
+
+type keyValueList struct {
+	Values []*keyValue `json:"values"`
+}
+
+// maxValueDepth is the maximum depth of the array and key-value list values
+// that String formats; deeper values are elided.
+const maxValueDepth = 32
+
+// String formats the value as an annotation value.
+func (v *anyValue) String() string {
+	return v.format(0)
+}
+
+// format formats the value, which is nested depth values deep.
+func (v *anyValue) format(depth int) string {
+	if depth >= maxValueDepth && (v.ArrayValue != nil || v.KvlistValue != nil) {
+		return "..."
+	}
+	switch {
+	case v.StringValue != nil:
+		return *v.StringValue
+	case v.BoolValue != nil:
+		return strconv.FormatBool(*v.BoolValue)
+	case v.IntValue != nil:
+		return strconv.FormatInt(int64(*v.IntValue), 10)
+	case v.DoubleValue != nil:
+		return strconv.FormatFloat(*v.DoubleValue, 'g', -1, 64)
+	case v.ArrayValue != nil:
+		vals := make([]string, len(v.ArrayValue.Values))
+		for i, e := range v.ArrayValue.Values {
+			vals[i] = e.format(depth + 1)
+		}
+		return "[" + strings.Join(vals, ", ") + "]"
+	case v.KvlistValue != nil:
+		kvs := make([]string, len(v.KvlistValue.Values))
+		for i, kv := range v.KvlistValue.Values {
+			kvs[i] = kv.Key + ": " + kv.Value.format(depth+1)
+		}
+		return "{" + strings.Join(kvs, ", ") + "}"
+	case v.BytesValue != nil:
+		return hex.EncodeToString(v.BytesValue)
+	}
+	return ""
+}
+
+// Span kinds.
+type spanKind int32
+
+const (
+	spanKindUnspecified spanKind = iota
+	spanKindInternal
+	spanKindServer
+	spanKindClient
+	spanKindProducer
+	spanKindConsumer
+)
+
+var spanKindNames = []string{"", "INTERNAL", "SERVER", "CLIENT", "PRODUCER", "CONSUMER"}
+
+func (k spanKind) String() string {
+	if k < 0 || int(k) >= len(spanKindNames) {
+		return ""
+	}
+	return spanKindNames[k]
+}
+
+// UnmarshalJSON accepts both integer kinds and their enum names (such as
+// "SPAN_KIND_SERVER").
+func (k *spanKind) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		for i, n := range spanKindNames {
+			if n != "" && name == "SPAN_KIND_"+n {
+				*k = spanKind(i)
+				return nil
+			}
+		}
+		*k = spanKindUnspecified
+		return nil
+	}
+	return json.Unmarshal(b, (*int32)(k))
+}
+
+// Status codes.
+type statusCode int32
+
+const (
+	statusCodeUnset statusCode = iota
+	statusCodeOK
+	statusCodeError
+)
+
+var statusCodeNames = []string{"", "OK", "ERROR"}
+
+func (c statusCode) String() string {
+	if c < 0 || int(c) >= len(statusCodeNames) {
+		return ""
+	}
+	return statusCodeNames[c]
+}
+
+// UnmarshalJSON accepts both integer codes and their enum names (such as
+// "STATUS_CODE_ERROR").
+func (c *statusCode) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		for i, n := range statusCodeNames {
+			if n != "" && name == "STATUS_CODE_"+n {
+				*c = statusCode(i)
+				return nil
+			}
+		}
+		*c = statusCodeUnset
+		return nil
+	}
+	return json.Unmarshal(b, (*int32)(c))
+}
+
+// hexBytes is a trace or span ID, which OTLP/JSON encodes as a hex string
+// (rather than as base64, like other bytes fields).
+type hexBytes []byte
+
+func (h *hexBytes) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("invalid ID %q: %s", s, err)
+	}
+	*h = v
+	return nil
+}
+
+// jsonUint64 is a 64 bit integer, which OTLP/JSON encodes as either a
+// decimal string or a number.
+type jsonUint64 uint64
+
+func (u *jsonUint64) UnmarshalJSON(b []byte) error {
+	v, err := strconv.ParseUint(strings.Trim(string(b), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+	*u = jsonUint64(v)
+	return nil
+}
+
+// jsonInt64 is the signed counterpart of jsonUint64.
+type jsonInt64 int64
+
+func (i *jsonInt64) UnmarshalJSON(b []byte) error {
+	v, err := strconv.ParseInt(strings.Trim(string(b), `"`), 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = jsonInt64(v)
+	return nil
+}
+
+// SpanEvent records the fields of an OTLP span that have no equivalent in
+// the other appdash events.
+type SpanEvent struct {
+	Kind          string `trace:"OTLP.Kind"`
+	StatusCode    string `trace:"OTLP.Status.Code"`
+	StatusMessage string `trace:"OTLP.Status.Message"`
+	Scope         string `trace:"OTLP.Scope"`
+}
+
+// Schema returns the constant "OTLP".
+func (SpanEvent) Schema() string { return "OTLP" }
+
+// Important implements the appdash ImportantEvent.
+func (SpanEvent) Important() []string {
+	return []string{"OTLP.Status.Code", "OTLP.Status.Message"}
+}
+
+func init() { appdash.RegisterEvent(SpanEvent{}) }
@@ -0,0 +1,261 @@
+package otlp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+	"sourcegraph.com/sourcegraph/appdash/sqltrace"
+)
+
+const testJSON = `{
+  "resourceSpans": [{
+    "resource": {"attributes": [{"key": "service.name", "value": {"stringValue": "frontend"}}]},
+    "scopeSpans": [{
+      "scope": {"name": "otelhttp"},
+      "spans": [{
+        "traceId": "5b8efff798038103d269b633813fc60c",
+        "spanId": "eee19b7ec3c1b174",
+        "parentSpanId": "eee19b7ec3c1b173",
+        "name": "GET /users",
+        "kind": 2,
+        "startTimeUnixNano": "1544712660000000000",
+        "endTimeUnixNano": "1544712661000000000",
+        "attributes": [
+          {"key": "http.request.method", "value": {"stringValue": "GET"}},
+          {"key": "url.path", "value": {"stringValue": "/users"}},
+          {"key": "http.response.status_code", "value": {"intValue": "500"}},
+          {"key": "http.route", "value": {"stringValue": "/users"}},
+          {"key": "retries", "value": {"arrayValue": {"values": [{"intValue": 1}, {"doubleValue": 2.5}]}}}
+        ],
+        "events": [{
+          "timeUnixNano": "1544712660500000000",
+          "name": "exception",
+          "attributes": [{"key": "exception.message", "value": {"stringValue": "boom"}}]
+        }],
+        "status": {"code": "STATUS_CODE_ERROR", "message": "internal error"}
+      }]
+    }]
+  }]
+}`
+
+func TestHandler_json(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	w := post(t, NewHandler(ms), "application/json", []byte(testJSON))
+	if w.Code != http.StatusOK || w.Body.String() != "{}" {
+		t.Fatalf("got status %d and body %q, want 200 and {}", w.Code, w.Body)
+	}
+
+	trace, err := ms.Trace(0xd269b633813fc60c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (appdash.SpanID{Trace: 0xd269b633813fc60c, Span: 0xeee19b7ec3c1b174, Parent: 0xeee19b7ec3c1b173}); trace.Span.ID != want {
+		t.Errorf("got span %v, want %v", trace.Span.ID, want)
+	}
+	if name := trace.Span.Name(); name != "GET /users" {
+		t.Errorf("got name %q, want %q", name, "GET /users")
+	}
+	var se httptrace.ServerEvent
+	if err := appdash.UnmarshalEvent(trace.Span.Annotations, &se); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Unix(1544712660, 0).UTC()
+	wantSE := httptrace.ServerEvent{
+		Request:    httptrace.RequestInfo{Method: "GET", URI: "/users"},
+		Response:   httptrace.ResponseInfo{StatusCode: 500},
+		Route:      "/users",
+		ServerRecv: start,
+		ServerSend: start.Add(time.Second),
+	}
+	if !reflect.DeepEqual(se, wantSE) {
+		t.Errorf("got server event %+v, want %+v", se, wantSE)
+	}
+	var oe SpanEvent
+	if err := appdash.UnmarshalEvent(trace.Span.Annotations, &oe); err != nil {
+		t.Fatal(err)
+	}
+	if want := (SpanEvent{Kind: "SERVER", StatusCode: "ERROR", StatusMessage: "internal error", Scope: "otelhttp"}); oe != want {
+		t.Errorf("got OTLP event %+v, want %+v", oe, want)
+	}
+	var ee appdash.ErrorEvent
+	if err := appdash.UnmarshalEvent(trace.Span.Annotations, &ee); err != nil {
+		t.Fatal(err)
+	}
+	if want := (appdash.ErrorEvent{Message: "internal error", Type: "OTLP"}); ee != want {
+		t.Errorf("got error event %+v, want %+v", ee, want)
+	}
+	m := trace.Span.Annotations.StringMap()
+	want := map[string]string{
+		"Resource.service.name": "frontend",
+		"retries":               "[1, 2.5]",
+		"Msg":                   "exception exception.message=boom",
+	}
+	for k, v := range want {
+		if m[k] != v {
+			t.Errorf("got annotation %s = %q, want %q", k, m[k], v)
+		}
+	}
+}
+
+// Helpers for encoding protobuf messages.
+
+func pbKey(field, wireType int) []byte { return appendVarint(nil, uint64(field<<3|wireType)) }
+
+func pbBytes(field int, b []byte) []byte {
+	return append(appendVarint(pbKey(field, wireBytes), uint64(len(b))), b...)
+}
+
+func pbVarint(field int, v uint64) []byte { return appendVarint(pbKey(field, wireVarint), v) }
+
+func pbFixed64(field int, v uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, v)
+	return append(pbKey(field, wireFixed64), b...)
+}
+
+func pbMsg(fields ...[]byte) []byte { return bytes.Join(fields, nil) }
+
+func TestHandler_protobuf(t *testing.T) {
+	attr := func(k string, v []byte) []byte { return pbBytes(9, pbMsg(pbBytes(1, []byte(k)), pbBytes(2, v))) }
+	sp := pbMsg(
+		pbBytes(1, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}),
+		pbBytes(2, []byte{0, 0, 0, 0, 0, 0, 0, 2}),
+		pbBytes(4, []byte{0, 0, 0, 0, 0, 0, 0, 3}),
+		pbBytes(5, []byte("SELECT users")),
+		pbVarint(6, 3), // CLIENT
+		pbFixed64(7, 1000),
+		pbFixed64(8, 3000),
+		attr("db.system", pbBytes(1, []byte("postgresql"))),
+		attr("db.statement", pbBytes(1, []byte("SELECT * FROM users"))),
+		attr("db.rows", pbVarint(3, 7)),
+		attr("cached", pbVarint(2, 1)),
+		attr("ratio", pbFixed64(4, math.Float64bits(0.5))),
+		pbVarint(12, 5), // unknown to appdash
+		pbBytes(15, pbMsg(pbBytes(2, []byte("timeout")), pbVarint(3, 2))),
+	)
+	req := pbMsg(pbBytes(1, pbMsg(
+		pbBytes(1, pbMsg(pbBytes(1, pbMsg(pbBytes(1, []byte("service.name")), pbBytes(2, pbBytes(1, []byte("api"))))))),
+		pbBytes(2, pbMsg(pbBytes(1, pbBytes(1, []byte("otelsql"))), pbBytes(2, sp))),
+	)))
+
+	ms := appdash.NewMemoryStore()
+	w := post(t, NewHandler(ms), "application/x-protobuf", req)
+	if w.Code != http.StatusOK || w.Body.Len() != 0 {
+		t.Fatalf("got status %d and body %q, want 200 and an empty body", w.Code, w.Body)
+	}
+
+	trace, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (appdash.SpanID{Trace: 1, Span: 2, Parent: 3}); trace.Span.ID != want {
+		t.Errorf("got span %v, want %v", trace.Span.ID, want)
+	}
+	var e sqltrace.SQLEvent
+	if err := appdash.UnmarshalEvent(trace.Span.Annotations, &e); err != nil {
+		t.Fatal(err)
+	}
+	wantE := sqltrace.SQLEvent{
+		SQL:        "SELECT * FROM users",
+		Tag:        "postgresql",
+		ClientSend: time.Unix(0, 1000).UTC(),
+		ClientRecv: time.Unix(0, 3000).UTC(),
+		Error:      "timeout",
+	}
+	if !reflect.DeepEqual(e, wantE) {
+		t.Errorf("got SQL event %+v, want %+v", e, wantE)
+	}
+	m := trace.Span.Annotations.StringMap()
+	want := map[string]string{
+		"Resource.service.name": "api",
+		"OTLP.Scope":            "otelsql",
+		"OTLP.Status.Code":      "ERROR",
+		"db.rows":               "7",
+		"cached":                "true",
+		"ratio":                 "0.5",
+	}
+	for k, v := range want {
+		if m[k] != v {
+			t.Errorf("got annotation %s = %q, want %q", k, m[k], v)
+		}
+	}
+}
+
+func TestHandler_errors(t *testing.T) {
+	h := NewHandler(appdash.NewMemoryStore())
+	tests := []struct {
+		contentType string
+		body        []byte
+		want        int
+	}{
+		{"text/plain", nil, http.StatusUnsupportedMediaType},
+		{"application/json", []byte("{"), http.StatusBadRequest},
+		{"application/json", []byte(`{"resourceSpans": [{"scopeSpans": [{"spans": [{"traceId": "01", "spanId": "0000000000000001"}]}]}]}`), http.StatusBadRequest},
+		{"application/x-protobuf", []byte{0x0a, 0x05}, http.StatusBadRequest},
+		{"application/x-protobuf", nil, http.StatusOK},
+		{"application/x-protobuf", pbDeepRequest(maxProtoDepth), http.StatusBadRequest},
+	}
+	for _, test := range tests {
+		w := post(t, h, test.contentType, test.body)
+		if w.Code != test.want {
+			t.Errorf("%s %q: got status %d, want %d", test.contentType, test.body, w.Code, test.want)
+		}
+		if w.Code == http.StatusBadRequest && test.contentType == "application/json" && !strings.Contains(w.Body.String(), `"code":3`) {
+			t.Errorf("%s %q: got body %q, want a JSON status", test.contentType, test.body, w.Body)
+		}
+	}
+
+	// Other methods are not allowed, whatever the content type.
+	for _, contentType := range []string{"", "application/json"} {
+		req := httptest.NewRequest("GET", TracesPath, nil)
+		req.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != http.StatusMethodNotAllowed || w.Header().Get("Allow") != "POST" {
+			t.Errorf("GET %q: got status %d with Allow %q, want %d with POST", contentType, w.Code, w.Header().Get("Allow"), http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+// pbDeepRequest returns an ExportTraceServiceRequest with a span attribute
+// whose value is nested n arrays deep.
+func pbDeepRequest(n int) []byte {
+	v := pbBytes(1, []byte("x"))
+	for i := 0; i < n; i++ {
+		v = pbBytes(5, pbBytes(1, v))
+	}
+	sp := pbMsg(
+		pbBytes(1, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}),
+		pbBytes(2, []byte{0, 0, 0, 0, 0, 0, 0, 2}),
+		pbBytes(9, pbMsg(pbBytes(1, []byte("deep")), pbBytes(2, v))),
+	)
+	return pbMsg(pbBytes(1, pbBytes(2, pbBytes(2, sp))))
+}
+
+func TestAnyValue_String_deep(t *testing.T) {
+	v := &anyValue{StringValue: new(string)}
+	for i := 0; i < 1000; i++ {
+		v = &anyValue{ArrayValue: &arrayValue{Values: []*anyValue{v}}}
+	}
+	want := strings.Repeat("[", maxValueDepth) + "..." + strings.Repeat("]", maxValueDepth)
+	if got := v.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func post(t *testing.T, h http.Handler, contentType string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest("POST", TracesPath, bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
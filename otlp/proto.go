@@ -0,0 +1,383 @@
+package otlp
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// The OTLP messages are decoded from the protobuf wire format by hand,
+// rather than with generated code, as appdash only needs a few of their
+// fields. See https://developers.google.com/protocol-buffers/docs/encoding.
+
+// Protobuf wire types.
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+// maxProtoDepth is the maximum depth of embedded messages that are decoded,
+// so that deeply nested values (e.g. arrays of arrays) can't exhaust the
+// stack.
+const maxProtoDepth = 32
+
+var (
+	errTruncated = errors.New("otlp: truncated protobuf message")
+	errTooDeep   = errors.New("otlp: protobuf message nested too deeply")
+)
+
+// protoReader reads the fields of a protobuf message.
+type protoReader struct {
+	b     []byte
+	depth int // depth of the message in the top-level message
+}
+
+// next reads the key of the next field, returning io.EOF at the end of the
+// message.
+func (r *protoReader) next() (field, wireType int, err error) {
+	if len(r.b) == 0 {
+		return 0, 0, io.EOF
+	}
+	key, err := r.varint()
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(key >> 3), int(key & 7), nil
+}
+
+func (r *protoReader) varint() (uint64, error) {
+	v, n := binary.Uvarint(r.b)
+	if n <= 0 {
+		return 0, errTruncated
+	}
+	r.b = r.b[n:]
+	return v, nil
+}
+
+func (r *protoReader) fixed64() (uint64, error) {
+	if len(r.b) < 8 {
+		return 0, errTruncated
+	}
+	v := binary.LittleEndian.Uint64(r.b)
+	r.b = r.b[8:]
+	return v, nil
+}
+
+func (r *protoReader) bytes() ([]byte, error) {
+	n, err := r.varint()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(r.b)) < n {
+		return nil, errTruncated
+	}
+	v := r.b[:n]
+	r.b = r.b[n:]
+	return v, nil
+}
+
+// skip skips the value of a field of the given wire type.
+func (r *protoReader) skip(wireType int) error {
+	var err error
+	switch wireType {
+	case wireVarint:
+		_, err = r.varint()
+	case wireFixed64:
+		_, err = r.fixed64()
+	case wireBytes:
+		_, err = r.bytes()
+	case wireFixed32:
+		if len(r.b) < 4 {
+			return errTruncated
+		}
+		r.b = r.b[4:]
+	default:
+		return errors.New("otlp: unsupported protobuf wire type")
+	}
+	return err
+}
+
+// protoMessage is implemented by the types that are decoded from protobuf
+// messages.
+type protoMessage interface {
+	// unmarshalField decodes the field from r, returning ok == false if
+	// the message has no such field.
+	unmarshalField(r *protoReader, field, wireType int) (ok bool, err error)
+}
+
+// unmarshalProto decodes the protobuf message b into m, skipping unknown
+// fields.
+func unmarshalProto(b []byte, m protoMessage) error {
+	return unmarshalProtoDepth(b, m, 0)
+}
+
+func unmarshalProtoDepth(b []byte, m protoMessage, depth int) error {
+	r := &protoReader{b: b, depth: depth}
+	for {
+		field, wireType, err := r.next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		ok, err := m.unmarshalField(r, field, wireType)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			if err := r.skip(wireType); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// embedded decodes an embedded message field into m, returning errTooDeep
+// if it is nested more than maxProtoDepth messages deep.
+func (r *protoReader) embedded(m protoMessage) error {
+	b, err := r.bytes()
+	if err != nil {
+		return err
+	}
+	if r.depth >= maxProtoDepth {
+		return errTooDeep
+	}
+	return unmarshalProtoDepth(b, m, r.depth+1)
+}
+
+func (r *protoReader) string() (string, error) {
+	b, err := r.bytes()
+	return string(b), err
+}
+
+func (m *exportTraceServiceRequest) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	if field == 1 && wireType == wireBytes {
+		rs := new(resourceSpans)
+		m.ResourceSpans = append(m.ResourceSpans, rs)
+		return true, r.embedded(rs)
+	}
+	return false, nil
+}
+
+func (m *resourceSpans) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	if wireType != wireBytes {
+		return false, nil
+	}
+	switch field {
+	case 1:
+		return true, r.embedded(&m.Resource)
+	case 2, 1000: // scope_spans, instrumentation_library_spans
+		ss := new(scopeSpans)
+		if field == 2 {
+			m.ScopeSpans = append(m.ScopeSpans, ss)
+		} else {
+			m.InstrumentationLibrarySpans = append(m.InstrumentationLibrarySpans, ss)
+		}
+		return true, r.embedded(ss)
+	}
+	return false, nil
+}
+
+func (m *resource) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	if field == 1 && wireType == wireBytes {
+		kv := new(keyValue)
+		m.Attributes = append(m.Attributes, kv)
+		return true, r.embedded(kv)
+	}
+	return false, nil
+}
+
+func (m *scopeSpans) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	if wireType != wireBytes {
+		return false, nil
+	}
+	switch field {
+	case 1:
+		return true, r.embedded(&m.Scope)
+	case 2:
+		s := new(span)
+		m.Spans = append(m.Spans, s)
+		return true, r.embedded(s)
+	}
+	return false, nil
+}
+
+func (m *instrumentationScope) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	if wireType != wireBytes {
+		return false, nil
+	}
+	var err error
+	switch field {
+	case 1:
+		m.Name, err = r.string()
+	case 2:
+		m.Version, err = r.string()
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func (m *span) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	var err error
+	switch {
+	case field == 1 && wireType == wireBytes:
+		m.TraceID, err = r.bytes()
+	case field == 2 && wireType == wireBytes:
+		m.SpanID, err = r.bytes()
+	case field == 4 && wireType == wireBytes:
+		m.ParentSpanID, err = r.bytes()
+	case field == 5 && wireType == wireBytes:
+		m.Name, err = r.string()
+	case field == 6 && wireType == wireVarint:
+		var v uint64
+		v, err = r.varint()
+		m.Kind = spanKind(v)
+	case field == 7 && wireType == wireFixed64:
+		var v uint64
+		v, err = r.fixed64()
+		m.StartTimeUnixNano = jsonUint64(v)
+	case field == 8 && wireType == wireFixed64:
+		var v uint64
+		v, err = r.fixed64()
+		m.EndTimeUnixNano = jsonUint64(v)
+	case field == 9 && wireType == wireBytes:
+		kv := new(keyValue)
+		m.Attributes = append(m.Attributes, kv)
+		err = r.embedded(kv)
+	case field == 11 && wireType == wireBytes:
+		e := new(event)
+		m.Events = append(m.Events, e)
+		err = r.embedded(e)
+	case field == 15 && wireType == wireBytes:
+		err = r.embedded(&m.Status)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func (m *event) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	var err error
+	switch {
+	case field == 1 && wireType == wireFixed64:
+		var v uint64
+		v, err = r.fixed64()
+		m.TimeUnixNano = jsonUint64(v)
+	case field == 2 && wireType == wireBytes:
+		m.Name, err = r.string()
+	case field == 3 && wireType == wireBytes:
+		kv := new(keyValue)
+		m.Attributes = append(m.Attributes, kv)
+		err = r.embedded(kv)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func (m *status) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	var err error
+	switch {
+	case field == 2 && wireType == wireBytes:
+		m.Message, err = r.string()
+	case field == 3 && wireType == wireVarint:
+		var v uint64
+		v, err = r.varint()
+		m.Code = statusCode(v)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func (m *keyValue) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	if wireType != wireBytes {
+		return false, nil
+	}
+	var err error
+	switch field {
+	case 1:
+		m.Key, err = r.string()
+	case 2:
+		err = r.embedded(&m.Value)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func (m *anyValue) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	var err error
+	switch {
+	case field == 1 && wireType == wireBytes:
+		var s string
+		s, err = r.string()
+		m.StringValue = &s
+	case field == 2 && wireType == wireVarint:
+		var v uint64
+		v, err = r.varint()
+		b := v != 0
+		m.BoolValue = &b
+	case field == 3 && wireType == wireVarint:
+		var v uint64
+		v, err = r.varint()
+		i := jsonInt64(v)
+		m.IntValue = &i
+	case field == 4 && wireType == wireFixed64:
+		var v uint64
+		v, err = r.fixed64()
+		f := math.Float64frombits(v)
+		m.DoubleValue = &f
+	case field == 5 && wireType == wireBytes:
+		m.ArrayValue = new(arrayValue)
+		err = r.embedded(m.ArrayValue)
+	case field == 6 && wireType == wireBytes:
+		m.KvlistValue = new(keyValueList)
+		err = r.embedded(m.KvlistValue)
+	case field == 7 && wireType == wireBytes:
+		var b []byte
+		b, err = r.bytes()
+		m.BytesValue = append([]byte{}, b...)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+func (m *arrayValue) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	if field == 1 && wireType == wireBytes {
+		v := new(anyValue)
+		m.Values = append(m.Values, v)
+		return true, r.embedded(v)
+	}
+	return false, nil
+}
+
+func (m *keyValueList) unmarshalField(r *protoReader, field, wireType int) (bool, error) {
+	if field == 1 && wireType == wireBytes {
+		kv := new(keyValue)
+		m.Values = append(m.Values, kv)
+		return true, r.embedded(kv)
+	}
+	return false, nil
+}
+
+// appendProtoStatus appends a google.rpc.Status message, which OTLP/HTTP
+// servers return in the bodies of error responses, to b.
+func appendProtoStatus(b []byte, code int, message string) []byte {
+	b = appendVarint(b, 1<<3|wireVarint)
+	b = appendVarint(b, uint64(code))
+	b = appendVarint(b, 2<<3|wireBytes)
+	b = appendVarint(b, uint64(len(message)))
+	return append(b, message...)
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	return append(b, buf[:binary.PutUvarint(buf[:], v)]...)
+}
@@ -0,0 +1,162 @@
+package appdash
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	"sourcegraph.com/sourcegraph/appdash/internal/wire"
+)
+
+// The wire protocol between RemoteCollector and CollectorServer comes in two
+// versions.
+//
+// In version 1, the client sends each span as a delimited CollectPacket
+// (a varint length followed by the message), and the server never replies.
+// A client can't tell which spans the server collected when the connection
+// is lost.
+//
+// In version 2, the client sends protocolPreamble, and then the client and
+// server exchange delimited messages: the client sends a Hello describing
+// itself, the server replies with a HelloAck choosing the version and
+// compression to use, and then the client sends Batches of spans, each of
+// which the server acknowledges with an Ack once it has collected them.
+//
+// A server tells the versions apart by the first byte of a connection,
+// which is never zero in version 1 (a CollectPacket is never empty). Servers
+// that only support version 1 close connections that begin with the
+// preamble, so clients fall back to version 1.
+const (
+	// protocolPreamble begins connections of version 2 and later.
+	protocolPreamble = "\x00appdash"
+
+	// protocolVersion is the latest version of the protocol.
+	protocolVersion = 2
+
+	// gzipCompression is the name of gzip compression in the protocol, the
+	// only compression that is supported.
+	gzipCompression = "gzip"
+)
+
+// A BatchCollector is a Collector that can collect the annotations of many
+// spans at once. ChunkedCollector sends the spans that it flushes to a
+// BatchCollector in one batch.
+type BatchCollector interface {
+	Collector
+
+	// CollectBatch collects the annotations of each of the spans.
+	CollectBatch([]Span) error
+}
+
+// newHello returns the Hello of a client that records spans of the given
+// resource (which may be nil), and supports the given compression (which
+// may be "" for none).
+func newHello(r *Resource, compression string) *wire.Hello {
+	h := &wire.Hello{
+		Version: proto.Uint32(protocolVersion),
+		Client:  proto.String("appdash-go"),
+		Pid:     proto.Int64(int64(os.Getpid())),
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		h.Hostname = proto.String(hostname)
+	}
+	if compression != "" {
+		h.Compression = []string{compression}
+	}
+	if r != nil {
+		for _, a := range r.Annotations() {
+			h.Metadata = append(h.Metadata, &wire.Metadata{Key: proto.String(a.Key), Value: proto.String(string(a.Value))})
+		}
+	}
+	return h
+}
+
+// splitBatch splits packets into batches whose payloads are no larger than
+// maxMessageSize (before compression), so that the server accepts them. It
+// returns an error if a packet is too large on its own.
+func splitBatch(packets []*wire.CollectPacket) ([][]*wire.CollectPacket, error) {
+	// Reserve room for the other fields of the Batch, and for compression,
+	// which grows payloads that can't be compressed slightly.
+	const maxPayloadSize = maxMessageSize - 1024
+
+	var (
+		batches [][]*wire.CollectPacket
+		start   int
+		size    int
+	)
+	for i, p := range packets {
+		n := proto.Size(p)
+		n += 1 + proto.SizeVarint(uint64(n)) // the field's tag and length
+		if n > maxPayloadSize {
+			return nil, fmt.Errorf("span %v is too large to send (%d bytes)", spanIDFromWire(p.Spanid), n)
+		}
+		if size+n > maxPayloadSize {
+			batches = append(batches, packets[start:i])
+			start, size = i, 0
+		}
+		size += n
+	}
+	if start < len(packets) {
+		batches = append(batches, packets[start:])
+	}
+	return batches, nil
+}
+
+// encodeBatch returns the batch of the given packets, compressed with the
+// given compression (or not, if it is "").
+func encodeBatch(seq uint64, packets []*wire.CollectPacket, compression string) (*wire.Batch, error) {
+	payload, err := proto.Marshal(&wire.BatchPayload{Packet: packets})
+	if err != nil {
+		return nil, err
+	}
+	b := &wire.Batch{Sequence: proto.Uint64(seq), Payload: payload}
+	switch compression {
+	case "":
+	case gzipCompression:
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(payload); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		b.Compression = proto.String(compression)
+		b.Payload = buf.Bytes()
+	default:
+		return nil, fmt.Errorf("unsupported compression %q", compression)
+	}
+	return b, nil
+}
+
+// decodeBatch returns the packets of the batch. The payload may not exceed
+// maxSize once decompressed.
+func decodeBatch(b *wire.Batch, maxSize int) ([]*wire.CollectPacket, error) {
+	payload := b.Payload
+	switch c := b.GetCompression(); c {
+	case "":
+	case gzipCompression:
+		zr, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, err
+		}
+		payload, err = ioutil.ReadAll(io.LimitReader(zr, int64(maxSize)+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(payload) > maxSize {
+			return nil, fmt.Errorf("batch %d is larger than %d bytes", b.GetSequence(), maxSize)
+		}
+	default:
+		return nil, fmt.Errorf("batch %d has unsupported compression %q", b.GetSequence(), c)
+	}
+	var p wire.BatchPayload
+	if err := proto.Unmarshal(payload, &p); err != nil {
+		return nil, err
+	}
+	return p.Packet, nil
+}
@@ -0,0 +1,307 @@
+package appdash
+
+import (
+	"errors"
+	"io/ioutil"
+	"log"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pio "github.com/gogo/protobuf/io"
+	"sourcegraph.com/sourcegraph/appdash/internal/wire"
+)
+
+// packetRecorder is a Collector that records the packets it collects.
+type packetRecorder struct {
+	mu      sync.Mutex
+	packets []*wire.CollectPacket
+	fail    SpanID // the span to fail to collect, if any
+}
+
+func (r *packetRecorder) Collect(span SpanID, anns ...Annotation) error {
+	if span == r.fail {
+		return errors.New("fake error")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.packets = append(r.packets, newCollectPacket(span, anns))
+	return nil
+}
+
+func (r *packetRecorder) sorted() []*wire.CollectPacket {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	packets := append([]*wire.CollectPacket(nil), r.packets...)
+	sort.Sort(byTraceID(packets))
+	return packets
+}
+
+// startCollectorServer starts a CollectorServer on a local port, which the
+// caller must close.
+func startCollectorServer(t *testing.T, c Collector) *CollectorServer {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := NewServer(l, c)
+	go cs.Start()
+	return cs
+}
+
+func TestRemoteCollector_protocolV2(t *testing.T) {
+	for _, compression := range []string{"", gzipCompression} {
+		rec := &packetRecorder{}
+		cs := startCollectorServer(t, rec)
+		rc := NewRemoteCollector(cs.l.Addr().String())
+		rc.Compression = compression
+
+		spans := []Span{
+			{ID: SpanID{1, 2, 3}, Annotations: Annotations{{"k1", []byte("v1")}}},
+			{ID: SpanID{2, 3, 4}, Annotations: Annotations{{"k2", []byte(strings.Repeat("v2", 1000))}}},
+		}
+		if err := rc.CollectBatch(spans); err != nil {
+			t.Fatal(err)
+		}
+		if rc.version != 2 || rc.compression != compression {
+			t.Errorf("got protocol version %d with compression %q, want 2 with %q", rc.version, rc.compression, compression)
+		}
+
+		// The batch was acknowledged, so there is no need to wait for the
+		// server to collect it.
+		want := []*wire.CollectPacket{
+			newCollectPacket(spans[0].ID, spans[0].Annotations),
+			newCollectPacket(spans[1].ID, spans[1].Annotations),
+		}
+		if got := rec.sorted(); !reflect.DeepEqual(got, want) {
+			t.Errorf("compression %q: server collected %v, want %v", compression, got, want)
+		}
+		rc.Close()
+		cs.Close()
+	}
+}
+
+func TestRemoteCollector_ackError(t *testing.T) {
+	rec := &packetRecorder{fail: SpanID{9, 9, 9}}
+	cs := startCollectorServer(t, rec)
+	defer cs.Close()
+	rc := NewRemoteCollector(cs.l.Addr().String())
+	defer rc.Close()
+
+	err := rc.CollectBatch([]Span{{ID: SpanID{9, 9, 9}}})
+	if _, ok := err.(*ackError); !ok || !strings.Contains(err.Error(), "fake error") {
+		t.Errorf("got error %v, want the server's error", err)
+	}
+
+	// The connection is still usable.
+	if err := rc.CollectBatch([]Span{{ID: SpanID{1, 2, 3}}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := rec.sorted(); len(got) != 1 {
+		t.Errorf("server collected %v, want only the span that succeeded", got)
+	}
+}
+
+func TestRemoteCollector_collectWithoutAck(t *testing.T) {
+	bc := &blockingCollector{entered: make(chan struct{}, 2), release: make(chan struct{})}
+	cs := startCollectorServer(t, bc)
+	defer cs.Close()
+	rc := NewRemoteCollector(cs.l.Addr().String())
+	defer rc.Close()
+
+	// Collect returns while the server is still collecting the span.
+	if err := rc.Collect(SpanID{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	<-bc.entered
+	if len(rc.unacked) != 1 {
+		t.Errorf("got %d unacknowledged batches, want 1", len(rc.unacked))
+	}
+
+	// CollectBatch waits for it, and for its own spans.
+	close(bc.release)
+	if err := rc.CollectBatch([]Span{{ID: SpanID{2, 3, 4}}}); err != nil {
+		t.Fatal(err)
+	}
+	if got := bc.sorted(); len(got) != 2 {
+		t.Errorf("server collected %v, want both spans", got)
+	}
+}
+
+func TestRemoteCollector_resendUnacked(t *testing.T) {
+	bc := &blockingCollector{entered: make(chan struct{}, 1), release: make(chan struct{})}
+	defer close(bc.release)
+	cs := startCollectorServer(t, bc)
+	rc := NewRemoteCollector(cs.l.Addr().String())
+	rc.Log = log.New(ioutil.Discard, "", 0)
+
+	// The server fails while collecting the span, before acknowledging it.
+	if err := rc.Collect(SpanID{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	<-bc.entered
+	cs.Close()
+
+	// The span is sent again to the server that replaces it.
+	rec := &packetRecorder{}
+	cs2 := startCollectorServer(t, rec)
+	defer cs2.Close()
+	rc.dial = func() (net.Conn, error) {
+		return net.Dial("tcp", cs2.l.Addr().String())
+	}
+	if err := rc.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := []*wire.CollectPacket{newCollectPacket(SpanID{1, 2, 3}, nil)}
+	if got := rec.sorted(); !reflect.DeepEqual(got, want) {
+		t.Errorf("server collected %v, want %v", got, want)
+	}
+}
+
+func TestCollectorServer_protocolV1(t *testing.T) {
+	rec := &packetRecorder{}
+	cs := startCollectorServer(t, rec)
+	defer cs.Close()
+
+	// Clients of version 1 don't wait for acknowledgements.
+	rc := NewRemoteCollector(cs.l.Addr().String())
+	rc.ProtocolVersion = 1
+	if err := rc.Collect(SpanID{1, 2, 3}, Annotation{"k1", []byte("v1")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rc.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nor do other clients, such as the Python one.
+	conn, err := net.Dial("tcp", cs.l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := pio.NewDelimitedWriter(conn)
+	if err := w.WriteMsg(newCollectPacket(SpanID{2, 3, 4}, nil)); err != nil {
+		t.Fatal(err)
+	}
+	w.Close()
+
+	time.Sleep(20 * time.Millisecond)
+	want := []*wire.CollectPacket{
+		newCollectPacket(SpanID{1, 2, 3}, Annotations{{"k1", []byte("v1")}}),
+		newCollectPacket(SpanID{2, 3, 4}, nil),
+	}
+	if got := rec.sorted(); !reflect.DeepEqual(got, want) {
+		t.Errorf("server collected %v, want %v", got, want)
+	}
+}
+
+func TestRemoteCollector_fallbackToV1(t *testing.T) {
+	// Serve like a server that only supports version 1 of the protocol.
+	rec := &packetRecorder{}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				rdr := pio.NewDelimitedReader(conn, maxMessageSize)
+				for {
+					p := &wire.CollectPacket{}
+					if err := rdr.ReadMsg(p); err != nil {
+						return
+					}
+					rec.Collect(spanIDFromWire(p.Spanid), annotationsFromWire(p.Annotation)...)
+				}
+			}()
+		}
+	}()
+
+	rc := NewRemoteCollector(l.Addr().String())
+	rc.Log = log.New(ioutil.Discard, "", 0)
+	rc.AckTimeout = time.Second
+	if err := rc.Collect(SpanID{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if rc.version != 1 {
+		t.Errorf("got protocol version %d, want a fallback to version 1", rc.version)
+	}
+	rc.Close()
+
+	want := []*wire.CollectPacket{newCollectPacket(SpanID{1, 2, 3}, nil)}
+	for deadline := time.Now().Add(time.Second); len(rec.sorted()) < len(want) && time.Now().Before(deadline); {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := rec.sorted(); !reflect.DeepEqual(got, want) {
+		t.Errorf("server collected %v, want %v", got, want)
+	}
+
+	// Version 2 is tried again on the next connection, in case the server
+	// has been upgraded.
+	cs2 := startCollectorServer(t, rec)
+	defer cs2.Close()
+	rc.dial = func() (net.Conn, error) { return net.Dial("tcp", cs2.l.Addr().String()) }
+	if err := rc.CollectBatch([]Span{{ID: SpanID{2, 3, 4}}}); err != nil {
+		t.Fatal(err)
+	}
+	if rc.version != 2 {
+		t.Errorf("got protocol version %d after reconnecting, want 2", rc.version)
+	}
+	rc.Close()
+}
+
+func TestRemoteCollector_noFallbackToV1(t *testing.T) {
+	// Serve like a server that is too slow to reply to the handshake.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	rc := NewRemoteCollector(l.Addr().String())
+	rc.AckTimeout = 20 * time.Millisecond
+	if err := rc.Collect(SpanID{1, 2, 3}); err == nil {
+		t.Error("got no error, want the handshake to time out")
+	}
+	if rc.pconn != nil {
+		t.Errorf("got a connection of protocol version %d, want none", rc.version)
+	}
+}
+
+func TestSplitBatch(t *testing.T) {
+	big := Annotations{{"k", make([]byte, maxMessageSize/3)}}
+	var packets []*wire.CollectPacket
+	for i := 0; i < 5; i++ {
+		packets = append(packets, newCollectPacket(SpanID{ID(i + 1), 1, 0}, big))
+	}
+	batches, err := splitBatch(packets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 3 || len(batches[0]) != 2 || len(batches[1]) != 2 || len(batches[2]) != 1 {
+		t.Errorf("got batches of sizes %d, want 2, 2 and 1", len(batches))
+	}
+
+	huge := newCollectPacket(SpanID{1, 1, 0}, Annotations{{"k", make([]byte, maxMessageSize)}})
+	if _, err := splitBatch([]*wire.CollectPacket{huge}); err == nil {
+		t.Error("got no error for a span larger than a batch")
+	}
+}
@@ -0,0 +1,295 @@
+package appdash
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// ErrorsFilter filters traces by whether or not they contain a failed span.
+type ErrorsFilter int
+
+const (
+	// AnyErrors matches traces regardless of whether or not they contain a
+	// failed span.
+	AnyErrors ErrorsFilter = iota
+
+	// WithErrors matches only traces that contain a failed span.
+	WithErrors
+
+	// WithoutErrors matches only traces that do not contain a failed span.
+	WithoutErrors
+)
+
+// TracesOrder is the order in which a Queryer returns traces.
+type TracesOrder int
+
+const (
+	// OrderDefault is an implementation-defined order.
+	OrderDefault TracesOrder = iota
+
+	// OrderNewest orders traces by their start time, most recent first.
+	OrderNewest
+
+	// OrderOldest orders traces by their start time, least recent first.
+	OrderOldest
+
+	// OrderSlowest orders traces by their duration, longest first.
+	OrderSlowest
+
+	// OrderFastest orders traces by their duration, shortest first.
+	OrderFastest
+)
+
+// Annotation keys used by the httptrace package which TracesOpts filters
+// on. They are duplicated here because this package cannot import httptrace.
+const (
+	serverUserKey       = "Server.User"
+	serverRouteKey      = "Server.Route"
+	serverStatusCodeKey = "Server.Response.StatusCode"
+	clientStatusCodeKey = "Client.Response.StatusCode"
+)
+
+// annotationFilters returns the annotation key/value pairs that every
+// matching trace must contain, including those implied by User, Route and
+// Service.
+func (opts *TracesOpts) annotationFilters() map[string]string {
+	if opts.User == "" && opts.Route == "" && opts.Service == "" {
+		return opts.Annotations
+	}
+	m := make(map[string]string, len(opts.Annotations)+3)
+	for k, v := range opts.Annotations {
+		m[k] = v
+	}
+	if opts.User != "" {
+		m[serverUserKey] = opts.User
+	}
+	if opts.Route != "" {
+		m[serverRouteKey] = opts.Route
+	}
+	if opts.Service != "" {
+		m[ServiceKey] = opts.Service
+	}
+	return m
+}
+
+// traceKey is the information about a trace that TracesOpts orders traces
+// by.
+type traceKey struct {
+	id       ID
+	start    time.Time
+	duration time.Duration
+	hasTimes bool // whether the root span has any timespan events
+}
+
+// traceInfo is a trace along with the information that TracesOpts filters
+// and orders traces by.
+type traceInfo struct {
+	*Trace
+	traceKey
+}
+
+func newTraceInfo(t *Trace) (*traceInfo, error) {
+	var events []Event
+	if err := UnmarshalEvents(t.Span.Annotations, &events); err != nil {
+		return nil, err
+	}
+	info := &traceInfo{Trace: t, traceKey: traceKey{id: t.Span.ID.Trace}}
+	if start, end, ok := findTraceTimes(events); ok {
+		info.start = start
+		info.duration = end.Sub(start)
+		info.hasTimes = true
+	}
+	return info, nil
+}
+
+// match reports whether the trace matches the filters in opts. It does not
+// consider ordering or pagination.
+func (t *traceInfo) match(opts *TracesOpts, annFilters map[string]string) bool {
+	if len(opts.TraceIDs) > 0 {
+		found := false
+		for _, id := range opts.TraceIDs {
+			if t.Span.ID.Trace == id {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if opts.Timespan != (Timespan{}) {
+		if !t.hasTimes {
+			return false
+		}
+		if !opts.Timespan.S.IsZero() && t.start.Before(opts.Timespan.S) {
+			return false
+		}
+		if !opts.Timespan.E.IsZero() && t.start.After(opts.Timespan.E) {
+			return false
+		}
+	}
+	if opts.Name != "" && t.Span.Name() != opts.Name {
+		return false
+	}
+	if opts.MinDuration != 0 || opts.MaxDuration != 0 {
+		if !t.hasTimes {
+			return false
+		}
+		if opts.MinDuration != 0 && t.duration < opts.MinDuration {
+			return false
+		}
+		if opts.MaxDuration != 0 && t.duration > opts.MaxDuration {
+			return false
+		}
+	}
+	for k, v := range annFilters {
+		if !traceHasAnnotation(t.Trace, k, v) {
+			return false
+		}
+	}
+	switch opts.Errors {
+	case WithErrors:
+		return t.Trace.Failed()
+	case WithoutErrors:
+		return !t.Trace.Failed()
+	}
+	return true
+}
+
+// traceHasAnnotation reports whether t or any of its descendants have an
+// annotation with the given key and value.
+func traceHasAnnotation(t *Trace, key, value string) bool {
+	for _, a := range t.Span.Annotations {
+		if a.Key == key && string(a.Value) == value {
+			return true
+		}
+	}
+	for _, sub := range t.Sub {
+		if traceHasAnnotation(sub, key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Failed reports whether the span of t or of any of its descendants failed
+// (see Span.Failed).
+func (t *Trace) Failed() bool {
+	if t.Span.Failed() {
+		return true
+	}
+	for _, sub := range t.Sub {
+		if sub.Failed() {
+			return true
+		}
+	}
+	return false
+}
+
+// Failed reports whether the span describes a failed operation: one with an
+// ErrorEvent, an HTTP server response with a 5xx status code, or an HTTP
+// client request that either could not be made or received a 5xx status
+// code.
+func (s *Span) Failed() bool {
+	for _, a := range s.Annotations {
+		switch a.Key {
+		case schemaPrefix + errorSchema:
+			return true
+		case serverStatusCodeKey, clientStatusCodeKey:
+			code, err := strconv.Atoi(string(a.Value))
+			if err != nil {
+				continue
+			}
+			if code >= 500 || (a.Key == clientStatusCodeKey && code < 0) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// filterTraces returns the traces in ts that match the filters in opts,
+// sorted according to opts.Order and paginated according to opts.Offset and
+// opts.Limit.
+func filterTraces(ts []*Trace, opts TracesOpts) ([]*Trace, error) {
+	annFilters := opts.annotationFilters()
+	var matched []*traceInfo
+	for _, t := range ts {
+		info, err := newTraceInfo(t)
+		if err != nil {
+			return nil, err
+		}
+		if info.match(&opts, annFilters) {
+			matched = append(matched, info)
+		}
+	}
+
+	sortTraceInfos(matched, opts.Order)
+
+	if opts.Offset > 0 {
+		if opts.Offset >= len(matched) {
+			matched = nil
+		} else {
+			matched = matched[opts.Offset:]
+		}
+	}
+	if opts.Limit > 0 && len(matched) > opts.Limit {
+		matched = matched[:opts.Limit]
+	}
+
+	filtered := make([]*Trace, len(matched))
+	for i, info := range matched {
+		filtered[i] = info.Trace
+	}
+	return filtered, nil
+}
+
+// traceOrderLess returns a function that reports whether a trace sorts
+// before another in the given order, or nil if the order is OrderDefault.
+// Traces without any timespan events are always sorted last, and ties are
+// broken by trace ID so that pagination is stable.
+func traceOrderLess(order TracesOrder) func(a, b *traceKey) bool {
+	var less func(a, b *traceKey) bool
+	switch order {
+	case OrderNewest:
+		less = func(a, b *traceKey) bool { return a.start.After(b.start) }
+	case OrderOldest:
+		less = func(a, b *traceKey) bool { return a.start.Before(b.start) }
+	case OrderSlowest:
+		less = func(a, b *traceKey) bool { return a.duration > b.duration }
+	case OrderFastest:
+		less = func(a, b *traceKey) bool { return a.duration < b.duration }
+	default:
+		return nil
+	}
+	return func(a, b *traceKey) bool {
+		if a.hasTimes != b.hasTimes {
+			return a.hasTimes
+		}
+		if less(a, b) {
+			return true
+		}
+		if less(b, a) {
+			return false
+		}
+		return a.id < b.id
+	}
+}
+
+// sortTraceInfos sorts the traces in the given order. OrderDefault leaves ts
+// as-is.
+func sortTraceInfos(ts []*traceInfo, order TracesOrder) {
+	if less := traceOrderLess(order); less != nil {
+		sort.Sort(traceInfoSorter{ts, less})
+	}
+}
+
+type traceInfoSorter struct {
+	ts   []*traceInfo
+	less func(a, b *traceKey) bool
+}
+
+func (s traceInfoSorter) Len() int           { return len(s.ts) }
+func (s traceInfoSorter) Swap(i, j int)      { s.ts[i], s.ts[j] = s.ts[j], s.ts[i] }
+func (s traceInfoSorter) Less(i, j int) bool { return s.less(&s.ts[i].traceKey, &s.ts[j].traceKey) }
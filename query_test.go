@@ -0,0 +1,127 @@
+package appdash
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Traces_opts(t *testing.T) {
+	testTracesOpts(t, NewMemoryStore())
+}
+
+// testTracesOpts tests that the given empty store's Traces method respects
+// the filtering, ordering and pagination options of TracesOpts.
+func testTracesOpts(t *testing.T, s interface {
+	Store
+	Queryer
+}) {
+	collectTracesOptsData(t, s)
+	testTracesOptsQueries(t, s)
+}
+
+// collectTracesOptsData collects the traces that testTracesOptsQueries
+// expects into the given empty store.
+func collectTracesOptsData(t *testing.T, s Store) {
+	st := storeT{t, s}
+
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	collect := func(id SpanID, name string, start time.Time, d time.Duration, anns ...Annotation) {
+		var as Annotations
+		for _, e := range []Event{SpanName(name), Timespan{S: start, E: start.Add(d)}} {
+			ea, err := MarshalEvent(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			as = append(as, ea...)
+		}
+		st.MustCollect(id, append(as, anns...)...)
+	}
+	collect(SpanID{1, 10, 0}, "/a", base, 100*time.Millisecond,
+		Annotation{Key: "Server.Route", Value: []byte("a")},
+		Annotation{Key: "Server.Response.StatusCode", Value: []byte("200")},
+	)
+	collect(SpanID{2, 20, 0}, "/a", base.Add(time.Second), 3*time.Second,
+		Annotation{Key: "Server.Route", Value: []byte("a")},
+		Annotation{Key: "Server.User", Value: []byte("alice")},
+	)
+	collect(SpanID{2, 21, 20}, "db", base.Add(time.Second), time.Second,
+		Annotation{Key: "Client.Response.StatusCode", Value: []byte("503")},
+		Annotation{Key: ServiceKey, Value: []byte("users")},
+	)
+	collect(SpanID{3, 30, 0}, "/b", base.Add(2*time.Second), time.Second,
+		Annotation{Key: "Server.Route", Value: []byte("b")},
+		Annotation{Key: "Server.Response.StatusCode", Value: []byte("500")},
+	)
+	st.MustCollect(SpanID{4, 40, 0}) // no name or timespan
+}
+
+// testTracesOptsQueries tests the results of Traces on a store containing
+// the traces collected by collectTracesOptsData.
+func testTracesOptsQueries(t *testing.T, s Queryer) {
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		opts TracesOpts
+		want []ID
+	}{
+		{TracesOpts{}, []ID{3, 2, 1, 4}},
+		{TracesOpts{Order: OrderOldest}, []ID{1, 2, 3, 4}},
+		{TracesOpts{Order: OrderSlowest}, []ID{2, 3, 1, 4}},
+		{TracesOpts{Order: OrderFastest}, []ID{1, 3, 2, 4}},
+		{TracesOpts{TraceIDs: []ID{1, 3, 3, 99}}, []ID{3, 1}},
+		{TracesOpts{Name: "/a"}, []ID{2, 1}},
+		{TracesOpts{MinDuration: time.Second}, []ID{3, 2}},
+		{TracesOpts{MaxDuration: time.Second}, []ID{3, 1}},
+		{TracesOpts{MinDuration: time.Second, MaxDuration: 2 * time.Second}, []ID{3}},
+		{TracesOpts{Timespan: Timespan{S: base.Add(time.Second)}}, []ID{3, 2}},
+		{TracesOpts{Timespan: Timespan{S: base, E: base.Add(time.Second)}}, []ID{2, 1}},
+		{TracesOpts{Annotations: map[string]string{"Server.Response.StatusCode": "500"}}, []ID{3}},
+		{TracesOpts{Annotations: map[string]string{"Client.Response.StatusCode": "503"}}, []ID{2}},
+		{TracesOpts{User: "alice"}, []ID{2}},
+		{TracesOpts{Route: "a"}, []ID{2, 1}},
+		{TracesOpts{Route: "a", User: "bob"}, nil},
+		{TracesOpts{Service: "users"}, []ID{2}},
+		{TracesOpts{Service: "users", Route: "b"}, nil},
+		{TracesOpts{Errors: WithErrors}, []ID{3, 2}},
+		{TracesOpts{Errors: WithoutErrors}, []ID{1, 4}},
+		{TracesOpts{Offset: 1, Limit: 2}, []ID{2, 1}},
+		{TracesOpts{Offset: 3, Limit: 2}, []ID{4}},
+		{TracesOpts{Offset: 10}, nil},
+		{TracesOpts{Errors: WithErrors, Order: OrderFastest, Limit: 1}, []ID{3}},
+	}
+	for i, test := range tests {
+		traces, err := s.Traces(test.opts)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		var got []ID
+		for _, tr := range traces {
+			got = append(got, tr.ID.Trace)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%d: Traces(%+v): got trace IDs %v, want %v", i, test.opts, got, test.want)
+		}
+	}
+}
+
+func TestSpanFailed(t *testing.T) {
+	tests := []struct {
+		anns Annotations
+		want bool
+	}{
+		{nil, false},
+		{Annotations{{Key: "Server.Response.StatusCode", Value: []byte("200")}}, false},
+		{Annotations{{Key: "Server.Response.StatusCode", Value: []byte("404")}}, false},
+		{Annotations{{Key: "Server.Response.StatusCode", Value: []byte("500")}}, true},
+		{Annotations{{Key: "Client.Response.StatusCode", Value: []byte("502")}}, true},
+		{Annotations{{Key: "Client.Response.StatusCode", Value: []byte("-1")}}, true},
+		{Annotations{{Key: "Server.Response.StatusCode", Value: []byte("-1")}}, false},
+		{Annotations{{Key: "Error.Message", Value: []byte("x")}, {Key: "_schema:Error"}}, true},
+	}
+	for _, test := range tests {
+		if got := (&Span{Annotations: test.anns}).Failed(); got != test.want {
+			t.Errorf("Failed(%v): got %v, want %v", test.anns, got, test.want)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"runtime/debug"
 	"sync"
 	"time"
 )
@@ -22,6 +23,7 @@ type Recorder struct {
 	SpanID                   // the span ID that annotations are about
 	annotations []Annotation // SpanID's annotations to be collected
 	finished    bool         // finished is whether Recorder.Finish was called
+	failed      bool         // failed is whether an ErrorEvent was recorded
 
 	collector Collector // the collector to send to
 
@@ -69,6 +71,25 @@ func (r *Recorder) LogWithTimestamp(msg string, timestamp time.Time) {
 	r.Event(LogWithTimestamp(msg, timestamp))
 }
 
+// Fail marks the span as failed by recording an ErrorEvent describing err.
+func (r *Recorder) Fail(err error) {
+	r.Event(Error(err))
+}
+
+// FailWithStack is like Fail, but also records the stack trace of the
+// calling goroutine.
+func (r *Recorder) FailWithStack(err error) {
+	e := Error(err)
+	e.Stack = string(debug.Stack())
+	r.Event(e)
+}
+
+// Failed reports whether an ErrorEvent has been recorded on the span, such
+// as by Fail.
+func (r *Recorder) Failed() bool {
+	return r.failed
+}
+
 // Event records any event that implements the Event, TimespanEvent, or
 // TimestampedEvent interfaces.
 func (r *Recorder) Event(e Event) {
@@ -77,6 +98,9 @@ func (r *Recorder) Event(e Event) {
 		r.error("Event", err)
 		return
 	}
+	if _, ok := e.(ErrorEvent); ok {
+		r.failed = true
+	}
 	r.annotations = append(r.annotations, as...)
 }
 
@@ -43,6 +43,35 @@ func TestRecorder(t *testing.T) {
 	}
 }
 
+func TestRecorder_Fail(t *testing.T) {
+	var anns Annotations
+	c := collectorFunc(func(spanID SpanID, as ...Annotation) error {
+		anns = append(anns, as...)
+		return nil
+	})
+
+	r := NewRecorder(SpanID{1, 2, 3}, c)
+	if r.Failed() {
+		t.Error("got Failed true before Fail")
+	}
+	r.FailWithStack(errors.New("boom"))
+	if !r.Failed() {
+		t.Error("got Failed false after Fail")
+	}
+	r.Finish()
+
+	var e ErrorEvent
+	if err := UnmarshalEvent(anns, &e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Message != "boom" || e.Type != "*errors.errorString" || !strings.Contains(e.Stack, "TestRecorder_Fail") {
+		t.Errorf("got %+v, want the error's message, type and stack", e)
+	}
+	if s := (&Span{Annotations: anns}); !s.Failed() {
+		t.Error("got span not failed, want failed")
+	}
+}
+
 func TestRecorder_Errors(t *testing.T) {
 	collectErr := errors.New("Collect error")
 	calledCollect := 0
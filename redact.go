@@ -0,0 +1,91 @@
+package appdash
+
+import (
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces the values of the annotations (or the parts of
+// them) that a RedactingCollector redacts.
+const RedactedValue = "[REDACTED]"
+
+// DefaultRedactedKeys are the keys of the annotations that hold credentials
+// in the ClientEvent and ServerEvent of the httptrace package.
+var DefaultRedactedKeys = []string{
+	"Client.Request.Headers.Authorization",
+	"Client.Request.Headers.Cookie",
+	"Client.Request.Headers.Proxy-Authorization",
+	"Client.Response.Headers.Set-Cookie",
+	"Server.Request.Headers.Authorization",
+	"Server.Request.Headers.Cookie",
+	"Server.Request.Headers.Proxy-Authorization",
+	"Server.Response.Headers.Set-Cookie",
+}
+
+// RedactingCollector is a Collector that redacts sensitive data, such as
+// credentials, from annotations before passing them on to the underlying
+// Collector.
+type RedactingCollector struct {
+	Collector
+
+	// Keys are the keys of the annotations whose values are replaced with
+	// RedactedValue. They are matched case-insensitively, and a key ending
+	// in "*" matches all keys that begin with the rest of it.
+	Keys []string
+
+	// Values are patterns of sensitive data, the matches of which are
+	// replaced with RedactedValue in the values of all annotations.
+	Values []*regexp.Regexp
+}
+
+// NewRedactingCollector returns a RedactingCollector that redacts the
+// annotations with the given keys (see RedactingCollector.Keys) before
+// passing them on to c.
+func NewRedactingCollector(c Collector, keys []string) *RedactingCollector {
+	return &RedactingCollector{Collector: c, Keys: keys}
+}
+
+// Collect implements the Collector interface by passing the span and its
+// redacted annotations on to the underlying Collector.
+func (rc *RedactingCollector) Collect(span SpanID, anns ...Annotation) error {
+	var redacted []Annotation
+	for i, a := range anns {
+		v := rc.redact(a)
+		if v == nil {
+			continue
+		}
+		if redacted == nil {
+			// Copy the annotations, which belong to the caller.
+			redacted = append([]Annotation(nil), anns...)
+		}
+		redacted[i].Value = v
+	}
+	if redacted != nil {
+		anns = redacted
+	}
+	return rc.Collector.Collect(span, anns...)
+}
+
+// redact returns the redacted value of the annotation, or nil if nothing in
+// it is redacted.
+func (rc *RedactingCollector) redact(a Annotation) []byte {
+	for _, k := range rc.Keys {
+		if prefix := strings.TrimSuffix(k, "*"); prefix != k {
+			if len(a.Key) >= len(prefix) && strings.EqualFold(a.Key[:len(prefix)], prefix) {
+				return []byte(RedactedValue)
+			}
+		} else if strings.EqualFold(a.Key, k) {
+			return []byte(RedactedValue)
+		}
+	}
+	v, redacted := a.Value, false
+	for _, re := range rc.Values {
+		if re.Match(v) {
+			v, redacted = re.ReplaceAllLiteral(v, []byte(RedactedValue)), true
+		}
+	}
+	if !redacted {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,41 @@
+package appdash
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestRedactingCollector(t *testing.T) {
+	ms := NewMemoryStore()
+	rc := NewRedactingCollector(ms, append(DefaultRedactedKeys, "Secret.*"))
+	rc.Values = []*regexp.Regexp{regexp.MustCompile(`token=\w+`)}
+
+	anns := Annotations{
+		{Key: "client.request.headers.cookie", Value: []byte("session=secret")},
+		{Key: "Secret.Key", Value: []byte("k")},
+		{Key: "SecretKey", Value: []byte("k")},
+		{Key: "Request.URI", Value: []byte("/a?token=abc&b=token=def")},
+	}
+	span := SpanID{1, 2, 0}
+	if err := rc.Collect(span, anns...); err != nil {
+		t.Fatal(err)
+	}
+
+	trace, err := ms.Trace(span.Trace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Annotations{
+		{Key: "client.request.headers.cookie", Value: []byte(RedactedValue)},
+		{Key: "Secret.Key", Value: []byte(RedactedValue)},
+		{Key: "SecretKey", Value: []byte("k")},
+		{Key: "Request.URI", Value: []byte("/a?" + RedactedValue + "&b=" + RedactedValue)},
+	}
+	if !reflect.DeepEqual(trace.Annotations, want) {
+		t.Errorf("got %v, want %v", trace.Annotations, want)
+	}
+	if string(anns[0].Value) == RedactedValue {
+		t.Error("the caller's annotations were modified")
+	}
+}
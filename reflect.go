@@ -184,7 +184,7 @@ func unflattenValue(prefix string, v reflect.Value, t reflect.Type, kv *[][2]str
 	if len(*kv) == 0 {
 		return nil
 	}
-	if !strings.HasPrefix((*kv)[0][0], prefix) && t.Kind() != reflect.Map { // map can have 0 fields
+	if !strings.HasPrefix((*kv)[0][0], prefix) && t.Kind() != reflect.Map && t.Kind() != reflect.Slice { // maps and slices can have 0 fields
 		*kv = (*kv)[1:]
 		return unflattenValue(prefix, v, t, kv)
 	}
@@ -269,6 +269,9 @@ func unflattenValue(prefix string, v reflect.Value, t reflect.Type, kv *[][2]str
 		}
 	case reflect.Slice, reflect.Array:
 		keyPrefix := prefix + "."
+		for len(*kv) > 0 && (*kv)[0][0] < keyPrefix {
+			*kv = (*kv)[1:] // skip values that precede the slice's elements
+		}
 		type elem struct {
 			i int
 			s string
@@ -294,6 +297,9 @@ func unflattenValue(prefix string, v reflect.Value, t reflect.Type, kv *[][2]str
 			*kv = (*kv)[1:]
 		}
 
+		if len(elems) == 0 {
+			return nil
+		}
 		if v.Kind() == reflect.Slice {
 			v.Set(reflect.MakeSlice(t, maxI+1, maxI+1))
 		}
@@ -567,6 +567,32 @@ func TestUnflatten_emptyMap(t *testing.T) {
 
 }
 
+func TestUnflatten_emptySlice(t *testing.T) {
+	type T struct {
+		A []string
+		B string
+		C []int
+		D string
+	}
+	m := map[string]string{
+		"B": "b",
+		"D": "d",
+	}
+
+	want := T{
+		B: "b",
+		D: "d",
+	}
+
+	var gotE T
+	if err := unflattenValue("", reflect.ValueOf(&gotE), reflect.TypeOf(&gotE), mapToKVs(m)); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotE, want) {
+		t.Errorf("got %#v, want %#v", gotE, want)
+	}
+}
+
 type testInnerEvent struct {
 	Days  map[string]int
 	Other []bool
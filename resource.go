@@ -0,0 +1,170 @@
+package appdash
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ResourcePrefix is the prefix of the keys of the annotations that hold the
+// attributes of the resource that recorded a span. For example, the service
+// name is the "Resource.service.name" annotation.
+const ResourcePrefix = "Resource."
+
+// Attributes of resources. Their names are those of the OpenTelemetry
+// semantic conventions, so that spans received from OpenTelemetry SDKs (see
+// the otlp package) have the same attributes.
+const (
+	ServiceNameAttribute    = "service.name"
+	ServiceVersionAttribute = "service.version"
+	HostNameAttribute       = "host.name"
+	ProcessPIDAttribute     = "process.pid"
+	ProcessNameAttribute    = "process.executable.name"
+)
+
+// ServiceKey is the annotation key of the name of the service that recorded
+// a span. Queries and aggregates may filter and group spans by it.
+const ServiceKey = ResourcePrefix + ServiceNameAttribute
+
+// A Resource identifies what recorded spans: the service, and the process,
+// host, build, etc. that it runs as. It is set once on a collector (see
+// NewResourceCollector and RemoteCollector.Resource), which sends it with the
+// annotations of each span, so that the spans of different services can be
+// told apart.
+type Resource struct {
+	// Service is the name of the service, such as "frontend".
+	Service string
+
+	// Attributes are the other attributes of the resource, such as
+	// "service.version" or "host.name".
+	Attributes map[string]string
+}
+
+// NewProcessResource returns a Resource for the named service, running as
+// the current process on this host.
+func NewProcessResource(service string) *Resource {
+	r := &Resource{
+		Service: service,
+		Attributes: map[string]string{
+			ProcessPIDAttribute:  strconv.Itoa(os.Getpid()),
+			ProcessNameAttribute: filepath.Base(os.Args[0]),
+		},
+	}
+	if host, err := os.Hostname(); err == nil {
+		r.Attributes[HostNameAttribute] = host
+	}
+	return r
+}
+
+// Annotations returns the resource as annotations, the service name first
+// and then the other attributes in order of their names.
+func (r *Resource) Annotations() Annotations {
+	var as Annotations
+	if r.Service != "" {
+		as = append(as, Annotation{Key: ServiceKey, Value: []byte(r.Service)})
+	}
+	keys := make([]string, 0, len(r.Attributes))
+	for k := range r.Attributes {
+		if k != ServiceNameAttribute {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		as = append(as, Annotation{Key: ResourcePrefix + k, Value: []byte(r.Attributes[k])})
+	}
+	return as
+}
+
+// annotate returns anns with the resource's annotations appended, unless
+// anns already has a resource (such as when it is relayed from another
+// service's collector).
+func (r *Resource) annotate(anns Annotations) Annotations {
+	if r == nil || anns.hasResource() {
+		return anns
+	}
+	return append(anns[:len(anns):len(anns)], r.Annotations()...)
+}
+
+// hasResource reports whether any of the annotations is of a resource.
+func (as Annotations) hasResource() bool {
+	for _, a := range as {
+		if strings.HasPrefix(a.Key, ResourcePrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resource returns the resource that recorded the span, or nil if its
+// annotations have none.
+func (s *Span) Resource() *Resource {
+	var r *Resource
+	for _, a := range s.Annotations {
+		if !strings.HasPrefix(a.Key, ResourcePrefix) {
+			continue
+		}
+		if r == nil {
+			r = &Resource{}
+		}
+		k := a.Key[len(ResourcePrefix):]
+		if k == ServiceNameAttribute {
+			if r.Service == "" {
+				r.Service = string(a.Value)
+			}
+			continue
+		}
+		if r.Attributes == nil {
+			r.Attributes = make(map[string]string)
+		}
+		if _, ok := r.Attributes[k]; !ok {
+			r.Attributes[k] = string(a.Value)
+		}
+	}
+	return r
+}
+
+// Service returns the name of the service that recorded the span, or "" if
+// it is unknown. If more than one service recorded the span, it returns the
+// first (see Services).
+func (s *Span) Service() string {
+	return string(s.Annotations.get(ServiceKey))
+}
+
+// Services returns the names of the services that recorded the span, in the
+// order that their annotations were collected. A span has more than one
+// service when the client and the server of an HTTP request (see the
+// httptrace package) both record it.
+func (s *Span) Services() []string {
+	var services []string
+	seen := make(map[string]bool)
+	for _, a := range s.Annotations {
+		if a.Key == ServiceKey && !seen[string(a.Value)] {
+			seen[string(a.Value)] = true
+			services = append(services, string(a.Value))
+		}
+	}
+	return services
+}
+
+// resourceCollector is a Collector that annotates spans with a resource.
+type resourceCollector struct {
+	Collector
+	r *Resource
+}
+
+// NewResourceCollector returns a Collector that adds the annotations of the
+// resource r to each collection of a span, before passing it to the
+// underlying collector c. Collections that already have a resource are
+// passed on unchanged. A Recorder collects each span once (when it is
+// finished), so the resource is recorded once per span.
+func NewResourceCollector(c Collector, r *Resource) Collector {
+	return &resourceCollector{Collector: c, r: r}
+}
+
+// Collect implements the Collector interface.
+func (rc *resourceCollector) Collect(id SpanID, anns ...Annotation) error {
+	return rc.Collector.Collect(id, rc.r.annotate(anns)...)
+}
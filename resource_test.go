@@ -0,0 +1,76 @@
+package appdash
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResourceCollector(t *testing.T) {
+	ms := NewMemoryStore()
+	r := &Resource{
+		Service:    "frontend",
+		Attributes: map[string]string{HostNameAttribute: "web-1", ServiceVersionAttribute: "1.2"},
+	}
+	c := NewResourceCollector(ms, r)
+
+	anns := Annotations{{Key: "k", Value: []byte("v")}}
+	if err := c.Collect(SpanID{1, 1, 0}, anns...); err != nil {
+		t.Fatal(err)
+	}
+	want := Annotations{
+		{Key: "k", Value: []byte("v")},
+		{Key: "Resource.service.name", Value: []byte("frontend")},
+		{Key: "Resource.host.name", Value: []byte("web-1")},
+		{Key: "Resource.service.version", Value: []byte("1.2")},
+	}
+	tr, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(tr.Span.Annotations, want) {
+		t.Errorf("got annotations %v, want %v", tr.Span.Annotations, want)
+	}
+	if len(anns) != 1 {
+		t.Errorf("the caller's annotations were changed to %v", anns)
+	}
+	if got := tr.Span.Service(); got != "frontend" {
+		t.Errorf("got service %q, want %q", got, "frontend")
+	}
+	if got := tr.Span.Resource(); !reflect.DeepEqual(got, r) {
+		t.Errorf("got resource %+v, want %+v", got, r)
+	}
+
+	// Spans relayed with another service's resource keep it.
+	relayed := Annotations{{Key: ServiceKey, Value: []byte("backend")}}
+	if err := c.Collect(SpanID{2, 2, 0}, relayed...); err != nil {
+		t.Fatal(err)
+	}
+	if tr, err := ms.Trace(2); err != nil || !reflect.DeepEqual(tr.Span.Annotations, relayed) {
+		t.Errorf("got trace %v (err %v), want annotations %v", tr, err, relayed)
+	}
+}
+
+func TestSpan_Resource_none(t *testing.T) {
+	s := &Span{Annotations: Annotations{{Key: "Name", Value: []byte("a")}}}
+	if r := s.Resource(); r != nil {
+		t.Errorf("got resource %+v, want nil", r)
+	}
+	if svc := s.Service(); svc != "" {
+		t.Errorf("got service %q, want none", svc)
+	}
+}
+
+func TestSpan_Services(t *testing.T) {
+	// The client and the server of an HTTP request record the same span.
+	s := &Span{Annotations: append(append(
+		(&Resource{Service: "frontend"}).Annotations(),
+		(&Resource{Service: "api"}).Annotations()...),
+		(&Resource{Service: "frontend"}).Annotations()...),
+	}
+	if got, want := s.Services(), []string{"frontend", "api"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got services %q, want %q", got, want)
+	}
+	if got := s.Service(); got != "frontend" {
+		t.Errorf("got service %q, want %q", got, "frontend")
+	}
+}
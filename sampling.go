@@ -0,0 +1,535 @@
+package appdash
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// A Sampler decides which traces to keep.
+type Sampler interface {
+	// Sample reports whether to keep the trace that span belongs to. The
+	// name is that of the span (see SpanName), or "" if it is not known.
+	Sample(span SpanID, name string) bool
+}
+
+// SamplerFunc is an adapter to allow the use of ordinary functions as
+// Samplers.
+type SamplerFunc func(span SpanID, name string) bool
+
+// Sample implements the Sampler interface by calling f(span, name).
+func (f SamplerFunc) Sample(span SpanID, name string) bool {
+	return f(span, name)
+}
+
+// ProbabilisticSampler is a Sampler that keeps the given fraction of traces,
+// between 0 (none) and 1 (all).
+//
+// The decision depends only on the trace ID, so processes that sample with
+// the same rate keep the same traces, and the spans of a trace are kept
+// together even when they are collected by different processes.
+type ProbabilisticSampler float64
+
+// Sample implements the Sampler interface.
+func (p ProbabilisticSampler) Sample(span SpanID, name string) bool {
+	switch {
+	case p <= 0:
+		return false
+	case p >= 1:
+		return true
+	}
+	return float64(mixID(span.Trace)) < float64(p)*(1<<64)
+}
+
+// mixID scrambles the bits of id (with the SplitMix64 finalizer), so that
+// sampling by ID is fair even for IDs that are not random.
+func mixID(id ID) uint64 {
+	x := uint64(id)
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// RateLimitingSampler is a Sampler that keeps at most PerSecond traces per
+// second on average, allowing bursts of up to one second's worth of traces.
+type RateLimitingSampler struct {
+	// PerSecond is the number of traces per second to keep.
+	PerSecond float64
+
+	mu     sync.Mutex // guards tokens and last
+	tokens float64
+	last   time.Time
+
+	now func() time.Time // for testing; time.Now if nil
+}
+
+// NewRateLimitingSampler returns a RateLimitingSampler that keeps at most
+// perSecond traces per second.
+func NewRateLimitingSampler(perSecond float64) *RateLimitingSampler {
+	return &RateLimitingSampler{PerSecond: perSecond}
+}
+
+// Sample implements the Sampler interface.
+func (s *RateLimitingSampler) Sample(span SpanID, name string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.now != nil {
+		now = s.now()
+	}
+	burst := s.PerSecond
+	if burst < 1 {
+		burst = 1
+	}
+	if s.last.IsZero() {
+		s.tokens = burst
+	} else {
+		s.tokens += now.Sub(s.last).Seconds() * s.PerSecond
+		if s.tokens > burst {
+			s.tokens = burst
+		}
+	}
+	s.last = now
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
+
+// NameSampler is a Sampler that uses a different Sampler for each span
+// name.
+type NameSampler struct {
+	// Samplers maps span names to the Samplers that decide whether to keep
+	// the spans with those names.
+	Samplers map[string]Sampler
+
+	// Default decides whether to keep spans whose names are not in
+	// Samplers. If nil, those spans are kept.
+	Default Sampler
+}
+
+// Sample implements the Sampler interface.
+func (s *NameSampler) Sample(span SpanID, name string) bool {
+	if ss, ok := s.Samplers[name]; ok {
+		return ss.Sample(span, name)
+	}
+	if s.Default != nil {
+		return s.Default.Sample(span, name)
+	}
+	return true
+}
+
+// samplingDecisions remembers whether traces were kept, so that the spans of
+// a trace collected after the decision are treated the same way.
+type samplingDecisions struct {
+	m         map[ID]samplingDecision
+	lastSweep time.Time
+}
+
+type samplingDecision struct {
+	keep bool
+	seen time.Time // when a span of the trace was last collected
+}
+
+// get returns the decision for trace, and ok == false if there is none.
+func (d *samplingDecisions) get(trace ID, now time.Time) (keep, ok bool) {
+	dec, ok := d.m[trace]
+	if ok {
+		dec.seen = now
+		d.m[trace] = dec
+	}
+	return dec.keep, ok
+}
+
+// set records the decision for trace. Decisions for traces that have not
+// been seen for ttl are forgotten.
+func (d *samplingDecisions) set(trace ID, keep bool, now time.Time, ttl time.Duration) {
+	if d.m == nil {
+		d.m = make(map[ID]samplingDecision)
+		d.lastSweep = now
+	}
+	d.m[trace] = samplingDecision{keep: keep, seen: now}
+	if now.Sub(d.lastSweep) < ttl {
+		return
+	}
+	for id, dec := range d.m {
+		if now.Sub(dec.seen) >= ttl {
+			delete(d.m, id)
+		}
+	}
+	d.lastSweep = now
+}
+
+// SamplingCollector is a Collector that passes only the spans of the traces
+// chosen by its Sampler on to the underlying Collector, and drops the rest.
+//
+// The decision for a trace is made when its first span is collected, and is
+// applied to the spans of the trace collected after it. As root spans are
+// often collected last (after the spans of their children), a NameSampler
+// used with a SamplingCollector usually sees the names of child spans; use a
+// TailSamplingCollector to sample by the names of root spans.
+type SamplingCollector struct {
+	Collector
+
+	// Sampler decides which traces to keep.
+	Sampler Sampler
+
+	// DecisionTTL is how long the decision for a trace is remembered after
+	// one of its spans was last collected. Spans of the trace collected
+	// later are treated as the first spans of a new trace.
+	//
+	// Default DecisionTTL = 1 * time.Minute.
+	DecisionTTL time.Duration
+
+	mu        sync.Mutex // guards decisions
+	decisions samplingDecisions
+}
+
+// NewSamplingCollector returns a SamplingCollector that passes the traces
+// chosen by s on to c.
+func NewSamplingCollector(c Collector, s Sampler) *SamplingCollector {
+	return &SamplingCollector{
+		Collector:   c,
+		Sampler:     s,
+		DecisionTTL: 1 * time.Minute,
+	}
+}
+
+// Collect implements the Collector interface by passing the span and its
+// annotations on to the underlying Collector if the trace it belongs to is
+// kept.
+func (sc *SamplingCollector) Collect(span SpanID, anns ...Annotation) error {
+	now := time.Now()
+	sc.mu.Lock()
+	keep, ok := sc.decisions.get(span.Trace, now)
+	if !ok {
+		keep = sc.Sampler.Sample(span, string(Annotations(anns).get("Name")))
+		sc.decisions.set(span.Trace, keep, now, sc.DecisionTTL)
+	}
+	sc.mu.Unlock()
+
+	if !keep {
+		return nil
+	}
+	return sc.Collector.Collect(span, anns...)
+}
+
+// TailSamplingCollector is a Collector that buffers the spans of each trace
+// for a while after the first of them is collected, and then decides whether
+// to pass the whole trace on to the underlying Collector. It keeps failed
+// and slow traces, and samples the rest.
+type TailSamplingCollector struct {
+	Collector
+
+	// Wait is how long the spans of a trace are buffered, from when the
+	// first of them is collected, before deciding whether to keep the
+	// trace. Spans of the trace collected after the decision are passed on
+	// or dropped according to it.
+	//
+	// Default Wait = 5 * time.Second.
+	Wait time.Duration
+
+	// KeepFailed, if true, keeps the traces that contain a failed span
+	// (for example, an HTTP 5xx response).
+	KeepFailed bool
+
+	// MinDuration, if non-zero, keeps the traces that last at least
+	// MinDuration, from the earliest start to the latest end of the
+	// timespan events of their spans.
+	MinDuration time.Duration
+
+	// Sampler, if non-nil, decides whether to keep the traces that are
+	// neither failed nor slow, given their root span (or their first
+	// collected span, if the root span was not collected). If nil, those
+	// traces are dropped.
+	Sampler Sampler
+
+	// MaxTraces is the maximum number of traces to buffer. When it is
+	// reached, the decision for the trace that has been buffered longest
+	// is made early.
+	//
+	// Default MaxTraces = 10000.
+	MaxTraces int
+
+	// DecisionTTL is how long the decision for a trace is remembered after
+	// one of its spans was last collected.
+	//
+	// Default DecisionTTL = 1 * time.Minute.
+	DecisionTTL time.Duration
+
+	// Log, if non-nil, is used to log errors from the underlying Collector
+	// when traces are passed on to it in the background.
+	Log *log.Logger
+
+	// The last error from the underlying Collector's Collect method, if
+	// any. It will be returned to the next caller of Collect and this
+	// field will be set to nil.
+	lastErr error
+
+	started, stopped bool
+	stopChan         chan struct{}
+
+	pending   map[ID]*tailTrace
+	queue     []ID // pending traces, in the order they were first collected
+	decisions samplingDecisions
+
+	// mu protects lastErr, started, stopped, stopChan, pending, queue and
+	// decisions.
+	mu sync.Mutex
+}
+
+// tailTrace is a trace buffered by a TailSamplingCollector.
+type tailTrace struct {
+	first       time.Time // when the first span was collected
+	collections []tailCollection
+	failed      bool
+
+	start, end time.Time // of the timespan events, if haveTimes
+	haveTimes  bool
+
+	span     SpanID // the root span, or else the first collected span
+	name     string // the name of span
+	haveRoot bool
+}
+
+type tailCollection struct {
+	span SpanID
+	anns Annotations
+}
+
+// add buffers a collection of the trace.
+func (t *tailTrace) add(span SpanID, anns Annotations) {
+	t.collections = append(t.collections, tailCollection{span, anns})
+	if !t.failed && (&Span{ID: span, Annotations: anns}).Failed() {
+		t.failed = true
+	}
+
+	var events []Event
+	if err := UnmarshalEvents(anns, &events); err == nil {
+		if start, end, ok := findTraceTimes(events); ok {
+			if !t.haveTimes || start.Before(t.start) {
+				t.start = start
+			}
+			if !t.haveTimes || end.After(t.end) {
+				t.end = end
+			}
+			t.haveTimes = true
+		}
+	}
+
+	if isRoot := span.Parent == 0; len(t.collections) == 1 || (isRoot && !t.haveRoot) {
+		t.span, t.name, t.haveRoot = span, "", isRoot
+	}
+	if span == t.span && t.name == "" {
+		t.name = string(anns.get("Name"))
+	}
+}
+
+// NewTailSamplingCollector is shorthand for:
+//
+//	c := &TailSamplingCollector{
+//		Collector:   c,
+//		Wait:        5 * time.Second,
+//		KeepFailed:  true,
+//		MinDuration: minDuration,
+//		MaxTraces:   10000,
+//		DecisionTTL: 1 * time.Minute,
+//		Log:         log.New(os.Stderr, "appdash: ", log.LstdFlags),
+//	}
+func NewTailSamplingCollector(c Collector, minDuration time.Duration) *TailSamplingCollector {
+	return &TailSamplingCollector{
+		Collector:   c,
+		Wait:        5 * time.Second,
+		KeepFailed:  true,
+		MinDuration: minDuration,
+		MaxTraces:   10000,
+		DecisionTTL: 1 * time.Minute,
+		Log:         log.New(os.Stderr, "appdash: ", log.LstdFlags),
+	}
+}
+
+// Collect implements the Collector interface by buffering the span and its
+// annotations until the decision for its trace is made, or, if it has
+// already been made, by passing them on to the underlying Collector or
+// dropping them according to it.
+func (tc *TailSamplingCollector) Collect(span SpanID, anns ...Annotation) error {
+	now := time.Now()
+	tc.mu.Lock()
+	if tc.stopped {
+		tc.mu.Unlock()
+		return errors.New("TailSamplingCollector is stopped")
+	}
+	if !tc.started {
+		tc.start()
+	}
+	err := tc.lastErr
+	tc.lastErr = nil
+
+	if keep, ok := tc.decisions.get(span.Trace, now); ok {
+		tc.mu.Unlock()
+		if !keep {
+			return err
+		}
+		if err2 := tc.Collector.Collect(span, anns...); err2 != nil {
+			return err2
+		}
+		return err
+	}
+
+	if tc.pending == nil {
+		tc.pending = make(map[ID]*tailTrace)
+	}
+	t, present := tc.pending[span.Trace]
+	if !present {
+		t = &tailTrace{first: now}
+		tc.pending[span.Trace] = t
+		tc.queue = append(tc.queue, span.Trace)
+	}
+	t.add(span, anns)
+
+	var kept []*tailTrace
+	if tc.MaxTraces > 0 && len(tc.pending) > tc.MaxTraces {
+		kept = tc.decideNoLock(1, now)
+	}
+	tc.mu.Unlock()
+
+	if err2 := tc.forward(kept); err2 != nil {
+		return err2
+	}
+	return err
+}
+
+// decideNoLock makes the decisions for the n traces that have been buffered
+// longest (or all of them, if n < 0) and returns those that are kept. It
+// must be called with tc.mu held.
+func (tc *TailSamplingCollector) decideNoLock(n int, now time.Time) []*tailTrace {
+	if n < 0 || n > len(tc.queue) {
+		n = len(tc.queue)
+	}
+	var kept []*tailTrace
+	for _, id := range tc.queue[:n] {
+		t := tc.pending[id]
+		delete(tc.pending, id)
+		keep := tc.keep(t)
+		tc.decisions.set(id, keep, now, tc.DecisionTTL)
+		if keep {
+			kept = append(kept, t)
+		}
+	}
+	tc.queue = tc.queue[n:]
+	return kept
+}
+
+// keep reports whether to keep the trace t.
+func (tc *TailSamplingCollector) keep(t *tailTrace) bool {
+	if tc.KeepFailed && t.failed {
+		return true
+	}
+	if tc.MinDuration != 0 && t.haveTimes && t.end.Sub(t.start) >= tc.MinDuration {
+		return true
+	}
+	if tc.Sampler != nil {
+		return tc.Sampler.Sample(t.span, t.name)
+	}
+	return false
+}
+
+// forward passes the spans of the kept traces on to the underlying
+// Collector.
+func (tc *TailSamplingCollector) forward(kept []*tailTrace) error {
+	var errs []error
+	for _, t := range kept {
+		for _, c := range t.collections {
+			if err := tc.Collector.Collect(c.span, c.anns...); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	} else if len(errs) > 1 {
+		return fmt.Errorf("TailSamplingCollector: multiple errors: %v", errs)
+	}
+	return nil
+}
+
+// Flush immediately makes the decisions for all buffered traces, and passes
+// the spans of those that are kept on to the underlying collector.
+func (tc *TailSamplingCollector) Flush() error {
+	tc.mu.Lock()
+	kept := tc.decideNoLock(-1, time.Now())
+	tc.mu.Unlock()
+	return tc.forward(kept)
+}
+
+// flushExpired makes the decisions for the traces that have been buffered
+// for at least Wait.
+func (tc *TailSamplingCollector) flushExpired() error {
+	now := time.Now()
+	tc.mu.Lock()
+	n := 0
+	for _, id := range tc.queue {
+		if now.Sub(tc.pending[id].first) < tc.Wait {
+			break
+		}
+		n++
+	}
+	var kept []*tailTrace
+	if n > 0 {
+		kept = tc.decideNoLock(n, now)
+	}
+	tc.mu.Unlock()
+	return tc.forward(kept)
+}
+
+func (tc *TailSamplingCollector) start() {
+	tc.stopChan = make(chan struct{})
+	tc.started = true
+	interval := tc.Wait / 4
+	if interval <= 0 {
+		interval = time.Millisecond
+	}
+	go func() {
+		for {
+			t := time.After(interval)
+			select {
+			case <-t:
+				if err := tc.flushExpired(); err != nil {
+					tc.mu.Lock()
+					tc.lastErr = err
+					if tc.Log != nil {
+						tc.Log.Printf("TailSamplingCollector: %s", err)
+					}
+					tc.mu.Unlock()
+				}
+			case <-tc.stopChan:
+				return // stop
+			}
+		}
+	}()
+}
+
+// Stop stops the collector, after making the decisions for all buffered
+// traces and passing the spans of those that are kept on to the underlying
+// collector. After stopping, calls to Collect will fail.
+func (tc *TailSamplingCollector) Stop() error {
+	tc.mu.Lock()
+	if tc.stopped {
+		tc.mu.Unlock()
+		return nil
+	}
+	if tc.started {
+		close(tc.stopChan)
+	}
+	tc.stopped = true
+	tc.mu.Unlock()
+	return tc.Flush()
+}
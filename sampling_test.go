@@ -0,0 +1,217 @@
+package appdash
+
+import (
+	"io/ioutil"
+	"log"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestProbabilisticSampler(t *testing.T) {
+	for _, rate := range []float64{0, 0.1, 0.5, 1} {
+		s := ProbabilisticSampler(rate)
+		n := 0
+		for i := 1; i <= 10000; i++ {
+			span := SpanID{Trace: ID(i), Span: ID(i)}
+			keep := s.Sample(span, "")
+			if keep != s.Sample(SpanID{Trace: ID(i), Span: 1, Parent: ID(i)}, "other") {
+				t.Fatalf("rate %v: decision for trace %d depends on the span", rate, i)
+			}
+			if keep {
+				n++
+			}
+		}
+		if got, want := float64(n)/10000, rate; got < want-0.02 || got > want+0.02 {
+			t.Errorf("rate %v: kept %v of traces", rate, got)
+		}
+	}
+}
+
+func TestRateLimitingSampler(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := NewRateLimitingSampler(2)
+	s.now = func() time.Time { return now }
+
+	sample := func() (n int) {
+		for i := 0; i < 10; i++ {
+			if s.Sample(SpanID{}, "") {
+				n++
+			}
+		}
+		return n
+	}
+	if n := sample(); n != 2 {
+		t.Errorf("initial burst: kept %d traces, want 2", n)
+	}
+	now = now.Add(500 * time.Millisecond)
+	if n := sample(); n != 1 {
+		t.Errorf("after 500ms: kept %d traces, want 1", n)
+	}
+	now = now.Add(time.Minute)
+	if n := sample(); n != 2 {
+		t.Errorf("after 1m: kept %d traces, want 2", n)
+	}
+}
+
+func TestNameSampler(t *testing.T) {
+	s := &NameSampler{
+		Samplers: map[string]Sampler{"/health": ProbabilisticSampler(0)},
+	}
+	if s.Sample(SpanID{Trace: 1}, "/health") {
+		t.Error("kept /health, want dropped")
+	}
+	if !s.Sample(SpanID{Trace: 1}, "/") {
+		t.Error("dropped /, want kept")
+	}
+	s.Default = ProbabilisticSampler(0)
+	if s.Sample(SpanID{Trace: 1}, "/") {
+		t.Error("kept / with dropping Default, want dropped")
+	}
+}
+
+func TestSamplingCollector(t *testing.T) {
+	ms := NewMemoryStore()
+	sc := NewSamplingCollector(ms, &NameSampler{
+		Samplers: map[string]Sampler{"drop": ProbabilisticSampler(0)},
+	})
+	collect := func(span SpanID, name string) {
+		anns, _ := MarshalEvent(SpanName(name))
+		if err := sc.Collect(span, anns...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	collect(SpanID{1, 2, 1}, "drop")
+	collect(SpanID{1, 1, 0}, "keep") // decided by the first span
+	collect(SpanID{2, 2, 1}, "keep")
+	collect(SpanID{2, 1, 0}, "drop")
+
+	if _, err := ms.Trace(1); err != ErrTraceNotFound {
+		t.Errorf("Trace(1): got err %v, want ErrTraceNotFound", err)
+	}
+	tr, err := ms.Trace(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tr.Sub) != 1 {
+		t.Errorf("Trace(2): got %d sub-traces, want 1", len(tr.Sub))
+	}
+}
+
+func TestTailSamplingCollector(t *testing.T) {
+	ms := NewMemoryStore()
+	tc := NewTailSamplingCollector(ms, time.Second)
+	tc.Wait = time.Hour // decide on Flush only
+	tc.Sampler = &NameSampler{
+		Samplers: map[string]Sampler{"/sampled": ProbabilisticSampler(1)},
+		Default:  ProbabilisticSampler(0),
+	}
+	tc.Log = log.New(ioutil.Discard, "", 0)
+	defer tc.Stop()
+
+	collect := func(span SpanID, name string, d time.Duration, status int) {
+		var anns Annotations
+		start := time.Unix(0, 0)
+		for _, e := range []Event{SpanName(name), timespanEvent{S: start, E: start.Add(d)}} {
+			as, err := MarshalEvent(e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			anns = append(anns, as...)
+		}
+		if status != 0 {
+			anns = append(anns, Annotation{Key: serverStatusCodeKey, Value: []byte(strconv.Itoa(status))})
+		}
+		if err := tc.Collect(span, anns...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	collect(SpanID{1, 2, 1}, "child", 10*time.Millisecond, 0)
+	collect(SpanID{1, 1, 0}, "/", 20*time.Millisecond, 0) // healthy
+	collect(SpanID{2, 2, 1}, "child", 10*time.Millisecond, 500)
+	collect(SpanID{2, 1, 0}, "/", 20*time.Millisecond, 200) // failed child
+	collect(SpanID{3, 2, 1}, "child", 2*time.Second, 0)
+	collect(SpanID{3, 1, 0}, "/", 20*time.Millisecond, 0) // slow child
+	collect(SpanID{4, 2, 1}, "child", 10*time.Millisecond, 0)
+	collect(SpanID{4, 1, 0}, "/sampled", 20*time.Millisecond, 0) // sampled by root name
+
+	traces, err := ms.Traces(TracesOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(traces) != 0 {
+		t.Errorf("got %d traces before Flush, want 0", len(traces))
+	}
+
+	if err := tc.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ms.Trace(1); err != ErrTraceNotFound {
+		t.Errorf("Trace(1): got err %v, want ErrTraceNotFound", err)
+	}
+	for _, id := range []ID{2, 3, 4} {
+		tr, err := ms.Trace(id)
+		if err != nil {
+			t.Errorf("Trace(%v): %s", id, err)
+			continue
+		}
+		if len(tr.Sub) != 1 {
+			t.Errorf("Trace(%v): got %d sub-traces, want 1", id, len(tr.Sub))
+		}
+	}
+
+	// Spans collected after the decision follow it.
+	collect(SpanID{1, 3, 1}, "late", 0, 0)
+	collect(SpanID{2, 3, 1}, "late", 0, 0)
+	if _, err := ms.Trace(1); err != ErrTraceNotFound {
+		t.Errorf("Trace(1): got err %v, want ErrTraceNotFound", err)
+	}
+	if tr := (storeT{t, ms}).MustTrace(2); len(tr.Sub) != 2 {
+		t.Errorf("Trace(2): got %d sub-traces, want 2", len(tr.Sub))
+	}
+}
+
+func TestTailSamplingCollector_wait(t *testing.T) {
+	ms := NewMemoryStore()
+	tc := NewTailSamplingCollector(ms, 0)
+	tc.Wait = 20 * time.Millisecond
+	tc.Log = log.New(ioutil.Discard, "", 0)
+	defer tc.Stop()
+
+	if err := tc.Collect(SpanID{1, 1, 0}, Annotation{Key: serverStatusCodeKey, Value: []byte("503")}); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := ms.Trace(1); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("failed trace was not passed on after Wait")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestTailSamplingCollector_maxTraces(t *testing.T) {
+	ms := NewMemoryStore()
+	tc := NewTailSamplingCollector(ms, 0)
+	tc.Wait = time.Hour
+	tc.MaxTraces = 1
+	tc.Sampler = ProbabilisticSampler(1)
+	defer tc.Stop()
+
+	if err := tc.Collect(SpanID{1, 1, 0}); err != nil {
+		t.Fatal(err)
+	}
+	// Buffering a second trace makes the decision for the first.
+	if err := tc.Collect(SpanID{2, 1, 0}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ms.Trace(1); err != nil {
+		t.Errorf("Trace(1): %s", err)
+	}
+	if _, err := ms.Trace(2); err != ErrTraceNotFound {
+		t.Errorf("Trace(2): got err %v, want ErrTraceNotFound", err)
+	}
+}
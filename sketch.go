@@ -0,0 +1,170 @@
+package appdash
+
+import (
+	"math"
+	"time"
+)
+
+// sketchAlpha is the maximum relative error of the quantiles estimated by a
+// DurationSketch.
+const sketchAlpha = 0.02
+
+// sketchGamma is the ratio between the upper bounds of consecutive buckets
+// of a DurationSketch.
+var (
+	sketchGamma    = (1 + sketchAlpha) / (1 - sketchAlpha)
+	sketchLogGamma = math.Log(sketchGamma)
+)
+
+// A DurationSketch summarizes a distribution of durations, such that its
+// quantiles can be estimated with a relative error of at most 2%. Sketches
+// can be merged, so that the distributions of durations aggregated
+// separately (such as over different time windows, or by different stores)
+// can be combined. See https://arxiv.org/abs/1908.10693 (DDSketch).
+//
+// Durations are counted in buckets whose bounds grow exponentially. The zero
+// value is an empty sketch.
+type DurationSketch struct {
+	// Offset is the index of the bucket that Counts starts at. Bucket i
+	// counts the durations d with gamma^(i-1) < d <= gamma^i nanoseconds,
+	// where gamma is (1+0.02)/(1-0.02); durations of at most one nanosecond
+	// are counted in bucket 0.
+	Offset int
+
+	// Counts is the number of durations in each bucket, from Offset.
+	Counts []int64
+}
+
+// sketchIndex returns the index of the bucket that counts d.
+func sketchIndex(d time.Duration) int {
+	if d <= 1 {
+		return 0
+	}
+	return int(math.Ceil(math.Log(float64(d)) / sketchLogGamma))
+}
+
+// sketchValue returns the estimate of the durations in bucket i, which is
+// within sketchAlpha of all of them.
+func sketchValue(i int) time.Duration {
+	if i <= 0 {
+		return 0
+	}
+	return time.Duration(2 * math.Pow(sketchGamma, float64(i)) / (sketchGamma + 1))
+}
+
+// Add adds a duration to the sketch.
+func (s *DurationSketch) Add(d time.Duration) {
+	s.addCount(sketchIndex(d), 1)
+}
+
+// addCount adds n to the count of bucket i, growing Counts as needed.
+func (s *DurationSketch) addCount(i int, n int64) {
+	switch {
+	case len(s.Counts) == 0:
+		s.Offset = i
+		s.Counts = []int64{0}
+	case i < s.Offset:
+		counts := make([]int64, len(s.Counts)+s.Offset-i)
+		copy(counts[s.Offset-i:], s.Counts)
+		s.Offset, s.Counts = i, counts
+	case i >= s.Offset+len(s.Counts):
+		s.Counts = append(s.Counts, make([]int64, i-s.Offset-len(s.Counts)+1)...)
+	}
+	s.Counts[i-s.Offset] += n
+}
+
+// Merge adds the durations counted by o to s.
+func (s *DurationSketch) Merge(o *DurationSketch) {
+	for i, n := range o.Counts {
+		if n != 0 {
+			s.addCount(o.Offset+i, n)
+		}
+	}
+}
+
+// Count returns the number of durations in the sketch.
+func (s *DurationSketch) Count() int64 {
+	var n int64
+	for _, c := range s.Counts {
+		n += c
+	}
+	return n
+}
+
+// Quantile returns an estimate of the q-quantile of the durations, such as
+// the median for q = 0.5, or zero if the sketch is empty.
+func (s *DurationSketch) Quantile(q float64) time.Duration {
+	n := s.Count()
+	if n == 0 {
+		return 0
+	}
+	if q < 0 {
+		q = 0
+	} else if q > 1 {
+		q = 1
+	}
+	// The estimate is of the duration of nearest rank.
+	rank := int64(math.Ceil(q*float64(n))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	var seen int64
+	for i, c := range s.Counts {
+		seen += c
+		if seen > rank {
+			return sketchValue(s.Offset + i)
+		}
+	}
+	return sketchValue(s.Offset + len(s.Counts) - 1)
+}
+
+// HistogramBounds are the upper bounds of the buckets of the histograms in
+// AggregatedResults.
+var HistogramBounds = []time.Duration{
+	1 * time.Millisecond, 2 * time.Millisecond, 5 * time.Millisecond,
+	10 * time.Millisecond, 20 * time.Millisecond, 50 * time.Millisecond,
+	100 * time.Millisecond, 200 * time.Millisecond, 500 * time.Millisecond,
+	1 * time.Second, 2 * time.Second, 5 * time.Second,
+	10 * time.Second, 20 * time.Second, 50 * time.Second,
+}
+
+// A HistogramBucket is the number of durations that are at most Max, and
+// greater than the Max of the previous bucket in the histogram.
+type HistogramBucket struct {
+	Max   time.Duration
+	Count int64
+}
+
+// Buckets returns a histogram of the durations, with buckets up to each of
+// the given increasing bounds, followed by a bucket up to the estimate of
+// the greatest duration if it exceeds the last bound. Empty buckets after the
+// greatest duration are omitted. Durations are placed in buckets by their
+// estimates, so counts near the bounds may be off by the sketch's error.
+func (s *DurationSketch) Buckets(bounds []time.Duration) []HistogramBucket {
+	var buckets []HistogramBucket
+	b := -1
+	for i, c := range s.Counts {
+		if c == 0 {
+			continue
+		}
+		v := sketchValue(s.Offset + i)
+		for b < len(bounds) && (b < 0 || v > bounds[b]) {
+			b++
+			max := v
+			if b < len(bounds) {
+				max = bounds[b]
+			}
+			buckets = append(buckets, HistogramBucket{Max: max})
+		}
+		if b == len(bounds) {
+			buckets[b].Max = v
+		}
+		buckets[b].Count += c
+	}
+	return buckets
+}
+
+// Copy returns a copy of s.
+func (s *DurationSketch) Copy() *DurationSketch {
+	return &DurationSketch{Offset: s.Offset, Counts: append([]int64(nil), s.Counts...)}
+}
@@ -0,0 +1,95 @@
+package appdash
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// within reports whether got is within the relative error of a
+// DurationSketch of want.
+func within(got, want time.Duration) bool {
+	diff := float64(got - want)
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= sketchAlpha*float64(want)
+}
+
+func TestDurationSketch_Quantile(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var (
+		all  []time.Duration
+		a, b DurationSketch
+	)
+	for i := 0; i < 10000; i++ {
+		d := time.Duration(r.ExpFloat64() * float64(50*time.Millisecond))
+		all = append(all, d)
+		// Add half of the durations to each sketch, to test merging.
+		if i%2 == 0 {
+			a.Add(d)
+		} else {
+			b.Add(d)
+		}
+	}
+	a.Merge(&b)
+	if n := a.Count(); n != 10000 {
+		t.Fatalf("got count %d, want 10000", n)
+	}
+	sort.Sort(durations(all))
+	for _, q := range []float64{0, 0.5, 0.9, 0.95, 0.99, 1} {
+		rank := int(math.Ceil(q*float64(len(all)))) - 1
+		if rank < 0 {
+			rank = 0
+		}
+		want := all[rank]
+		if got := a.Quantile(q); !within(got, want) {
+			t.Errorf("q=%v: got %s, want %s within %v%%", q, got, want, sketchAlpha*100)
+		}
+	}
+
+	var empty DurationSketch
+	if got := empty.Quantile(0.5); got != 0 {
+		t.Errorf("empty sketch: got %s, want 0", got)
+	}
+}
+
+func TestDurationSketch_Buckets(t *testing.T) {
+	var s DurationSketch
+	for _, d := range []time.Duration{0, 3 * time.Millisecond, 4 * time.Millisecond, 7 * time.Second} {
+		s.Add(d)
+	}
+	bounds := []time.Duration{time.Millisecond, 5 * time.Millisecond, time.Second}
+	got := s.Buckets(bounds)
+	want := []HistogramBucket{
+		{Max: time.Millisecond, Count: 1},
+		{Max: 5 * time.Millisecond, Count: 2},
+		{Max: time.Second, Count: 0},
+		{Max: 0, Count: 1},
+	}
+	if len(got) == len(want) {
+		want[3].Max = got[3].Max
+		if !within(got[3].Max, 7*time.Second) {
+			t.Errorf("got overflow bucket max %s, want about 7s", got[3].Max)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got buckets %+v, want %+v", got, want)
+	}
+
+	// Empty buckets after the greatest duration are omitted.
+	var small DurationSketch
+	small.Add(2 * time.Millisecond)
+	if got, want := small.Buckets(bounds), []HistogramBucket{{time.Millisecond, 0}, {5 * time.Millisecond, 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got buckets %+v, want %+v", got, want)
+	}
+}
+
+type durations []time.Duration
+
+func (v durations) Len() int           { return len(v) }
+func (v durations) Less(i, j int) bool { return v[i] < v[j] }
+func (v durations) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
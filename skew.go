@@ -0,0 +1,256 @@
+package appdash
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// ClockSkewKey is the key of the annotation that CorrectClockSkew adds to the
+// spans whose times it adjusted. Its value is the total adjustment, formatted
+// by time.Duration.String (such as "-1.5s").
+const ClockSkewKey = "ClockSkew.Adjustment"
+
+// Annotation keys of the times of the httptrace package's ClientEvent and
+// ServerEvent. They are duplicated here because this package cannot import
+// httptrace.
+const (
+	clientSendKey = "Client.Send"
+	clientRecvKey = "Client.Recv"
+	serverRecvKey = "Server.Recv"
+	serverSendKey = "Server.Send"
+
+	serverKeyPrefix = "Server."
+)
+
+// eventTimeKeys are the annotation keys of the times of the events of this
+// package, httptrace and sqltrace, which are the times that CorrectClockSkew
+// shifts.
+var eventTimeKeys = map[string]bool{
+	"Span.Start":  true, // Timespan
+	"Span.End":    true,
+	"S":           true, // timespanEvent
+	"E":           true,
+	"Time":        true, // logEvent
+	clientSendKey: true, // httptrace.ClientEvent
+	clientRecvKey: true,
+	serverRecvKey: true, // httptrace.ServerEvent
+	serverSendKey: true,
+	"ClientSend":  true, // sqltrace.SQLEvent
+	"ClientRecv":  true,
+}
+
+// CorrectClockSkew returns a copy of the trace in which the times of spans
+// recorded on hosts whose clocks are skewed relative to those of their
+// callers are adjusted. t is not modified.
+//
+// Skew is detected with the HTTP requests between hosts. The httptrace
+// package records the client's side of a request in one span and the
+// server's in another, which is a child of the client's span or, if the
+// Span-ID header alone passed the span along, a sibling of it; other
+// tracers (such as Zipkin's) record both sides in the same span. Either
+// way, the server must have received the request after the client sent it,
+// and responded before the client received the response. If it did not,
+// the server's times, and all times in the server span's descendants, are
+// shifted so that the server's handling of the request is centered between
+// the client's, as if the network latency were the same in both directions.
+// Requests in which the server took longer than the client, which skew
+// cannot explain, are left as they are.
+//
+// Only the times of the events of this package, httptrace and sqltrace are
+// shifted; other annotations whose values are times are left as they are.
+// Each adjusted span has a ClockSkewKey annotation.
+func (t *Trace) CorrectClockSkew() *Trace {
+	c := t.clone()
+	c.correctClockSkew()
+	return c
+}
+
+// clone returns a copy of t whose spans' annotations may be modified
+// without modifying those of t.
+func (t *Trace) clone() *Trace {
+	c := &Trace{Span: t.Span}
+	c.Annotations = append(Annotations(nil), t.Annotations...)
+	if t.Sub != nil {
+		c.Sub = make([]*Trace, len(t.Sub))
+		for i, sub := range t.Sub {
+			c.Sub[i] = sub.clone()
+		}
+	}
+	return c
+}
+
+func (t *Trace) correctClockSkew() {
+	if times, ok := eventTimes(t.Annotations, clientSendKey, clientRecvKey, serverRecvKey, serverSendKey); ok {
+		if skew := clockSkew(times[0], times[1], times[2], times[3]); skew != 0 {
+			t.shiftSpanTimes(-skew, serverKeyPrefix)
+			for _, sub := range t.Sub {
+				sub.shiftTimes(-skew)
+			}
+		}
+	}
+	for _, r := range t.requests() {
+		client, _ := eventTimes(r.client.Annotations, clientSendKey, clientRecvKey)
+		server, _ := eventTimes(r.server.Annotations, serverRecvKey, serverSendKey)
+		if skew := clockSkew(client[0], client[1], server[0], server[1]); skew != 0 {
+			r.server.shiftTimes(-skew)
+		}
+	}
+	for _, sub := range t.Sub {
+		sub.correctClockSkew()
+	}
+}
+
+// An httpRequest is an HTTP request whose client and server recorded
+// separate spans.
+type httpRequest struct {
+	client, server *Trace
+}
+
+// requests returns the HTTP requests whose client spans are t's children.
+// The server span of each is the client span's child or, failing that, the
+// sibling with the same method and URI that received the same request in
+// order of time.
+func (t *Trace) requests() []httpRequest {
+	var reqs []httpRequest
+	unmatched := map[string][]*Trace{} // client spans by method and URI
+	for _, sub := range t.Sub {
+		if !sub.isHTTPSide(clientSendKey, clientRecvKey, serverRecvKey) {
+			continue
+		}
+		matched := false
+		for _, subsub := range sub.Sub {
+			if subsub.isHTTPSide(serverRecvKey, serverSendKey, clientSendKey) {
+				reqs = append(reqs, httpRequest{client: sub, server: subsub})
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			key := sub.requestKey("Client.")
+			unmatched[key] = append(unmatched[key], sub)
+		}
+	}
+	if len(unmatched) == 0 {
+		return reqs
+	}
+
+	servers := map[string][]*Trace{} // sibling server spans by method and URI
+	for _, sub := range t.Sub {
+		if sub.isHTTPSide(serverRecvKey, serverSendKey, clientSendKey) {
+			key := sub.requestKey(serverKeyPrefix)
+			servers[key] = append(servers[key], sub)
+		}
+	}
+	for key, clients := range unmatched {
+		sort.Stable(tracesByEventTime{clients, clientSendKey})
+		sort.Stable(tracesByEventTime{servers[key], serverRecvKey})
+		for i, client := range clients {
+			if i == len(servers[key]) {
+				break
+			}
+			reqs = append(reqs, httpRequest{client: client, server: servers[key][i]})
+		}
+	}
+	return reqs
+}
+
+// isHTTPSide reports whether t's span has valid times for both keys and no
+// annotation with key other, i.e., whether it records only one side of an
+// HTTP request.
+func (t *Trace) isHTTPSide(key1, key2, other string) bool {
+	_, ok := eventTimes(t.Annotations, key1, key2)
+	return ok && t.Annotations.get(other) == nil
+}
+
+// requestKey returns the method and URI of the HTTP request recorded by t's
+// client or server event, whose annotation keys have the given prefix.
+func (t *Trace) requestKey(prefix string) string {
+	return string(t.Annotations.get(prefix+"Request.Method")) + " " + string(t.Annotations.get(prefix+"Request.URI"))
+}
+
+// tracesByEventTime sorts traces by the time in their span's annotation
+// with the given key.
+type tracesByEventTime struct {
+	ts  []*Trace
+	key string
+}
+
+func (v tracesByEventTime) Len() int      { return len(v.ts) }
+func (v tracesByEventTime) Swap(i, j int) { v.ts[i], v.ts[j] = v.ts[j], v.ts[i] }
+func (v tracesByEventTime) Less(i, j int) bool {
+	ti, _ := eventTimes(v.ts[i].Annotations, v.key)
+	tj, _ := eventTimes(v.ts[j].Annotations, v.key)
+	return ti[0].Before(tj[0])
+}
+
+// eventTimes returns the times in the annotations with the given keys, and
+// whether all are present, valid and non-zero.
+func eventTimes(as Annotations, keys ...string) ([]time.Time, bool) {
+	times := make([]time.Time, len(keys))
+	for i, key := range keys {
+		v := as.get(key)
+		if v == nil {
+			return times, false
+		}
+		tm, err := time.Parse(time.RFC3339Nano, string(v))
+		if err != nil || tm.IsZero() {
+			return times, false
+		}
+		times[i] = tm
+	}
+	return times, true
+}
+
+// clockSkew returns how far ahead the clock of the server of an HTTP request
+// is of that of its client, given the times each recorded, or zero if the
+// times are consistent.
+func clockSkew(clientSend, clientRecv, serverRecv, serverSend time.Time) time.Duration {
+	if !serverRecv.Before(clientSend) && !serverSend.After(clientRecv) {
+		return 0 // consistent
+	}
+	client, server := clientRecv.Sub(clientSend), serverSend.Sub(serverRecv)
+	if client < 0 || server < 0 || server > client {
+		return 0
+	}
+	latency := (client - server) / 2
+	return serverRecv.Sub(clientSend.Add(latency))
+}
+
+// shiftTimes shifts all times of t and its descendants by d.
+func (t *Trace) shiftTimes(d time.Duration) {
+	t.shiftSpanTimes(d, "")
+	for _, sub := range t.Sub {
+		sub.shiftTimes(d)
+	}
+}
+
+// shiftSpanTimes shifts the event times (see eventTimeKeys) of t's span
+// whose annotation keys have the given prefix by d, and adds d to its
+// ClockSkewKey annotation.
+func (t *Trace) shiftSpanTimes(d time.Duration, prefix string) {
+	total := d
+	skew := -1
+	for i, a := range t.Annotations {
+		if a.Key == ClockSkewKey {
+			skew = i
+			if prev, err := time.ParseDuration(string(a.Value)); err == nil {
+				total += prev
+			}
+			continue
+		}
+		if !eventTimeKeys[a.Key] || !strings.HasPrefix(a.Key, prefix) {
+			continue
+		}
+		tm, err := time.Parse(time.RFC3339Nano, string(a.Value))
+		if err != nil || tm.IsZero() {
+			continue
+		}
+		t.Annotations[i].Value = []byte(tm.Add(d).Format(time.RFC3339Nano))
+	}
+	if skew == -1 {
+		t.Annotations = append(t.Annotations, Annotation{Key: ClockSkewKey, Value: []byte(total.String())})
+	} else {
+		t.Annotations[skew].Value = []byte(total.String())
+	}
+}
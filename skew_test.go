@@ -0,0 +1,170 @@
+package appdash_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+)
+
+// skewedCollector is a Collector for a host whose clock is skew ahead of
+// that of the test: it shifts the times in the annotations it collects.
+type skewedCollector struct {
+	appdash.Collector
+	skew time.Duration
+}
+
+func (c skewedCollector) Collect(id appdash.SpanID, as ...appdash.Annotation) error {
+	shifted := make([]appdash.Annotation, len(as))
+	for i, a := range as {
+		shifted[i] = a
+		if tm, err := time.Parse(time.RFC3339Nano, string(a.Value)); err == nil && !tm.IsZero() {
+			shifted[i].Value = []byte(tm.Add(c.skew).Format(time.RFC3339Nano))
+		}
+	}
+	return c.Collector.Collect(id, shifted...)
+}
+
+func TestTrace_CorrectClockSkew(t *testing.T) {
+	tests := map[string][]httptrace.Propagator{
+		"Span-ID header":         nil, // the server span is the client span's sibling
+		"SpanIDPropagator":       {httptrace.SpanIDPropagator{}},
+		"TraceContextPropagator": {httptrace.TraceContextPropagator{}},
+	}
+	for name, props := range tests {
+		t.Run(name, func(t *testing.T) {
+			ms := appdash.NewMemoryStore()
+
+			// The backend's clock is 1s ahead of that of the frontend.
+			backendCollector := skewedCollector{ms, time.Second}
+			mw := httptrace.Middleware(backendCollector, &httptrace.MiddlewareConfig{Propagators: props})
+			backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mw(w, r, func(w http.ResponseWriter, r *http.Request) {
+					rec := appdash.NewChildRecorderFromContext(r.Context(), backendCollector)
+					start := time.Now()
+					time.Sleep(time.Millisecond)
+					rec.Event(appdash.Timespan{S: start, E: time.Now()})
+					rec.Finish()
+				})
+			}))
+			defer backend.Close()
+
+			root := appdash.NewRootSpanID()
+			rec := appdash.NewRecorder(root, ms)
+			client := &http.Client{Transport: &httptrace.Transport{Recorder: rec, Propagators: props}}
+			start := time.Now()
+			resp, err := client.Get(backend.URL + "/api")
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			rec.Event(appdash.Timespan{S: start, E: time.Now()})
+			rec.Finish()
+
+			tr, err := ms.Trace(root.Trace)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := tr.CorrectClockSkew()
+
+			clientSpan := findSpan(got, "Client.Send")
+			serverSpan := findSpan(got, "Server.Recv")
+			if clientSpan == nil || serverSpan == nil {
+				t.Fatalf("got trace without client and server spans\n%s", got)
+			}
+			if len(serverSpan.Sub) != 1 {
+				t.Fatalf("got server span without a child\n%s", got)
+			}
+			backendSpan := serverSpan.Sub[0]
+
+			// The skew is estimated to within the network latency.
+			c, s := clientSpan.Annotations.StringMap(), serverSpan.Annotations.StringMap()
+			clientSend, clientRecv := parseTime(t, c["Client.Send"]), parseTime(t, c["Client.Recv"])
+			serverRecv, serverSend := parseTime(t, s["Server.Recv"]), parseTime(t, s["Server.Send"])
+			if serverRecv.Before(clientSend) || serverSend.After(clientRecv) {
+				t.Errorf("got server times %s-%s outside of client times %s-%s", serverRecv, serverSend, clientSend, clientRecv)
+			}
+			latency := clientRecv.Sub(clientSend)
+			for _, span := range []*appdash.Trace{serverSpan, backendSpan} {
+				skew, err := time.ParseDuration(span.Annotations.StringMap()[appdash.ClockSkewKey])
+				if err != nil {
+					t.Fatalf("span %v: %s", span.Span.ID, err)
+				}
+				if skew < -time.Second-latency || skew > -time.Second+latency {
+					t.Errorf("span %v: got adjustment %s, want -1s±%s", span.Span.ID, skew, latency)
+				}
+			}
+			for _, span := range []*appdash.Trace{got, clientSpan} {
+				if _, ok := span.Annotations.StringMap()[appdash.ClockSkewKey]; ok {
+					t.Errorf("span %v: got the frontend's times adjusted", span.Span.ID)
+				}
+			}
+
+			// The trace is not modified, and consistent times are left as
+			// they are.
+			if _, ok := findSpan(tr, "Server.Recv").Annotations.StringMap()[appdash.ClockSkewKey]; ok {
+				t.Errorf("CorrectClockSkew modified the trace")
+			}
+			if again := got.CorrectClockSkew(); !reflect.DeepEqual(again, got) {
+				t.Errorf("got corrected trace\n%s\nwant it unchanged", again)
+			}
+		})
+	}
+}
+
+func TestTrace_CorrectClockSkew_sharedSpan(t *testing.T) {
+	base := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	ms := func(n int) []byte {
+		return []byte(base.Add(time.Duration(n) * time.Millisecond).Format(time.RFC3339Nano))
+	}
+
+	// Zipkin records both sides of a request in the same span.
+	tr := &appdash.Trace{Span: appdash.Span{ID: appdash.SpanID{Trace: 1, Span: 1}, Annotations: appdash.Annotations{
+		{Key: "Client.Send", Value: ms(10)}, {Key: "Client.Recv", Value: ms(90)},
+		{Key: "Server.Recv", Value: ms(1020)}, {Key: "Server.Send", Value: ms(1080)},
+	}}}
+	got := tr.CorrectClockSkew().Annotations.StringMap()
+	want := map[string]string{
+		"Client.Send": string(ms(10)), "Client.Recv": string(ms(90)),
+		"Server.Recv": string(ms(20)), "Server.Send": string(ms(80)),
+		appdash.ClockSkewKey: "-1s",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// Servers that took longer than their clients, which skew cannot
+	// explain, are left as they are.
+	slow := &appdash.Trace{Span: appdash.Span{ID: appdash.SpanID{Trace: 1, Span: 1}, Annotations: appdash.Annotations{
+		{Key: "Client.Send", Value: ms(10)}, {Key: "Client.Recv", Value: ms(20)},
+		{Key: "Server.Recv", Value: ms(1000)}, {Key: "Server.Send", Value: ms(1050)},
+	}}}
+	if got := slow.CorrectClockSkew(); !reflect.DeepEqual(got, slow) {
+		t.Errorf("got corrected trace\n%s\nwant it unchanged", got)
+	}
+}
+
+// findSpan returns the first span in t with an annotation with the given key.
+func findSpan(t *appdash.Trace, key string) *appdash.Trace {
+	if _, ok := t.Annotations.StringMap()[key]; ok {
+		return t
+	}
+	for _, sub := range t.Sub {
+		if s := findSpan(sub, key); s != nil {
+			return s
+		}
+	}
+	return nil
+}
+
+func parseTime(t *testing.T, s string) time.Time {
+	tm, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tm
+}
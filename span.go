@@ -177,8 +177,12 @@ type Annotation struct {
 }
 
 // Important determines if this annotation's key is considered important to any
-// of the registered event types.
+// of the registered event types. The attributes of resources and clock skew
+// adjustments are always important.
 func (a Annotation) Important() bool {
+	if strings.HasPrefix(a.Key, ResourcePrefix) || a.Key == ClockSkewKey {
+		return true
+	}
 	for _, ev := range registeredEvents {
 		i, ok := ev.(ImportantEvent)
 		if !ok {
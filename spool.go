@@ -0,0 +1,477 @@
+package appdash
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"sourcegraph.com/sourcegraph/appdash/internal/wire"
+)
+
+const (
+	spoolSegmentExt = ".spool" // extension of the segment files in a Spool's directory
+
+	// spoolHeaderSize is the size of a segment record's header: a four byte
+	// payload length and a four byte CRC-32 checksum of the payload.
+	spoolHeaderSize = 4 + 4
+)
+
+// errSpoolCorrupt is returned when reading a segment record that is
+// incomplete or whose checksum does not match.
+var errSpoolCorrupt = errors.New("Spool: corrupt segment record")
+
+// A Spool is a bounded queue of spans on disk. A RemoteCollector with a
+// Spool adds the spans that it fails to send to the collector server to the
+// spool, and sends them from it, in the order they were added, once it can
+// connect to the server again. As the spool persists across restarts, spans
+// recorded while the server (or the process) is down are not lost.
+//
+// The spool is stored in segment files in its directory. Spans are appended
+// to the newest segment, and sent from the oldest, which is deleted once all
+// of its spans are sent. If the spool would exceed MaxBytes, its oldest
+// segments are dropped. Spans are sent at least once: those of a segment
+// that was partially sent before a restart are sent again.
+type Spool struct {
+	// MaxBytes is the maximum total size of the segment files. When the
+	// spool grows larger, its oldest segments are dropped (and their spans
+	// lost) until it is no larger.
+	//
+	// Default MaxBytes (set by OpenSpool) = 64 * 1024 * 1024 (64 MB).
+	MaxBytes int64
+
+	// SegmentBytes is the size at which a segment is complete, and spans
+	// are appended to a new segment. Spans are dropped a segment at a time.
+	//
+	// Default SegmentBytes (set by OpenSpool) = 4 * 1024 * 1024 (4 MB).
+	SegmentBytes int64
+
+	// Log is the logger to use for errors and warnings.
+	Log *log.Logger
+
+	dir      string
+	segments []*spoolSegment       // oldest first
+	w        *os.File              // the newest segment, open for appending, or nil
+	head     []*wire.CollectPacket // unsent packets of the oldest segment, once read
+
+	spooled, replayed, dropped uint64
+
+	mu sync.Mutex // protects all of the above, except the exported fields.
+
+	// collectMu serializes calls to collect, so that concurrent callers
+	// don't send the same spooled spans.
+	collectMu sync.Mutex
+}
+
+// spoolSegment is a segment file of a Spool.
+type spoolSegment struct {
+	seq     uint64 // sequence number, which names the file
+	bytes   int64  // size of the file
+	packets int    // number of unsent packets in the file
+}
+
+func (s *spoolSegment) name() string {
+	return fmt.Sprintf("%020d%s", s.seq, spoolSegmentExt)
+}
+
+// SpoolStats are the metrics of a Spool.
+type SpoolStats struct {
+	Segments int   // number of segment files
+	Bytes    int64 // total size of the segment files
+	Pending  int   // number of spans in the spool, waiting to be sent
+
+	Spooled  uint64 // number of spans added to the spool
+	Replayed uint64 // number of spans sent from the spool
+	Dropped  uint64 // number of spans dropped because the spool was full
+}
+
+// OpenSpool opens (or creates) the Spool stored in the given directory,
+// including the spans that it held when it was last closed.
+func OpenSpool(dir string) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	s := &Spool{
+		MaxBytes:     64 * 1024 * 1024, // 64 MB
+		SegmentBytes: 4 * 1024 * 1024,  // 4 MB
+		Log:          log.New(os.Stderr, "appdash: Spool: ", log.LstdFlags),
+		dir:          dir,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// open reads the segments in the spool's directory. It must be called
+// before s is shared.
+func (s *Spool) open() error {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, fi := range files {
+		name := fi.Name()
+		if !strings.HasSuffix(name, spoolSegmentExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, spoolSegmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		s.segments = append(s.segments, &spoolSegment{seq: seq})
+	}
+	sort.Sort(spoolSegmentsBySeq(s.segments))
+
+	for _, seg := range s.segments {
+		packets, n, err := s.readSegment(seg)
+		if err != nil {
+			return err
+		}
+		seg.bytes, seg.packets = n, len(packets)
+	}
+	return nil
+}
+
+// readSegment reads the packets of a segment file, returning them and the
+// size of the records that were read. An incomplete or corrupt record at the
+// end of the file (e.g. as the result of a crash while it was being written)
+// is discarded.
+func (s *Spool) readSegment(seg *spoolSegment) ([]*wire.CollectPacket, int64, error) {
+	f, err := os.OpenFile(filepath.Join(s.dir, seg.name()), os.O_RDWR, 0600)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer f.Close()
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		packets []*wire.CollectPacket
+		off     int64
+	)
+	for off < int64(len(data)) {
+		p, n, err := readSpoolRecord(data[off:])
+		if err == errSpoolCorrupt {
+			s.Log.Printf("discarding %d bytes of incomplete data at offset %d of %s", int64(len(data))-off, off, seg.name())
+			if err := f.Truncate(off); err != nil {
+				return nil, 0, err
+			}
+			break
+		} else if err != nil {
+			return nil, 0, err
+		}
+		packets = append(packets, p)
+		off += n
+	}
+	return packets, off, nil
+}
+
+// readSpoolRecord reads the segment record at the start of data, returning
+// its packet and total size in bytes.
+func readSpoolRecord(data []byte) (*wire.CollectPacket, int64, error) {
+	if len(data) < spoolHeaderSize {
+		return nil, 0, errSpoolCorrupt
+	}
+	size := binary.BigEndian.Uint32(data[0:4])
+	sum := binary.BigEndian.Uint32(data[4:8])
+	if size > maxMessageSize || uint64(len(data)-spoolHeaderSize) < uint64(size) {
+		return nil, 0, errSpoolCorrupt
+	}
+	payload := data[spoolHeaderSize : spoolHeaderSize+size]
+	if crc32.ChecksumIEEE(payload) != sum {
+		return nil, 0, errSpoolCorrupt
+	}
+	p := &wire.CollectPacket{}
+	if err := proto.Unmarshal(payload, p); err != nil {
+		return nil, 0, err
+	}
+	return p, spoolHeaderSize + int64(size), nil
+}
+
+// append adds the packets to the end of the spool, and then drops the
+// oldest segments while it is larger than MaxBytes.
+func (s *Spool) append(packets []*wire.CollectPacket) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(packets) == 0 {
+		return nil
+	}
+
+	for _, p := range packets {
+		payload, err := proto.Marshal(p)
+		if err != nil {
+			return err
+		}
+		buf := make([]byte, spoolHeaderSize+len(payload))
+		binary.BigEndian.PutUint32(buf[0:4], uint32(len(payload)))
+		binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(payload))
+		copy(buf[spoolHeaderSize:], payload)
+
+		seg, err := s.writableSegmentNoLock()
+		if err != nil {
+			return err
+		}
+		// Write the whole record at once, so that a crash leaves at most
+		// one incomplete record at the end of the segment.
+		if _, err := s.w.Write(buf); err != nil {
+			return err
+		}
+		seg.bytes += int64(len(buf))
+		seg.packets++
+		s.spooled++
+	}
+	if err := s.w.Sync(); err != nil {
+		return err
+	}
+
+	for s.bytesNoLock() > s.MaxBytes && len(s.segments) > 1 {
+		if err := s.dropNoLock(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writableSegmentNoLock returns the newest segment, opened for appending as
+// s.w, or a new one if it is complete or being sent from. It must be called
+// with s.mu held.
+func (s *Spool) writableSegmentNoLock() (*spoolSegment, error) {
+	if s.w != nil {
+		seg := s.segments[len(s.segments)-1]
+		if seg.bytes < s.SegmentBytes {
+			return seg, nil
+		}
+		if err := s.closeWriterNoLock(); err != nil {
+			return nil, err
+		}
+	}
+
+	seg := &spoolSegment{seq: 1}
+	if len(s.segments) > 0 {
+		seg.seq = s.segments[len(s.segments)-1].seq + 1
+	}
+	f, err := os.OpenFile(filepath.Join(s.dir, seg.name()), os.O_WRONLY|os.O_CREATE|os.O_EXCL|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	s.w = f
+	s.segments = append(s.segments, seg)
+	return seg, nil
+}
+
+func (s *Spool) closeWriterNoLock() error {
+	if s.w == nil {
+		return nil
+	}
+	err := s.w.Close()
+	s.w = nil
+	return err
+}
+
+// dropNoLock drops the oldest segment. It must be called with s.mu held.
+func (s *Spool) dropNoLock() error {
+	seg := s.segments[0]
+	if seg == s.segments[len(s.segments)-1] {
+		if err := s.closeWriterNoLock(); err != nil {
+			return err
+		}
+	}
+	if err := os.Remove(filepath.Join(s.dir, seg.name())); err != nil {
+		return err
+	}
+	s.segments = s.segments[1:]
+	s.head = nil
+	s.dropped += uint64(seg.packets)
+	s.Log.Printf("spool is full, dropped %d spans (%d bytes)", seg.packets, seg.bytes)
+	return nil
+}
+
+// peek returns the oldest packets of the spool, which are those of its
+// oldest segment that have not been removed, or nil if the spool is empty.
+func (s *Spool) peek() ([]*wire.CollectPacket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.segments) == 0 {
+		return nil, nil
+	}
+	if s.head == nil {
+		seg := s.segments[0]
+		if seg == s.segments[len(s.segments)-1] {
+			// Append to a new segment from now on.
+			if err := s.closeWriterNoLock(); err != nil {
+				return nil, err
+			}
+		}
+		packets, _, err := s.readSegment(seg)
+		if err != nil {
+			return nil, err
+		}
+		s.head = packets[len(packets)-seg.packets:]
+	}
+	return s.head, nil
+}
+
+// remove removes the first n packets returned by peek, which have been
+// sent.
+func (s *Spool) remove(n int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if n > len(s.head) {
+		return fmt.Errorf("Spool: removing %d spans, only %d were peeked", n, len(s.head))
+	}
+	seg := s.segments[0]
+	s.head = s.head[n:]
+	seg.packets -= n
+	s.replayed += uint64(n)
+	if seg.packets > 0 {
+		return nil
+	}
+	if err := os.Remove(filepath.Join(s.dir, seg.name())); err != nil {
+		return err
+	}
+	s.segments = s.segments[1:]
+	s.head = nil
+	return nil
+}
+
+func (s *Spool) pendingNoLock() int {
+	var n int
+	for _, seg := range s.segments {
+		n += seg.packets
+	}
+	return n
+}
+
+func (s *Spool) bytesNoLock() int64 {
+	var n int64
+	for _, seg := range s.segments {
+		n += seg.bytes
+	}
+	return n
+}
+
+// collect sends the spans in the spool, oldest first, and then the batches,
+// with send, which returns an *ackError if the server failed to collect a
+// batch. Spans are removed from the spool once they are sent, or dropped if
+// the server failed to collect them, as it would fail to again. The batches
+// that can't be sent are added to the spool, and collect returns nil unless
+// the server failed to collect them (or the spool can't be written). If send
+// returns an *undeliveredError, only its packets of the batch are added.
+func (s *Spool) collect(batches [][]*wire.CollectPacket, send func([]*wire.CollectPacket) error) error {
+	s.collectMu.Lock()
+	defer s.collectMu.Unlock()
+
+	sendErr, err := s.replay(send)
+	if err != nil {
+		return err
+	}
+	if sendErr != nil {
+		return s.append(flattenBatches(batches))
+	}
+	for i, b := range batches {
+		err := send(b)
+		if err == nil {
+			continue
+		}
+		if _, ok := err.(*ackError); ok {
+			return err
+		}
+		if e, ok := err.(*undeliveredError); ok {
+			return s.append(append(e.packets, flattenBatches(batches[i+1:])...))
+		}
+		return s.append(flattenBatches(batches[i:]))
+	}
+	return nil
+}
+
+// replay sends the spans in the spool with send (see collect). It returns
+// the error of send if the spans can't be sent, and any error of the spool
+// itself as err.
+func (s *Spool) replay(send func([]*wire.CollectPacket) error) (sendErr, err error) {
+	for {
+		packets, err := s.peek()
+		if err != nil {
+			return nil, err
+		}
+		if len(packets) == 0 {
+			return nil, nil
+		}
+		batches, err := splitBatch(packets)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range batches {
+			if err := send(b); err != nil {
+				if e, ok := err.(*undeliveredError); ok {
+					// Spool the undelivered packets again, at the end, as
+					// the others must not be sent again.
+					if err := s.append(e.packets); err != nil {
+						return nil, err
+					}
+					if err := s.remove(len(b)); err != nil {
+						return nil, err
+					}
+					return e, nil
+				}
+				if _, ok := err.(*ackError); !ok {
+					return err, nil
+				}
+				s.Log.Printf("dropping %d spans that the server failed to collect (%s)", len(b), err)
+			}
+			if err := s.remove(len(b)); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func flattenBatches(batches [][]*wire.CollectPacket) []*wire.CollectPacket {
+	var packets []*wire.CollectPacket
+	for _, b := range batches {
+		packets = append(packets, b...)
+	}
+	return packets
+}
+
+// Stats returns the spool's metrics.
+func (s *Spool) Stats() SpoolStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return SpoolStats{
+		Segments: len(s.segments),
+		Bytes:    s.bytesNoLock(),
+		Pending:  s.pendingNoLock(),
+		Spooled:  s.spooled,
+		Replayed: s.replayed,
+		Dropped:  s.dropped,
+	}
+}
+
+// Close closes the spool. The spans in it are kept on disk, to be sent
+// once it is opened again.
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closeWriterNoLock()
+}
+
+type spoolSegmentsBySeq []*spoolSegment
+
+func (v spoolSegmentsBySeq) Len() int           { return len(v) }
+func (v spoolSegmentsBySeq) Less(i, j int) bool { return v[i].seq < v[j].seq }
+func (v spoolSegmentsBySeq) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+
+var _ io.Closer = (*Spool)(nil)
@@ -0,0 +1,274 @@
+package appdash
+
+import (
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/appdash/internal/wire"
+)
+
+func newTestSpool(t *testing.T) (*Spool, string) {
+	dir, err := ioutil.TempDir("", "appdash-spool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := OpenSpool(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Log = log.New(ioutil.Discard, "", 0)
+	return s, dir
+}
+
+func testPackets(ids ...ID) []*wire.CollectPacket {
+	var packets []*wire.CollectPacket
+	for _, id := range ids {
+		packets = append(packets, newCollectPacket(SpanID{id, id, 0}, Annotations{{"k", []byte("v")}}))
+	}
+	return packets
+}
+
+func TestSpool(t *testing.T) {
+	s, dir := newTestSpool(t)
+	defer os.RemoveAll(dir)
+	s.SegmentBytes = 1 // a segment per span
+
+	if err := s.append(testPackets(1, 2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.append(testPackets(3)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the spool, with a torn record at the end of its last segment.
+	f, err := os.OpenFile(filepath.Join(dir, (&spoolSegment{seq: 3}).name()), os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Write([]byte{0, 0, 0, 9, 1})
+	f.Close()
+	s, err = OpenSpool(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Log = log.New(ioutil.Discard, "", 0)
+	if st := s.Stats(); st.Segments != 3 || st.Pending != 3 {
+		t.Errorf("got %+v, want 3 segments with 3 spans", st)
+	}
+
+	// The spans are peeked in order.
+	var got []*wire.CollectPacket
+	for {
+		packets, err := s.peek()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(packets) == 0 {
+			break
+		}
+		got = append(got, packets[0])
+		if err := s.remove(1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := testPackets(1, 2, 3); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if st := s.Stats(); st.Segments != 0 || st.Pending != 0 || st.Replayed != 3 {
+		t.Errorf("got %+v, want an empty spool that replayed 3 spans", st)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+		t.Errorf("got %d files, want the segments to be deleted", len(files))
+	}
+}
+
+func TestSpool_drop(t *testing.T) {
+	s, dir := newTestSpool(t)
+	defer os.RemoveAll(dir)
+	s.SegmentBytes = 1
+	s.MaxBytes = 100
+
+	for i := ID(1); i <= 10; i++ {
+		if err := s.append(testPackets(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st := s.Stats()
+	if st.Bytes > s.MaxBytes || st.Spooled != 10 || st.Dropped == 0 || st.Dropped+uint64(st.Pending) != 10 {
+		t.Fatalf("got %+v, want at most %d bytes, with the rest of 10 spans dropped", st, s.MaxBytes)
+	}
+
+	// The oldest spans were dropped.
+	packets, err := s.peek()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := testPackets(ID(st.Dropped + 1)); !reflect.DeepEqual(packets, want) {
+		t.Errorf("got %v, want %v", packets, want)
+	}
+}
+
+func TestRemoteCollector_spool(t *testing.T) {
+	s, dir := newTestSpool(t)
+	defer os.RemoveAll(dir)
+
+	// Find an address that nothing listens on, yet.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	rc := NewRemoteCollector(addr)
+	rc.Log = log.New(ioutil.Discard, "", 0)
+	rc.Spool = s
+	defer rc.Close()
+	for _, id := range []ID{1, 2} {
+		if err := rc.Collect(SpanID{id, id, 0}, Annotation{"k", []byte("v")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if st := s.Stats(); st.Pending != 2 {
+		t.Fatalf("got %+v, want 2 spooled spans", st)
+	}
+
+	// Once the server is back, the spooled spans are sent before new ones.
+	l, err = net.Listen("tcp", addr)
+	if err != nil {
+		t.Skip(err) // the address was taken in the meantime
+	}
+	rec := &packetRecorder{}
+	cs := NewServer(l, rec)
+	defer cs.Close()
+	go cs.Start()
+	if err := rc.Collect(SpanID{3, 3, 0}, Annotation{"k", []byte("v")}); err != nil {
+		t.Fatal(err)
+	}
+	rec.mu.Lock()
+	got := rec.packets
+	rec.mu.Unlock()
+	if want := testPackets(1, 2, 3); !reflect.DeepEqual(got, want) {
+		t.Errorf("server collected %v, want %v", got, want)
+	}
+	if st := s.Stats(); st.Pending != 0 || st.Replayed != 2 {
+		t.Errorf("got %+v, want 2 replayed spans", st)
+	}
+}
+
+func TestMultiRemoteCollector_spoolConcurrent(t *testing.T) {
+	s, dir := newTestSpool(t)
+	defer os.RemoveAll(dir)
+	s.SegmentBytes = 1024
+	const n = 2000
+	var ids []ID
+	for i := ID(1); i <= n; i++ {
+		ids = append(ids, i)
+	}
+	if err := s.append(testPackets(ids...)); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := &packetRecorder{}
+	cs := startCollectorServer(t, rec)
+	defer cs.Close()
+	m := NewMultiRemoteCollector([]string{cs.l.Addr().String()})
+	m.Spool = s
+	defer m.Close()
+
+	// Concurrent callers (such as ChunkedCollector's background flushes)
+	// send each spooled span once.
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- m.CollectBatch(nil)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := rec.sorted(); len(got) != n {
+		t.Errorf("server collected %d spans, want %d", len(got), n)
+	}
+	if st := s.Stats(); st.Pending != 0 || st.Replayed != n {
+		t.Errorf("got %+v, want %d replayed spans", st, n)
+	}
+}
+
+func TestMultiRemoteCollector_spoolUndeliveredShard(t *testing.T) {
+	s, dir := newTestSpool(t)
+	defer os.RemoveAll(dir)
+
+	rec := &packetRecorder{}
+	cs := startCollectorServer(t, rec)
+	defer cs.Close()
+
+	// The second server fails, and takes the first down with it, once the
+	// first has collected the spans of its shard.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		cs.Close()
+		conn.Close()
+	}()
+
+	m := NewMultiRemoteCollector([]string{cs.l.Addr().String(), l.Addr().String()})
+	m.ShardByTrace = true
+	m.Spool = s
+	m.Log = log.New(ioutil.Discard, "", 0)
+	for _, rc := range m.Collectors {
+		rc.ProtocolVersion = 2
+		rc.Log = m.Log
+	}
+	defer m.Close()
+
+	// Find a trace of each server's shard.
+	traces := make([]ID, 2)
+	m.mu.Lock()
+	m.init()
+	for trace := ID(1); traces[0] == 0 || traces[1] == 0; trace++ {
+		traces[m.ring[m.shardNoLock(trace)].server] = trace
+	}
+	m.mu.Unlock()
+
+	spans := []Span{{ID: SpanID{traces[0], 1, 0}}, {ID: SpanID{traces[1], 1, 0}}}
+	if err := m.CollectBatch(spans); err != nil {
+		t.Fatal(err)
+	}
+	want := []*wire.CollectPacket{newCollectPacket(spans[0].ID, nil)}
+	if got := rec.sorted(); !reflect.DeepEqual(got, want) {
+		t.Errorf("server collected %v, want %v", got, want)
+	}
+
+	// Only the span that wasn't collected is spooled.
+	got, err := s.peek()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []*wire.CollectPacket{newCollectPacket(spans[1].ID, nil)}; !reflect.DeepEqual(got, want) {
+		t.Errorf("spooled %v, want %v", got, want)
+	}
+}
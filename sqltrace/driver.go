@@ -0,0 +1,562 @@
+package sqltrace
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// DriverConfig configures a traced database/sql driver.
+type DriverConfig struct {
+	// Tag, if non-empty, is set as the Tag of every SQLEvent recorded by the
+	// driver (e.g. the name of the database).
+	Tag string
+
+	// FormatArg, if non-nil, is called to format each query argument for
+	// recording. By default only the type of each argument is recorded, so
+	// that sensitive values are never sent to the collector.
+	FormatArg func(driver.NamedValue) string
+
+	// RootSpans, if true, causes operations whose context carries no span
+	// to be recorded as new traces (the rows of a query are recorded in the
+	// query's trace). By default they are not recorded.
+	RootSpans bool
+}
+
+// Register registers a traced wrapper of drv (see Wrap) with the
+// database/sql package under the given driver name.
+func Register(name string, drv driver.Driver, c appdash.Collector, conf *DriverConfig) {
+	sql.Register(name, Wrap(drv, c, conf))
+}
+
+// Wrap returns a driver that traces the operations performed through drv,
+// recording each as a span with an SQLEvent whose Op is one of "Exec",
+// "Query", "Prepare", "Begin", "Commit", "Rollback" or "Rows" (the
+// iteration over the rows returned by a query, until they are closed).
+//
+// Spans are recorded as children of the span carried by the context of
+// each operation (see appdash.NewContextWithSpanID), so the context-aware
+// methods of database/sql (e.g. QueryContext) must be used for operations
+// to be associated with a trace. Transactions and prepared statements
+// record their operations using the context they were created with.
+//
+// Spans are recorded to the collector c or, if c is nil, to that of the
+// Recorder carried by the context (see appdash.NewContextWithRecorder).
+// If conf is nil, the default configuration is used.
+func Wrap(drv driver.Driver, c appdash.Collector, conf *DriverConfig) driver.Driver {
+	if conf == nil {
+		conf = &DriverConfig{}
+	}
+	return &tracedDriver{Driver: drv, t: &tracer{c: c, conf: *conf}}
+}
+
+// tracer records the operations of a traced driver.
+type tracer struct {
+	c    appdash.Collector
+	conf DriverConfig
+}
+
+// opSpan is an operation being recorded.
+type opSpan struct {
+	rec *appdash.Recorder
+	e   SQLEvent
+}
+
+// start starts recording an operation, returning nil if the operation is
+// not to be recorded.
+func (t *tracer) start(ctx context.Context, op, query string, args []driver.NamedValue) *opSpan {
+	if _, ok := appdash.SpanIDFromContext(ctx); !ok && !t.conf.RootSpans {
+		return nil
+	}
+	rec := appdash.NewChildRecorderFromContext(ctx, t.c)
+	if rec == nil {
+		return nil
+	}
+	s := &opSpan{
+		rec: rec,
+		e: SQLEvent{
+			SQL:        query,
+			Tag:        t.conf.Tag,
+			Op:         op,
+			ClientSend: time.Now(),
+		},
+	}
+	if len(args) > 0 {
+		s.e.Args = make([]string, len(args))
+		for i, arg := range args {
+			if t.conf.FormatArg != nil {
+				s.e.Args[i] = t.conf.FormatArg(arg)
+			} else {
+				s.e.Args[i] = redactArg(arg)
+			}
+		}
+	}
+	return s
+}
+
+// finish records the operation, which returned err. An operation that
+// returned driver.ErrSkip is not recorded, as database/sql retries it in
+// another way. It is safe to call finish on a nil *opSpan.
+func (s *opSpan) finish(err error) {
+	if s == nil || err == driver.ErrSkip {
+		return
+	}
+	s.e.ClientRecv = time.Now()
+	if err != nil {
+		s.e.Error = err.Error()
+		s.rec.Fail(err)
+	}
+	s.rec.Name("SQL " + s.e.Op)
+	s.rec.Event(s.e)
+	s.rec.Finish()
+}
+
+// redactArg formats a query argument without revealing its value.
+func redactArg(arg driver.NamedValue) string {
+	typ := "nil"
+	if arg.Value != nil {
+		typ = fmt.Sprintf("%T", arg.Value)
+	}
+	if arg.Name != "" {
+		return fmt.Sprintf("%s: %s", arg.Name, typ)
+	}
+	return typ
+}
+
+type tracedDriver struct {
+	driver.Driver
+	t *tracer
+}
+
+// Open implements the driver.Driver interface.
+func (d *tracedDriver) Open(name string) (driver.Conn, error) {
+	c, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedConn{Conn: c, t: d.t}, nil
+}
+
+type tracedConn struct {
+	driver.Conn
+	t *tracer
+}
+
+// Compile-time "implements" check.
+var _ interface {
+	driver.Conn
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.Pinger
+	driver.SessionResetter
+	driver.Validator
+	driver.NamedValueChecker
+} = (*tracedConn)(nil)
+
+// Prepare implements the driver.Conn interface.
+func (c *tracedConn) Prepare(query string) (driver.Stmt, error) {
+	return c.PrepareContext(context.Background(), query)
+}
+
+// PrepareContext implements the driver.ConnPrepareContext interface.
+func (c *tracedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	span := c.t.start(ctx, "Prepare", query, nil)
+	var (
+		stmt driver.Stmt
+		err  error
+	)
+	if cpc, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		stmt, err = cpc.PrepareContext(ctx, query)
+	} else {
+		stmt, err = c.Conn.Prepare(query)
+	}
+	span.finish(err)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedStmt{Stmt: stmt, conn: c, ctx: ctx, query: query}, nil
+}
+
+// Begin implements the driver.Conn interface.
+func (c *tracedConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+// BeginTx implements the driver.ConnBeginTx interface.
+func (c *tracedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	span := c.t.start(ctx, "Begin", "", nil)
+	var (
+		tx  driver.Tx
+		err error
+	)
+	if cbt, ok := c.Conn.(driver.ConnBeginTx); ok {
+		tx, err = cbt.BeginTx(ctx, opts)
+	} else if opts.Isolation != driver.IsolationLevel(sql.LevelDefault) {
+		err = errors.New("sql: driver does not support non-default isolation level")
+	} else if opts.ReadOnly {
+		err = errors.New("sql: driver does not support read-only transactions")
+	} else {
+		tx, err = c.Conn.Begin()
+	}
+	span.finish(err)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedTx{Tx: tx, t: c.t, ctx: ctx}, nil
+}
+
+// ExecContext implements the driver.ExecerContext interface.
+func (c *tracedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	var exec func() (driver.Result, error)
+	if ec, ok := c.Conn.(driver.ExecerContext); ok {
+		exec = func() (driver.Result, error) { return ec.ExecContext(ctx, query, args) }
+	} else if e, ok := c.Conn.(driver.Execer); ok {
+		exec = func() (driver.Result, error) {
+			values, err := namedValuesToValues(args)
+			if err != nil {
+				return nil, err
+			}
+			return e.Exec(query, values)
+		}
+	} else {
+		return nil, driver.ErrSkip
+	}
+	return c.t.exec(ctx, query, args, exec)
+}
+
+// QueryContext implements the driver.QueryerContext interface.
+func (c *tracedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	var q func() (driver.Rows, error)
+	if qc, ok := c.Conn.(driver.QueryerContext); ok {
+		q = func() (driver.Rows, error) { return qc.QueryContext(ctx, query, args) }
+	} else if qr, ok := c.Conn.(driver.Queryer); ok {
+		q = func() (driver.Rows, error) {
+			values, err := namedValuesToValues(args)
+			if err != nil {
+				return nil, err
+			}
+			return qr.Query(query, values)
+		}
+	} else {
+		return nil, driver.ErrSkip
+	}
+	return c.t.query(ctx, query, args, q)
+}
+
+// Ping implements the driver.Pinger interface.
+func (c *tracedConn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+// ResetSession implements the driver.SessionResetter interface.
+func (c *tracedConn) ResetSession(ctx context.Context) error {
+	if sr, ok := c.Conn.(driver.SessionResetter); ok {
+		return sr.ResetSession(ctx)
+	}
+	return nil
+}
+
+// IsValid implements the driver.Validator interface.
+func (c *tracedConn) IsValid() bool {
+	if v, ok := c.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
+
+// CheckNamedValue implements the driver.NamedValueChecker interface.
+func (c *tracedConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if nvc, ok := c.Conn.(driver.NamedValueChecker); ok {
+		return nvc.CheckNamedValue(nv)
+	}
+	return driver.ErrSkip
+}
+
+// exec records an "Exec" operation performed by calling exec.
+func (t *tracer) exec(ctx context.Context, query string, args []driver.NamedValue, exec func() (driver.Result, error)) (driver.Result, error) {
+	span := t.start(ctx, "Exec", query, args)
+	res, err := exec()
+	if span != nil && err == nil {
+		if n, err := res.RowsAffected(); err == nil {
+			span.e.RowsAffected = n
+		}
+	}
+	span.finish(err)
+	return res, err
+}
+
+// query records a "Query" operation performed by calling query, and wraps
+// the returned rows to record their iteration. If the query is recorded as
+// a new trace, so are the rows, as a child of the query's span.
+func (t *tracer) query(ctx context.Context, query string, args []driver.NamedValue, q func() (driver.Rows, error)) (driver.Rows, error) {
+	span := t.start(ctx, "Query", query, args)
+	rows, err := q()
+	span.finish(err)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := appdash.SpanIDFromContext(ctx); !ok && span != nil {
+		ctx = appdash.NewContextWithSpanID(ctx, span.rec.SpanID)
+	}
+	return &tracedRows{Rows: rows, span: t.start(ctx, "Rows", query, nil)}, nil
+}
+
+// namedValuesToValues converts arguments for drivers that do not support
+// the context-aware interfaces, as database/sql does.
+func namedValuesToValues(named []driver.NamedValue) ([]driver.Value, error) {
+	values := make([]driver.Value, len(named))
+	for i, nv := range named {
+		if nv.Name != "" {
+			return nil, errors.New("sql: driver does not support the use of Named Parameters")
+		}
+		values[i] = nv.Value
+	}
+	return values, nil
+}
+
+type tracedStmt struct {
+	driver.Stmt
+	conn  *tracedConn
+	ctx   context.Context // context the statement was prepared with
+	query string
+}
+
+// Compile-time "implements" check.
+var _ interface {
+	driver.Stmt
+	driver.StmtExecContext
+	driver.StmtQueryContext
+	driver.NamedValueChecker
+} = (*tracedStmt)(nil)
+
+// Exec implements the driver.Stmt interface.
+func (s *tracedStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return s.ExecContext(s.ctx, valuesToNamedValues(args))
+}
+
+// Query implements the driver.Stmt interface.
+func (s *tracedStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return s.QueryContext(s.ctx, valuesToNamedValues(args))
+}
+
+// ExecContext implements the driver.StmtExecContext interface. The
+// operation is recorded as part of the span carried by ctx or, if it
+// carries none, that carried by the context the statement was prepared
+// with.
+func (s *tracedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	return s.conn.t.exec(s.spanContext(ctx), s.query, args, func() (driver.Result, error) {
+		if sec, ok := s.Stmt.(driver.StmtExecContext); ok {
+			return sec.ExecContext(ctx, args)
+		}
+		values, err := namedValuesToValues(args)
+		if err != nil {
+			return nil, err
+		}
+		return s.Stmt.Exec(values)
+	})
+}
+
+// QueryContext implements the driver.StmtQueryContext interface. The
+// operation is recorded in the same way as by ExecContext.
+func (s *tracedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	return s.conn.t.query(s.spanContext(ctx), s.query, args, func() (driver.Rows, error) {
+		if sqc, ok := s.Stmt.(driver.StmtQueryContext); ok {
+			return sqc.QueryContext(ctx, args)
+		}
+		values, err := namedValuesToValues(args)
+		if err != nil {
+			return nil, err
+		}
+		return s.Stmt.Query(values)
+	})
+}
+
+// spanContext returns ctx if it carries a span, and otherwise the context
+// the statement was prepared with.
+func (s *tracedStmt) spanContext(ctx context.Context) context.Context {
+	if _, ok := appdash.SpanIDFromContext(ctx); ok {
+		return ctx
+	}
+	return s.ctx
+}
+
+// CheckNamedValue implements the driver.NamedValueChecker interface. As
+// database/sql only consults the connection's checker if the statement does
+// not have one, it checks the value using the underlying statement or,
+// failing that, the connection.
+func (s *tracedStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	switch stmt := s.Stmt.(type) {
+	case driver.NamedValueChecker:
+		return stmt.CheckNamedValue(nv)
+	case driver.ColumnConverter:
+		if nv.Ordinal < 1 {
+			return driver.ErrSkip
+		}
+		v := nv.Value
+		if vr, ok := v.(driver.Valuer); ok {
+			var err error
+			if v, err = vr.Value(); err != nil {
+				return err
+			}
+		}
+		v, err := stmt.ColumnConverter(nv.Ordinal - 1).ConvertValue(v)
+		if err != nil {
+			return err
+		}
+		if !driver.IsValue(v) {
+			return fmt.Errorf("driver ColumnConverter error converted %T to unsupported type %T", nv.Value, v)
+		}
+		nv.Value = v
+		return nil
+	}
+	return s.conn.CheckNamedValue(nv)
+}
+
+func valuesToNamedValues(values []driver.Value) []driver.NamedValue {
+	named := make([]driver.NamedValue, len(values))
+	for i, v := range values {
+		named[i] = driver.NamedValue{Ordinal: i + 1, Value: v}
+	}
+	return named
+}
+
+type tracedTx struct {
+	driver.Tx
+	t   *tracer
+	ctx context.Context // context the transaction was begun with
+}
+
+// Commit implements the driver.Tx interface.
+func (tx *tracedTx) Commit() error {
+	span := tx.t.start(tx.ctx, "Commit", "", nil)
+	err := tx.Tx.Commit()
+	span.finish(err)
+	return err
+}
+
+// Rollback implements the driver.Tx interface.
+func (tx *tracedTx) Rollback() error {
+	span := tx.t.start(tx.ctx, "Rollback", "", nil)
+	err := tx.Tx.Rollback()
+	span.finish(err)
+	return err
+}
+
+// tracedRows records the iteration over rows as a "Rows" operation, which
+// finishes when the rows are closed.
+type tracedRows struct {
+	driver.Rows
+	span *opSpan
+	err  error // first error returned by Next, other than io.EOF
+}
+
+// Compile-time "implements" check.
+var _ interface {
+	driver.Rows
+	driver.RowsNextResultSet
+	driver.RowsColumnTypeScanType
+	driver.RowsColumnTypeDatabaseTypeName
+	driver.RowsColumnTypeLength
+	driver.RowsColumnTypeNullable
+	driver.RowsColumnTypePrecisionScale
+} = (*tracedRows)(nil)
+
+// Next implements the driver.Rows interface.
+func (r *tracedRows) Next(dest []driver.Value) error {
+	err := r.Rows.Next(dest)
+	if r.span != nil {
+		if err == nil {
+			r.span.e.Rows++
+		} else if err != io.EOF && r.err == nil {
+			r.err = err
+		}
+	}
+	return err
+}
+
+// Close implements the driver.Rows interface.
+func (r *tracedRows) Close() error {
+	err := r.Rows.Close()
+	if r.err == nil {
+		r.err = err
+	}
+	r.span.finish(r.err)
+	r.span = nil
+	return err
+}
+
+// HasNextResultSet implements the driver.RowsNextResultSet interface.
+func (r *tracedRows) HasNextResultSet() bool {
+	if nrs, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return nrs.HasNextResultSet()
+	}
+	return false
+}
+
+// NextResultSet implements the driver.RowsNextResultSet interface.
+func (r *tracedRows) NextResultSet() error {
+	if nrs, ok := r.Rows.(driver.RowsNextResultSet); ok {
+		return nrs.NextResultSet()
+	}
+	return io.EOF
+}
+
+// The ColumnType methods below return the same defaults as database/sql
+// uses when the underlying rows do not implement them.
+
+var scanTypeAny = reflect.TypeOf(new(interface{})).Elem()
+
+// ColumnTypeScanType implements the driver.RowsColumnTypeScanType interface.
+func (r *tracedRows) ColumnTypeScanType(index int) reflect.Type {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeScanType); ok {
+		return ct.ColumnTypeScanType(index)
+	}
+	return scanTypeAny
+}
+
+// ColumnTypeDatabaseTypeName implements the
+// driver.RowsColumnTypeDatabaseTypeName interface.
+func (r *tracedRows) ColumnTypeDatabaseTypeName(index int) string {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeDatabaseTypeName); ok {
+		return ct.ColumnTypeDatabaseTypeName(index)
+	}
+	return ""
+}
+
+// ColumnTypeLength implements the driver.RowsColumnTypeLength interface.
+func (r *tracedRows) ColumnTypeLength(index int) (length int64, ok bool) {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeLength); ok {
+		return ct.ColumnTypeLength(index)
+	}
+	return 0, false
+}
+
+// ColumnTypeNullable implements the driver.RowsColumnTypeNullable
+// interface.
+func (r *tracedRows) ColumnTypeNullable(index int) (nullable, ok bool) {
+	if ct, ok := r.Rows.(driver.RowsColumnTypeNullable); ok {
+		return ct.ColumnTypeNullable(index)
+	}
+	return false, false
+}
+
+// ColumnTypePrecisionScale implements the
+// driver.RowsColumnTypePrecisionScale interface.
+func (r *tracedRows) ColumnTypePrecisionScale(index int) (precision, scale int64, ok bool) {
+	if ct, ok := r.Rows.(driver.RowsColumnTypePrecisionScale); ok {
+		return ct.ColumnTypePrecisionScale(index)
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,240 @@
+package sqltrace
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// registerTestDriver registers the test driver once, as sql.Register panics
+// if a name is registered twice (such as with go test -count=2).
+var registerTestDriver sync.Once
+
+func TestWrap(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	rec := appdash.NewRecorder(appdash.SpanID{1, 2, 0}, appdash.NewLocalCollector(ms))
+	registerTestDriver.Do(func() {
+		Register("sqltrace-test", fakeDriver{}, nil, &DriverConfig{Tag: "test"})
+	})
+	db, err := sql.Open("sqltrace-test", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// Operations whose context carries no span are not recorded.
+	if _, err := db.Exec("UPDATE t SET a = ?", "secret"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := appdash.NewContextWithRecorder(context.Background(), rec)
+	if _, err := db.ExecContext(ctx, "UPDATE t SET a = ?", "secret"); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := db.QueryContext(ctx, "SELECT a FROM t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+	if _, err := db.ExecContext(ctx, "fail"); err == nil {
+		t.Fatal("got nil error, want error")
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	tx, err = db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Prepared statements record their operations as part of the span of
+	// the context they were prepared with, and the values of their
+	// arguments are checked by the connection.
+	stmt, err := db.PrepareContext(ctx, "SELECT a FROM t WHERE a = ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stmt.Exec(fakeValue{"b"}); err != nil {
+		t.Fatal(err)
+	}
+	rows, err = stmt.Query(fakeValue{"c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+	stmt.Close()
+
+	rec.Finish()
+
+	trace, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []SQLEvent
+	for _, sub := range trace.Sub {
+		if sub.Span.ID.Parent != rec.Span {
+			t.Errorf("got span %v, want a child of %v", sub.Span.ID, rec.SpanID)
+		}
+		var e SQLEvent
+		if err := appdash.UnmarshalEvent(sub.Span.Annotations, &e); err != nil {
+			t.Fatal(err)
+		}
+		if e.Tag != "test" || e.ClientSend.IsZero() || e.ClientRecv.IsZero() {
+			t.Errorf("got incomplete event %+v", e)
+		}
+		if failed := sub.Span.Failed(); failed != (e.Error != "") {
+			t.Errorf("got span failed %v for event %+v", failed, e)
+		}
+		got = append(got, SQLEvent{Op: e.Op, SQL: e.SQL, Args: e.Args, RowsAffected: e.RowsAffected, Rows: e.Rows, Error: e.Error})
+	}
+	want := []SQLEvent{
+		{Op: "Exec", SQL: "UPDATE t SET a = ?", Args: []string{"string"}, RowsAffected: 1},
+		{Op: "Query", SQL: "SELECT a FROM t"},
+		{Op: "Rows", SQL: "SELECT a FROM t", Rows: 3},
+		{Op: "Exec", SQL: "fail", Error: "fail"},
+		{Op: "Begin"},
+		{Op: "Commit"},
+		{Op: "Begin"},
+		{Op: "Rollback"},
+		{Op: "Prepare", SQL: "SELECT a FROM t WHERE a = ?"},
+		{Op: "Exec", SQL: "SELECT a FROM t WHERE a = ?", Args: []string{"string"}, RowsAffected: 1},
+		{Op: "Query", SQL: "SELECT a FROM t WHERE a = ?", Args: []string{"string"}},
+		{Op: "Rows", SQL: "SELECT a FROM t WHERE a = ?", Rows: 2},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d events %+v, want %d", len(got), got, len(want))
+	}
+	// The order in which sub-traces are stored is undefined.
+	for _, w := range want {
+		found := false
+		for _, g := range got {
+			if reflect.DeepEqual(g, w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("missing event %+v in %+v", w, got)
+		}
+	}
+}
+
+func TestWrap_rootSpans(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	drv := Wrap(fakeDriver{}, appdash.NewLocalCollector(ms), &DriverConfig{RootSpans: true})
+	db := sql.OpenDB(driverConnector{drv})
+	defer db.Close()
+
+	rows, err := db.Query("SELECT a FROM t")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+	}
+	rows.Close()
+
+	// The rows are recorded in the trace of their query.
+	traces, err := ms.Traces(appdash.TracesOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(traces) != 1 || len(traces[0].Sub) != 1 {
+		t.Fatalf("got traces %v, want one with a query and its rows", traces)
+	}
+	for _, s := range []struct {
+		span *appdash.Trace
+		op   string
+	}{{traces[0], "Query"}, {traces[0].Sub[0], "Rows"}} {
+		var e SQLEvent
+		if err := appdash.UnmarshalEvent(s.span.Span.Annotations, &e); err != nil {
+			t.Fatal(err)
+		}
+		if e.Op != s.op {
+			t.Errorf("got span %v of op %q, want %q", s.span.Span.ID, e.Op, s.op)
+		}
+	}
+}
+
+// driverConnector is a driver.Connector of a driver, to open it without
+// registering it.
+type driverConnector struct{ d driver.Driver }
+
+func (c driverConnector) Connect(context.Context) (driver.Conn, error) { return c.d.Open("") }
+func (c driverConnector) Driver() driver.Driver                        { return c.d }
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return fakeTx{}, nil }
+
+func (fakeConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if query == "fail" {
+		return nil, errors.New("fail")
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &fakeRows{n: 3}, nil
+}
+
+// CheckNamedValue converts fakeValues, which database/sql would reject.
+func (fakeConn) CheckNamedValue(nv *driver.NamedValue) error {
+	if v, ok := nv.Value.(fakeValue); ok {
+		nv.Value = v.s
+		return nil
+	}
+	return driver.ErrSkip
+}
+
+type fakeValue struct{ s string }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return driver.RowsAffected(1), nil
+}
+func (fakeStmt) Query(args []driver.Value) (driver.Rows, error) { return &fakeRows{n: 2}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeRows struct{ n int }
+
+func (r *fakeRows) Columns() []string { return []string{"a"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.n == 0 {
+		return io.EOF
+	}
+	r.n--
+	dest[0] = int64(r.n)
+	return nil
+}
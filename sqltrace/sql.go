@@ -1,4 +1,7 @@
 // Package sqltrace implements utility types for tracing SQL queries.
+//
+// SQLEvents can be recorded by hand, or automatically by a database/sql
+// driver wrapped by Wrap (or registered by Register).
 package sqltrace
 
 import (
@@ -14,15 +17,23 @@ type SQLEvent struct {
 	Tag        string
 	ClientSend time.Time
 	ClientRecv time.Time
+
+	// The fields below are set by the driver returned by Wrap.
+
+	Op           string   // operation, e.g. "Query" or "Commit" (see Wrap)
+	Args         []string // query arguments, redacted by default
+	RowsAffected int64    // rows affected by an "Exec" operation
+	Rows         int64    // rows read by a "Rows" operation
+	Error        string   // error returned by the operation, if any
 }
 
 // Schema implements the appdash Event interface by returning this event's
 // constant schema string, "SQL".
 func (SQLEvent) Schema() string { return "SQL" }
 
-// Important implements the appdash ImportantEvent by returning the SQL and Tag
-// keys.
-func (SQLEvent) Important() []string { return []string{"SQL", "Tag"} }
+// Important implements the appdash ImportantEvent by returning the SQL, Tag
+// and Error keys.
+func (SQLEvent) Important() []string { return []string{"SQL", "Tag", "Error"} }
 
 // Start implements the appdash TimespanEvent interface by returning the time
 // at which the SQL query was sent out.
@@ -1,12 +1,17 @@
 package appdash
 
 import (
+	"bytes"
 	"encoding/gob"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 )
@@ -33,6 +38,43 @@ type TracesOpts struct {
 
 	// TraceIDs filters the returned traces to just the ones with the given IDs.
 	TraceIDs []ID
+
+	// Name, if non-empty, filters the returned traces to just the ones whose
+	// root span has the given name.
+	Name string
+
+	// MinDuration and MaxDuration, if non-zero, filter the returned traces to
+	// just the ones whose root span duration is within the given bounds
+	// (inclusive). Traces without a timespan event never match a duration
+	// filter.
+	MinDuration, MaxDuration time.Duration
+
+	// Annotations filters the returned traces to just the ones which contain
+	// at least one span with an annotation matching each given key and value,
+	// e.g. "Server.Response.StatusCode" -> "500".
+	Annotations map[string]string
+
+	// User and Route, if non-empty, filter the returned traces to just the
+	// ones containing a span whose "Server.User" or "Server.Route" annotation
+	// (see the httptrace package) has the given value.
+	User, Route string
+
+	// Service, if non-empty, filters the returned traces to just the ones
+	// containing a span recorded by the named service (see Resource).
+	Service string
+
+	// Errors filters the returned traces by whether or not they contain a
+	// span that failed.
+	Errors ErrorsFilter
+
+	// Order is the order in which traces are returned. The default,
+	// OrderDefault, is implementation-defined.
+	Order TracesOrder
+
+	// Offset is the number of matching traces to skip, and Limit (if non-zero)
+	// is the maximum number of traces to return. Together they may be used to
+	// paginate results.
+	Offset, Limit int
 }
 
 // A Queryer indexes spans and makes them queryable.
@@ -41,14 +83,38 @@ type Queryer interface {
 	Traces(opts TracesOpts) ([]*Trace, error)
 }
 
+// AggregateOpts specifies the spans that an Aggregator aggregates, and how
+// they are grouped.
+type AggregateOpts struct {
+	// Start and End are the time range of the aggregated spans, relative to
+	// now, such that Start = -72 * time.Hour and End = 0 is the past 72
+	// hours.
+	Start, End time.Duration
+
+	// GroupBy is the annotation key whose values group the spans, such as
+	// "Server.Route", "Server.User", the "Tag" of sqltrace events or
+	// ServiceKey. Spans without the annotation (or with an empty value) are
+	// not aggregated. If GroupBy is empty, spans are grouped by name.
+	GroupBy string
+
+	// AllSpans is whether all named spans are aggregated, rather than only
+	// root spans.
+	AllSpans bool
+}
+
 // AggregatedResult represents a set of traces that were aggregated together by
 // root span name to produce some useful metrics (average trace time, minimum
 // time, a link to the slowest traces, etc).
 type AggregatedResult struct {
-	// RootSpanName is the name of the root span of the traces that were
-	// aggregated to form this result.
+	// RootSpanName is the name of the spans that were aggregated to form this
+	// result, if they were grouped by name (see AggregateOpts.GroupBy), and
+	// is empty otherwise.
 	RootSpanName string
 
+	// Group is the value of the annotation that the aggregated spans were
+	// grouped by, which is their name if they were grouped by name.
+	Group string
+
 	// Average, Minimum, Maximum, and standard deviation of the total trace
 	// times (earliest span start time, latest span end time) of all traces
 	// that were aggregated to produce this result, respectively.
@@ -61,6 +127,22 @@ type AggregatedResult struct {
 	// Slowest is the N-slowest trace IDs that were part of this group, such
 	// that these are the most valuable/slowest traces for inspection.
 	Slowest []ID
+
+	// P50, P90, P95 and P99 are estimates of the 50th, 90th, 95th and 99th
+	// percentiles of the total trace times. They are zero if the Aggregator
+	// doesn't estimate percentiles.
+	P50, P90, P95, P99 time.Duration
+
+	// Histogram is the number of traces by total trace time, with buckets
+	// bounded by HistogramBounds. It is nil if the Aggregator doesn't
+	// provide histograms.
+	Histogram []HistogramBucket
+
+	// Sketch summarizes the distribution of the total trace times, so that
+	// it can be combined with those of other results (see
+	// DurationSketch.Merge). It is nil if the Aggregator doesn't provide
+	// sketches.
+	Sketch *DurationSketch
 }
 
 // Aggregator is a type of store that can aggregate its trace data and return
@@ -69,10 +151,10 @@ type Aggregator interface {
 	// Aggregate should return the aggregated data for all traces within the
 	// past 72/hr, such that:
 	//
-	//  Aggregate(-72 * time.Hour, 0)
+	//  Aggregate(AggregateOpts{Start: -72 * time.Hour})
 	//
-	// would return all possible results.
-	Aggregate(start, end time.Duration) ([]*AggregatedResult, error)
+	// would return all possible results for root spans grouped by name.
+	Aggregate(opts AggregateOpts) ([]*AggregatedResult, error)
 }
 
 // NewMemoryStore creates a new in-memory store
@@ -255,20 +337,35 @@ func (ms *MemoryStore) traceNoLock(id ID) (*Trace, error) {
 	return t, nil
 }
 
-// Traces implements the Queryer interface.
+// Traces implements the Queryer interface. Unless another order is specified,
+// traces are returned most recent first.
 func (ms *MemoryStore) Traces(opts TracesOpts) ([]*Trace, error) {
 	ms.Lock()
 	defer ms.Unlock()
 
 	var ts []*Trace
-	for id := range ms.trace {
-		t, err := ms.traceNoLock(id)
-		if err != nil {
-			return nil, err
+	if len(opts.TraceIDs) > 0 {
+		// Look up the requested traces directly, rather than filtering all of
+		// them.
+		seen := make(map[ID]struct{}, len(opts.TraceIDs))
+		for _, id := range opts.TraceIDs {
+			if _, dup := seen[id]; dup {
+				continue
+			}
+			seen[id] = struct{}{}
+			if t, err := ms.traceNoLock(id); err == nil {
+				ts = append(ts, t)
+			}
+		}
+	} else {
+		for _, t := range ms.trace {
+			ts = append(ts, t)
 		}
-		ts = append(ts, t)
 	}
-	return ts, nil
+	if opts.Order == OrderDefault {
+		opts.Order = OrderNewest
+	}
+	return filterTraces(ts, opts)
 }
 
 // Delete implements the DeleteStore interface by deleting the traces given by
@@ -359,24 +456,29 @@ type PersistentStore interface {
 func PersistEvery(s PersistentStore, interval time.Duration, file string) error {
 	for {
 		time.Sleep(interval)
-
-		f, err := ioutil.TempFile("", "appdash")
-		if err != nil {
-			return err
-		}
-		if err := s.Write(f); err != nil {
-			f.Close()
-			return err
-		}
-		if err := f.Close(); err != nil {
-			return err
-		}
-		if err := os.Rename(f.Name(), file); err != nil {
+		if err := Persist(s, file); err != nil {
 			return err
 		}
 	}
 }
 
+// Persist persists s's data to a file, replacing it once the data is
+// written.
+func Persist(s PersistentStore, file string) error {
+	f, err := ioutil.TempFile("", "appdash")
+	if err != nil {
+		return err
+	}
+	if err := s.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), file)
+}
+
 // A DeleteStore is a Store that can delete traces.
 type DeleteStore interface {
 	Store
@@ -385,6 +487,121 @@ type DeleteStore interface {
 	Delete(...ID) error
 }
 
+// Pins is a set of pinned traces, which the stores that evict traces
+// (RecentStore and LimitStore) never delete. The zero value is an empty set
+// that is kept only in memory; OpenPins returns a set that is persisted to a
+// file. It is safe for concurrent use.
+type Pins struct {
+	mu   sync.Mutex
+	ids  map[ID]struct{}
+	file string // file the set is saved to, if any
+}
+
+// OpenPins returns the set of pinned traces saved in the given file, which
+// is saved to the file again whenever it changes. If the file doesn't exist,
+// the set is empty and the file is created by the first change.
+//
+// The file lists the IDs of the pinned traces, one per line.
+func OpenPins(file string) (*Pins, error) {
+	p := &Pins{ids: map[ID]struct{}{}, file: file}
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return p, nil
+	} else if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Fields(string(data)) {
+		id, err := ParseID(line)
+		if err != nil {
+			return nil, fmt.Errorf("pins file %s: invalid trace ID %q", file, line)
+		}
+		p.ids[id] = struct{}{}
+	}
+	return p, nil
+}
+
+// Pin adds the given traces to the set.
+func (p *Pins) Pin(traces ...ID) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.ids == nil {
+		p.ids = make(map[ID]struct{}, len(traces))
+	}
+	for _, id := range traces {
+		p.ids[id] = struct{}{}
+	}
+	return p.saveNoLock()
+}
+
+// Unpin removes the given traces from the set.
+func (p *Pins) Unpin(traces ...ID) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, id := range traces {
+		delete(p.ids, id)
+	}
+	return p.saveNoLock()
+}
+
+// Pinned reports whether the trace is pinned. It returns false if p is nil.
+func (p *Pins) Pinned(trace ID) bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, pinned := p.ids[trace]
+	return pinned
+}
+
+// List returns the pinned traces in ascending order.
+func (p *Pins) List() []ID {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.listNoLock()
+}
+
+func (p *Pins) listNoLock() []ID {
+	ids := make([]ID, 0, len(p.ids))
+	for id := range p.ids {
+		ids = append(ids, id)
+	}
+	sort.Sort(idsByValue(ids))
+	return ids
+}
+
+// saveNoLock atomically replaces the file of the set, if any, with the
+// current set. It must be called with p.mu held.
+func (p *Pins) saveNoLock() error {
+	if p.file == "" {
+		return nil
+	}
+	var buf bytes.Buffer
+	for _, id := range p.listNoLock() {
+		fmt.Fprintln(&buf, id)
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(p.file), filepath.Base(p.file)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), p.file)
+}
+
+type idsByValue []ID
+
+func (v idsByValue) Len() int           { return len(v) }
+func (v idsByValue) Less(i, j int) bool { return v[i] < v[j] }
+func (v idsByValue) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+
 // A RecentStore wraps another store and deletes old traces after a
 // specified amount of time.
 type RecentStore struct {
@@ -398,6 +615,10 @@ type RecentStore struct {
 	// Debug is whether to log debug messages.
 	Debug bool
 
+	// Pins, if non-nil, is the set of traces that are never evicted. Pinned
+	// traces are evicted normally once they are unpinned.
+	Pins *Pins
+
 	// created maps trace ID to the UnixNano time it was first seen.
 	created map[ID]int64
 
@@ -425,6 +646,24 @@ func (rs *RecentStore) Collect(id SpanID, anns ...Annotation) error {
 	return rs.DeleteStore.Collect(id, anns...)
 }
 
+// Track records that the trace was created at the given time, or now if it
+// is zero, so that it is evicted once it is older than MinEvictAge. It is
+// used to evict the traces that were stored before rs was created, such as
+// those of a persistent store when it is reopened.
+func (rs *RecentStore) Track(trace ID, created time.Time) {
+	if created.IsZero() {
+		created = time.Now()
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if rs.created == nil {
+		rs.created = map[ID]int64{}
+	}
+	if _, present := rs.created[trace]; !present {
+		rs.created[trace] = created.UnixNano()
+	}
+}
+
 // evictBefore evicts traces that were created before t. The rs.mu lock
 // must be held while calling evictBefore.
 func (rs *RecentStore) evictBefore(t time.Time) {
@@ -433,7 +672,7 @@ func (rs *RecentStore) evictBefore(t time.Time) {
 	tnano := t.UnixNano()
 	var toEvict []ID
 	for id, ct := range rs.created {
-		if ct < tnano {
+		if ct < tnano && !rs.Pins.Pinned(id) {
 			toEvict = append(toEvict, id)
 			delete(rs.created, id)
 		}
@@ -458,6 +697,17 @@ func (rs *RecentStore) evictBefore(t time.Time) {
 	}()
 }
 
+// Delete calls the underlying store's Delete and forgets the deleted traces.
+func (rs *RecentStore) Delete(traces ...ID) error {
+	rs.mu.Lock()
+	for _, id := range traces {
+		delete(rs.created, id)
+	}
+	rs.mu.Unlock()
+
+	return rs.DeleteStore.Delete(traces...)
+}
+
 // A LimitStore wraps another store and deletes the oldest trace when
 // the number of traces reaches the capacity (Max).
 type LimitStore struct {
@@ -468,11 +718,17 @@ type LimitStore struct {
 	// deleted from.
 	DeleteStore
 
+	// Pins, if non-nil, is the set of traces that are never deleted. When
+	// the oldest trace is pinned, it stops counting toward Max instead of
+	// being deleted, so the store may hold more than Max traces. Once it
+	// is unpinned, it is deleted when the next new trace is collected.
+	Pins *Pins
+
 	mu            sync.Mutex
 	traces        map[ID]struct{} // set of traces to quickly determine which traces exist in ring already.
 	ring          []int64         // ring is a circular list of trace IDs in insertion order.
 	nextInsertIdx int             // nextInsertIdx is the ring index for the next insertion.
-
+	kept          map[ID]struct{} // pinned traces that were removed from the ring instead of being deleted.
 }
 
 // Collect calls the underlying store's Collect, deleting the oldest
@@ -495,12 +751,34 @@ func (ls *LimitStore) Collect(id SpanID, anns ...Annotation) error {
 		return ls.DeleteStore.Collect(id, anns...)
 	}
 
+	// Pinned traces that no longer count toward Max aren't added back to
+	// the ring.
+	if _, ok := ls.kept[id.Trace]; ok {
+		return ls.DeleteStore.Collect(id, anns...)
+	}
+
+	// Delete the kept traces that have been unpinned since.
+	for old := range ls.kept {
+		if !ls.Pins.Pinned(old) {
+			if err := ls.DeleteStore.Delete(old); err != nil {
+				return err
+			}
+			delete(ls.kept, old)
+		}
+	}
+
 	if nextInsert := ls.ring[ls.nextInsertIdx]; nextInsert != 0 {
 		// Store is at capacity (we know this because the next insert
-		// slot already contains trace); delete oldest.
+		// slot already contains trace); delete oldest, unless it's
+		// pinned, in which case it is kept until it is unpinned.
 		old := ID(ls.ring[ls.nextInsertIdx])
 		delete(ls.traces, old)
-		if err := ls.DeleteStore.Delete(old); err != nil {
+		if ls.Pins.Pinned(old) {
+			if ls.kept == nil {
+				ls.kept = make(map[ID]struct{})
+			}
+			ls.kept[old] = struct{}{}
+		} else if err := ls.DeleteStore.Delete(old); err != nil {
 			return err
 		}
 	}
@@ -510,3 +788,24 @@ func (ls *LimitStore) Collect(id SpanID, anns ...Annotation) error {
 
 	return ls.DeleteStore.Collect(id, anns...)
 }
+
+// Delete calls the underlying store's Delete and forgets the deleted traces,
+// so that they no longer count toward Max.
+func (ls *LimitStore) Delete(traces ...ID) error {
+	ls.mu.Lock()
+	for _, id := range traces {
+		delete(ls.kept, id)
+		if _, ok := ls.traces[id]; !ok {
+			continue
+		}
+		delete(ls.traces, id)
+		for i, v := range ls.ring {
+			if ID(v) == id {
+				ls.ring[i] = 0
+			}
+		}
+	}
+	ls.mu.Unlock()
+
+	return ls.DeleteStore.Delete(traces...)
+}
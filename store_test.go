@@ -7,6 +7,8 @@ import (
 	"io/ioutil"
 	"log"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strings"
@@ -367,6 +369,27 @@ func TestRecentStore(t *testing.T) {
 	}
 }
 
+func TestRecentStore_Track(t *testing.T) {
+	const age = time.Hour
+
+	ms := NewMemoryStore()
+	ms.Collect(SpanID{1, 2, 0})
+	ms.Collect(SpanID{2, 3, 0})
+	ms.Collect(SpanID{3, 4, 0})
+	rs := &RecentStore{DeleteStore: ms, MinEvictAge: age}
+	rs.Track(1, time.Now().Add(-2*age))
+	rs.Track(2, time.Now())
+	rs.Track(3, time.Time{}) // unknown start time
+
+	// The traces stored before the RecentStore was created are evicted by
+	// the time they were tracked.
+	storeT{t, rs}.MustCollect(SpanID{4, 5, 0})
+	time.Sleep(10 * time.Millisecond)
+	if got, want := storedTraceIDs(ms), []ID{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+}
+
 func TestLimitStore(t *testing.T) {
 	const age = time.Millisecond * 10
 
@@ -412,6 +435,127 @@ func TestLimitStore(t *testing.T) {
 	}
 }
 
+func TestRecentStore_pins(t *testing.T) {
+	const age = time.Millisecond * 10
+
+	ms := NewMemoryStore()
+	pins := new(Pins)
+	pins.Pin(1)
+	rs := &storeT{t, &RecentStore{DeleteStore: ms, MinEvictAge: age, Pins: pins}}
+
+	rs.MustCollect(SpanID{1, 2, 0})
+	rs.MustCollect(SpanID{2, 3, 0})
+	time.Sleep(2 * age)
+	rs.MustCollect(SpanID{3, 4, 0})
+	time.Sleep(2 * age)
+	if got, want := storedTraceIDs(ms), []ID{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+
+	// Once unpinned, the trace is evicted like any other.
+	pins.Unpin(1)
+	rs.MustCollect(SpanID{4, 5, 0})
+	time.Sleep(2 * age)
+	if got, want := storedTraceIDs(ms), []ID{4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+}
+
+func TestLimitStore_pins(t *testing.T) {
+	ms := NewMemoryStore()
+	pins := new(Pins)
+	pins.Pin(1)
+	ls := &LimitStore{DeleteStore: ms, Max: 2, Pins: pins}
+	rs := &storeT{t, ls}
+
+	rs.MustCollect(SpanID{1, 2, 0})
+	rs.MustCollect(SpanID{2, 3, 0})
+	rs.MustCollect(SpanID{3, 4, 0})
+	rs.MustCollect(SpanID{1, 5, 2}) // pinned trace that no longer counts
+	rs.MustCollect(SpanID{4, 6, 0})
+	if got, want := storedTraceIDs(ms), []ID{1, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+	if tr, err := ms.Trace(1); err != nil || len(tr.Sub) != 1 {
+		t.Errorf("got trace %v (err %v), want the pinned trace with 1 child", tr, err)
+	}
+
+	// Deleted traces no longer count toward Max.
+	if err := ls.Delete(3); err != nil {
+		t.Fatal(err)
+	}
+	rs.MustCollect(SpanID{5, 7, 0})
+	if got, want := storedTraceIDs(ms), []ID{1, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+
+	// Once unpinned, the trace is deleted.
+	pins.Unpin(1)
+	rs.MustCollect(SpanID{6, 8, 0})
+	if got, want := storedTraceIDs(ms), []ID{5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+}
+
+func TestPins(t *testing.T) {
+	var nilPins *Pins
+	if nilPins.Pinned(1) {
+		t.Error("nil Pins: got pinned, want not pinned")
+	}
+	var p Pins
+	p.Pin(3, 1, 2)
+	p.Unpin(2)
+	if !p.Pinned(1) || p.Pinned(2) {
+		t.Errorf("got Pinned(1)=%v Pinned(2)=%v, want true and false", p.Pinned(1), p.Pinned(2))
+	}
+	if got, want := p.List(), []ID{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got List() = %v, want %v", got, want)
+	}
+}
+
+func TestOpenPins(t *testing.T) {
+	dir, err := ioutil.TempDir("", "appdash-pins")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "pins")
+
+	p, err := OpenPins(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.List(); len(got) != 0 {
+		t.Errorf("got pins %v in a new file, want none", got)
+	}
+	if err := p.Pin(3, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Unpin(2); err != nil {
+		t.Fatal(err)
+	}
+
+	// The pins are read again after a restart.
+	p, err = OpenPins(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := p.List(), []ID{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got List() = %v after reopening, want %v", got, want)
+	}
+}
+
+// storedTraceIDs returns the IDs of the traces in ms in ascending order.
+func storedTraceIDs(ms *MemoryStore) []ID {
+	traces, _ := ms.Traces(TracesOpts{})
+	ids := make([]ID, len(traces))
+	for i, t := range traces {
+		ids[i] = t.ID.Trace
+	}
+	sort.Sort(idsByValue(ids))
+	return ids
+}
+
 func compareTraces(a, b *Trace) (diff []string) {
 	var cmp func(parent ID, a, b *Trace)
 	cmp = func(parent ID, a, b *Trace) {
@@ -2,6 +2,7 @@ package traceapp
 
 import (
 	"fmt"
+	"time"
 
 	"sourcegraph.com/sourcegraph/appdash"
 )
@@ -89,3 +90,39 @@ func (a *App) aggregate(traces []*appdash.Trace, mode aggMode) ([]*aggItem, erro
 	}
 	return list, nil
 }
+
+// aggLatency is the distribution of the durations of a set of traces, in
+// milliseconds. It is encoded to JSON.
+type aggLatency struct {
+	P50, P90, P95, P99 int64
+	Histogram          []*aggBucket
+}
+
+// aggBucket is the number of traces that took at most Max milliseconds, and
+// longer than the Max of the previous bucket.
+type aggBucket struct {
+	Max   int64 `json:"max"`
+	Count int64 `json:"count"`
+}
+
+// latency returns the distribution of the durations of the given traces'
+// root spans. Traces without a timespan event are ignored.
+func (a *App) latency(traces []*appdash.Trace) *aggLatency {
+	var sketch appdash.DurationSketch
+	for _, t := range traces {
+		if start, _, d := spanTimes(t); start != nil {
+			sketch.Add(d)
+		}
+	}
+	l := &aggLatency{
+		P50:       int64(sketch.Quantile(0.5) / time.Millisecond),
+		P90:       int64(sketch.Quantile(0.9) / time.Millisecond),
+		P95:       int64(sketch.Quantile(0.95) / time.Millisecond),
+		P99:       int64(sketch.Quantile(0.99) / time.Millisecond),
+		Histogram: []*aggBucket{},
+	}
+	for _, b := range sketch.Buckets(appdash.HistogramBounds) {
+		l.Histogram = append(l.Histogram, &aggBucket{Max: int64(b.Max / time.Millisecond), Count: b.Count})
+	}
+	return l
+}
@@ -0,0 +1,494 @@
+package traceapp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// The JSON API is served under /api/v1 (see the API*Route names). Its
+// responses are encoded from the api* types below, whose JSON field names are
+// part of the API and must not change within a version. In all responses,
+// IDs are hex strings, times are RFC 3339 strings and durations are integer
+// nanoseconds.
+
+const (
+	// apiDefaultLimit is the number of traces listed when the request doesn't
+	// specify a limit.
+	apiDefaultLimit = 100
+
+	// apiMaxLimit is the maximum number of traces listed per request.
+	apiMaxLimit = 1000
+)
+
+// apiSpan is a span, and in trace trees the spans below it.
+type apiSpan struct {
+	TraceID     appdash.ID      `json:"traceId"`
+	SpanID      appdash.ID      `json:"spanId"`
+	ParentID    appdash.ID      `json:"parentId,omitempty"`
+	Name        string          `json:"name"`
+	Service     string          `json:"service,omitempty"`
+	Start       *time.Time      `json:"start,omitempty"`
+	End         *time.Time      `json:"end,omitempty"`
+	Duration    time.Duration   `json:"duration"`
+	Failed      bool            `json:"failed"`
+	URL         string          `json:"url"`
+	Annotations []apiAnnotation `json:"annotations"`
+	Events      []apiEvent      `json:"events,omitempty"`
+	Children    []*apiSpan      `json:"children,omitempty"`
+}
+
+// apiAnnotation is a raw span annotation.
+type apiAnnotation struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// apiEvent is an event decoded from a span's annotations. Data is the event
+// value itself, so its fields depend on the schema.
+type apiEvent struct {
+	Schema string        `json:"schema"`
+	Data   appdash.Event `json:"data"`
+}
+
+// apiTraceSummary describes a trace in a list of traces. Failed is whether
+// any of its spans failed.
+type apiTraceSummary struct {
+	TraceID  appdash.ID    `json:"traceId"`
+	Name     string        `json:"name"`
+	Service  string        `json:"service,omitempty"`
+	Start    *time.Time    `json:"start,omitempty"`
+	End      *time.Time    `json:"end,omitempty"`
+	Duration time.Duration `json:"duration"`
+	Spans    int           `json:"spans"`
+	Failed   bool          `json:"failed"`
+	Pinned   bool          `json:"pinned"`
+	URL      string        `json:"url"`
+}
+
+// apiTraceList is a page of traces. Next is the URL of the next page, if
+// there may be one.
+type apiTraceList struct {
+	Traces []*apiTraceSummary `json:"traces"`
+	Offset int                `json:"offset"`
+	Limit  int                `json:"limit"`
+	Next   string             `json:"next,omitempty"`
+}
+
+// apiAggregateItem is the cumulative time of a set of traces or spans with
+// the same name.
+type apiAggregateItem struct {
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+}
+
+// apiDashboardRow is the aggregated durations of a group of spans, as shown by
+// a row of the dashboard. Name is the span name or annotation value that
+// groups them, and URL is the traces page listing the slowest of them.
+type apiDashboardRow struct {
+	Name      string                `json:"name"`
+	Average   time.Duration         `json:"average"`
+	Min       time.Duration         `json:"min"`
+	Max       time.Duration         `json:"max"`
+	StdDev    time.Duration         `json:"stdDev"`
+	P50       time.Duration         `json:"p50"`
+	P90       time.Duration         `json:"p90"`
+	P95       time.Duration         `json:"p95"`
+	P99       time.Duration         `json:"p99"`
+	Histogram []*apiHistogramBucket `json:"histogram"`
+	Samples   int64                 `json:"samples"`
+	Slowest   []appdash.ID          `json:"slowest"`
+	URL       string                `json:"url"`
+}
+
+// apiHistogramBucket is the number of traces that took at most Max, and
+// longer than the Max of the previous bucket.
+type apiHistogramBucket struct {
+	Max   time.Duration `json:"max"`
+	Count int64         `json:"count"`
+}
+
+// apiErrorResponse is the body of an API error response.
+type apiErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// apiError is an error with the HTTP status code to respond with.
+type apiError struct {
+	status int
+	err    error
+}
+
+func (e *apiError) Error() string { return e.err.Error() }
+
+// badRequest returns an error that responds with 400 Bad Request.
+func badRequest(err error) error {
+	return &apiError{status: http.StatusBadRequest, err: err}
+}
+
+// apiHandlerFunc is a handler of the JSON API. It returns the value to encode
+// as the response body, or an error that is encoded as an apiErrorResponse.
+type apiHandlerFunc func(*http.Request) (interface{}, error)
+
+// ServeHTTP implements http.Handler.
+func (h apiHandlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if rv := recover(); rv != nil {
+			writeAPIError(w, r, fmt.Errorf("handler panic\n\n%s\n\n%s", rv, debug.Stack()))
+		}
+	}()
+
+	v, err := h(r)
+	if err != nil {
+		writeAPIError(w, r, err)
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		writeAPIError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(b)
+}
+
+// writeAPIError responds with err encoded as an apiErrorResponse.
+func writeAPIError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusInternalServerError
+	if err == appdash.ErrTraceNotFound {
+		status = http.StatusNotFound
+	} else if e, ok := err.(*apiError); ok {
+		status = e.status
+	}
+	if status == http.StatusInternalServerError {
+		log.Printf("%s %s: error: %s", r.Method, r.URL.RequestURI(), err.Error())
+	}
+
+	// Never cache error responses.
+	w.Header().Set("cache-control", "no-cache, max-age=0")
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(apiErrorResponse{Error: err.Error()})
+}
+
+// serveAPITraces serves a page of the traces matching the search parameters
+// of the traces page (see parseTracesSearch). At most apiDefaultLimit traces
+// are listed if no limit is given.
+func (a *App) serveAPITraces(r *http.Request) (interface{}, error) {
+	search, err := parseTracesSearch(r.URL.Query())
+	if err != nil {
+		return nil, badRequest(err)
+	}
+	if search.Limit == 0 {
+		search.Limit = apiDefaultLimit
+	}
+	if search.Limit > apiMaxLimit {
+		search.Limit = apiMaxLimit
+	}
+	opts, err := search.TracesOpts()
+	if err != nil {
+		return nil, badRequest(err)
+	}
+
+	var traces []*appdash.Trace
+	if search.Pinned == "" || a.restrictToPinned(&opts) {
+		traces, err = a.Queryer.Traces(opts)
+		if err != nil {
+			return nil, err
+		}
+	}
+	list := &apiTraceList{
+		Traces: make([]*apiTraceSummary, len(traces)),
+		Offset: search.Offset,
+		Limit:  search.Limit,
+	}
+	for i, t := range traces {
+		u, err := a.URLToTrace(t.ID.Trace)
+		if err != nil {
+			return nil, err
+		}
+		s := &apiTraceSummary{
+			TraceID: t.ID.Trace,
+			Name:    t.Span.Name(),
+			Service: t.Span.Service(),
+			Spans:   countSpans(t),
+			Failed:  t.Failed(),
+			Pinned:  a.Pins.Pinned(t.ID.Trace),
+			URL:     u.String(),
+		}
+		s.Start, s.End, s.Duration = spanTimes(t)
+		list.Traces[i] = s
+	}
+	if len(traces) == search.Limit {
+		list.Next = search.pageURL(r.URL, search.Offset+search.Limit).String()
+	}
+	return list, nil
+}
+
+// serveAPITrace serves the tree of spans of a trace.
+func (a *App) serveAPITrace(r *http.Request) (interface{}, error) {
+	trace, err := a.apiTrace(r)
+	if err != nil {
+		return nil, err
+	}
+	return a.apiSpanTree(trace)
+}
+
+// serveAPISpan serves a span of a trace, with its decoded events.
+func (a *App) serveAPISpan(r *http.Request) (interface{}, error) {
+	trace, err := a.apiTrace(r)
+	if err != nil {
+		return nil, err
+	}
+	spanID, err := appdash.ParseID(mux.Vars(r)["Span"])
+	if err != nil {
+		return nil, badRequest(fmt.Errorf("invalid span ID: %s", err))
+	}
+	t := trace.FindSpan(spanID)
+	if t == nil {
+		return nil, &apiError{status: http.StatusNotFound, err: errors.New("span not found")}
+	}
+
+	s, err := a.apiSpan(t)
+	if err != nil {
+		return nil, err
+	}
+	var events []appdash.Event
+	if err := appdash.UnmarshalEvents(t.Span.Annotations, &events); err != nil {
+		return nil, err
+	}
+	s.Events = make([]apiEvent, len(events))
+	for i, e := range events {
+		s.Events[i] = apiEvent{Schema: e.Schema(), Data: e}
+	}
+	return s, nil
+}
+
+// serveAPIAggregate serves the cumulative time of traces or spans grouped by
+// name, like the aggregate page. It accepts the same query parameters.
+func (a *App) serveAPIAggregate(r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	traces, err := a.selectedTraces(q.Get("selection"))
+	if err != nil {
+		return nil, badRequest(err)
+	}
+	aggregated, err := a.aggregate(traces, parseAggMode(q.Get("view-mode")))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*apiAggregateItem, len(aggregated))
+	for i, item := range aggregated {
+		items[i] = &apiAggregateItem{
+			Name:     item.Label,
+			Duration: time.Duration(item.Value) * time.Millisecond,
+		}
+	}
+	return items, nil
+}
+
+// serveAPIDashboard serves the dashboard rows of the traces that occurred
+// between the start and end query parameters, which are durations relative
+// to now (such as "-24h"). They default to the last 72 hours. Root spans are
+// grouped by name, unless the group-by parameter is an annotation key to
+// group by instead; all-spans=true aggregates all spans.
+func (a *App) serveAPIDashboard(r *http.Request) (interface{}, error) {
+	if a.Aggregator == nil {
+		return nil, &apiError{status: http.StatusNotImplemented, err: errors.New("the store does not support aggregation")}
+	}
+	q := r.URL.Query()
+	start, end, err := parseRelativeWindow(q, -72*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	opts := appdash.AggregateOpts{Start: start, End: end, GroupBy: q.Get("group-by")}
+	if opts.GroupBy != "" && !a.groupsBy(opts.GroupBy) {
+		return nil, badRequest(fmt.Errorf("spans are not grouped by %q", opts.GroupBy))
+	}
+	if s := q.Get("all-spans"); s != "" {
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, badRequest(fmt.Errorf("invalid all-spans %q", s))
+		}
+		opts.AllSpans = v
+	}
+
+	results, err := a.Aggregator.Aggregate(opts)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]*apiDashboardRow, len(results))
+	for i, res := range results {
+		u, err := a.slowestTracesURL(res.Slowest)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = &apiDashboardRow{
+			Name:      res.Group,
+			Average:   res.Average,
+			Min:       res.Min,
+			Max:       res.Max,
+			StdDev:    res.StdDev,
+			P50:       res.P50,
+			P90:       res.P90,
+			P95:       res.P95,
+			P99:       res.P99,
+			Histogram: []*apiHistogramBucket{},
+			Samples:   res.Samples,
+			Slowest:   res.Slowest,
+			URL:       u.String(),
+		}
+		for _, b := range res.Histogram {
+			rows[i].Histogram = append(rows[i].Histogram, &apiHistogramBucket{Max: b.Max, Count: b.Count})
+		}
+		if rows[i].Slowest == nil {
+			rows[i].Slowest = []appdash.ID{}
+		}
+	}
+	return rows, nil
+}
+
+// parseRelativeWindow parses the start and end query parameters, which are
+// durations relative to now (such as "-24h"). They default to defaultStart
+// and now.
+func parseRelativeWindow(q url.Values, defaultStart time.Duration) (start, end time.Duration, err error) {
+	start = defaultStart
+	for _, v := range []struct {
+		name string
+		dst  *time.Duration
+	}{{"start", &start}, {"end", &end}} {
+		s := q.Get(v.name)
+		if s == "" {
+			continue
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return 0, 0, badRequest(fmt.Errorf("invalid %s %q", v.name, s))
+		}
+		*v.dst = d
+	}
+	return start, end, nil
+}
+
+// windowTracesPage is the number of traces that allTraces queries at once.
+const windowTracesPage = 1000
+
+// allTraces returns all of the traces matching opts, newest first. Queryers
+// may return only a page of the matching traces when no limit is set (e.g.
+// InfluxDBStore), so they are queried a page at a time until no more are
+// left.
+func (a *App) allTraces(opts appdash.TracesOpts) ([]*appdash.Trace, error) {
+	opts.Order, opts.Limit = appdash.OrderNewest, windowTracesPage
+	var traces []*appdash.Trace
+	for opts.Offset = 0; ; opts.Offset += opts.Limit {
+		page, err := a.Queryer.Traces(opts)
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, page...)
+		if len(page) < opts.Limit {
+			return traces, nil
+		}
+	}
+}
+
+// apiTrace returns the trace named by the Trace route variable of r.
+func (a *App) apiTrace(r *http.Request) (*appdash.Trace, error) {
+	traceID, err := appdash.ParseID(mux.Vars(r)["Trace"])
+	if err != nil {
+		return nil, badRequest(fmt.Errorf("invalid trace ID: %s", err))
+	}
+	return a.Store.Trace(traceID)
+}
+
+// apiSpanTree converts t and its descendants to apiSpans.
+func (a *App) apiSpanTree(t *appdash.Trace) (*apiSpan, error) {
+	s, err := a.apiSpan(t)
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range t.Sub {
+		child, err := a.apiSpanTree(sub)
+		if err != nil {
+			return nil, err
+		}
+		s.Children = append(s.Children, child)
+	}
+	return s, nil
+}
+
+// apiSpan converts the span of t, without its children, to an apiSpan.
+func (a *App) apiSpan(t *appdash.Trace) (*apiSpan, error) {
+	var (
+		u   *url.URL
+		err error
+	)
+	if t.ID.Parent == 0 {
+		u, err = a.URLToTrace(t.ID.Trace)
+	} else {
+		u, err = a.URLToTraceSpan(t.ID.Trace, t.ID.Span)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := &apiSpan{
+		TraceID:     t.ID.Trace,
+		SpanID:      t.ID.Span,
+		ParentID:    t.ID.Parent,
+		Name:        t.Span.Name(),
+		Service:     t.Span.Service(),
+		Failed:      t.Span.Failed(),
+		URL:         u.String(),
+		Annotations: make([]apiAnnotation, len(t.Span.Annotations)),
+	}
+	s.Start, s.End, s.Duration = spanTimes(t)
+	for i, ann := range t.Span.Annotations {
+		s.Annotations[i] = apiAnnotation{Key: ann.Key, Value: string(ann.Value)}
+	}
+	return s, nil
+}
+
+// spanTimes returns the start and end times of the span of t, which are nil
+// if it has no timespan events.
+func spanTimes(t *appdash.Trace) (start, end *time.Time, d time.Duration) {
+	ts, err := t.TimespanEvent()
+	if err != nil {
+		return nil, nil, 0
+	}
+	s, e := ts.Start(), ts.End()
+	return &s, &e, e.Sub(s)
+}
+
+// countSpans returns the number of spans in t.
+func countSpans(t *appdash.Trace) int {
+	n := 1
+	for _, sub := range t.Sub {
+		n += countSpans(sub)
+	}
+	return n
+}
+
+// slowestTracesURL returns the URL of the traces page showing only the given
+// traces.
+func (a *App) slowestTracesURL(ids []appdash.ID) (*url.URL, error) {
+	u, err := a.Router.URLTo(TracesRoute)
+	if err != nil {
+		return nil, err
+	}
+	stringIDs := make([]string, len(ids))
+	for i, id := range ids {
+		stringIDs[i] = id.String()
+	}
+	u.RawQuery = "show=" + strings.Join(stringIDs, ",")
+	return u, nil
+}
@@ -0,0 +1,128 @@
+package traceapp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+func TestAPI_statusCodes(t *testing.T) {
+	app, ms := newTestApp(t)
+	collectNamed(t, ms, "a", 1, 2)
+
+	// The dashboard needs an Aggregator.
+	if w := serve(app, "GET", "/api/v1/dashboard", nil); w.Code != http.StatusNotImplemented {
+		t.Errorf("GET /api/v1/dashboard without Aggregator: got status %d, want %d", w.Code, http.StatusNotImplemented)
+	}
+	app.Aggregator = appdash.NewAggregatingCollector(ms)
+
+	tests := map[string]int{
+		"/api/v1/traces": http.StatusOK,
+		"/api/v1/traces?name=a&sort=oldest&limit=1": http.StatusOK,
+		"/api/v1/traces?limit=x":                    http.StatusBadRequest,
+		"/api/v1/traces?errors=maybe":               http.StatusBadRequest,
+		"/api/v1/traces/1":                          http.StatusOK,
+		"/api/v1/traces/3":                          http.StatusNotFound,
+		"/api/v1/traces/x":                          http.StatusBadRequest,
+		"/api/v1/traces/1/spans/1":                  http.StatusOK,
+		"/api/v1/traces/1/spans/2":                  http.StatusNotFound,
+		"/api/v1/traces/1/spans/x":                  http.StatusBadRequest,
+		"/api/v1/aggregate?selection=1,2":           http.StatusOK,
+		"/api/v1/aggregate?selection=x":             http.StatusBadRequest,
+		"/api/v1/dashboard?start=-1h&group-by=Tag":  http.StatusOK,
+		"/api/v1/dashboard?group-by=Missing":        http.StatusBadRequest,
+		"/api/v1/dashboard?start=yesterday":         http.StatusBadRequest,
+		"/api/v1/dashboard?all-spans=maybe":         http.StatusBadRequest,
+		"/api/v1/dependencies":                      http.StatusOK,
+		"/api/v1/dependencies?end=now":              http.StatusBadRequest,
+	}
+	for path, want := range tests {
+		w := serve(app, "GET", path, nil)
+		if w.Code != want {
+			t.Errorf("GET %s: got status %d, want %d (body %q)", path, w.Code, want, w.Body)
+			continue
+		}
+		if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+			t.Errorf("GET %s: got Content-Type %q, want application/json", path, ct)
+		}
+		if want != http.StatusOK {
+			var res apiErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil || res.Error == "" {
+				t.Errorf("GET %s: got error body %q, want an apiErrorResponse", path, w.Body)
+			}
+		}
+	}
+}
+
+func TestAPI_tracesPages(t *testing.T) {
+	app, ms := newTestApp(t)
+	collectNamed(t, ms, "a", 1, 2, 3)
+
+	var ids []appdash.ID
+	path := "/api/v1/traces?sort=oldest&limit=2"
+	for pages := 0; path != ""; pages++ {
+		if pages == 3 {
+			t.Fatal("got too many pages")
+		}
+		w := serve(app, "GET", path, nil)
+		var list apiTraceList
+		if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil {
+			t.Fatalf("GET %s: %s (body %q)", path, err, w.Body)
+		}
+		for _, s := range list.Traces {
+			ids = append(ids, s.TraceID)
+		}
+		path = ""
+		if list.Next != "" {
+			next, err := url.Parse(list.Next)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path = next.RequestURI()
+		}
+	}
+	sortIDs(ids)
+	if want := []appdash.ID{1, 2, 3}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got traces %v, want %v", ids, want)
+	}
+}
+
+// pagedQueryer is a Queryer that returns at most a page of traces when no
+// limit is set, like InfluxDBStore.
+type pagedQueryer struct {
+	appdash.Queryer
+}
+
+func (q pagedQueryer) Traces(opts appdash.TracesOpts) ([]*appdash.Trace, error) {
+	if opts.Limit == 0 {
+		opts.Limit = 10
+	}
+	return q.Queryer.Traces(opts)
+}
+
+func TestApp_allTraces(t *testing.T) {
+	app, ms := newTestApp(t)
+	app.Queryer = pagedQueryer{ms}
+	const n = windowTracesPage + 1
+	var ids []appdash.ID
+	for id := appdash.ID(1); id <= n; id++ {
+		ids = append(ids, id)
+	}
+	collectNamed(t, ms, "a", ids...)
+
+	traces, err := app.allTraces(appdash.TracesOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make(map[appdash.ID]bool)
+	for _, tr := range traces {
+		seen[tr.ID.Trace] = true
+	}
+	if len(traces) != n || len(seen) != n {
+		t.Errorf("got %d traces (%d distinct), want %d", len(traces), len(seen), n)
+	}
+}
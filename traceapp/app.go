@@ -43,6 +43,17 @@ type App struct {
 	Queryer    appdash.Queryer
 	Aggregator appdash.Aggregator
 
+	// Pins, if non-nil, is the set of pinned traces, which users can change.
+	// It should also be the Pins of any RecentStore or LimitStore that Store
+	// wraps.
+	Pins *appdash.Pins
+
+	// AllowChanges is whether users can delete and pin traces. The app has
+	// no authentication of its own, so it should only be set if the app is
+	// served behind some (such as HTTP Basic auth); otherwise, anyone who
+	// can reach it could delete the traces.
+	AllowChanges bool
+
 	tmplLock sync.Mutex
 	tmpls    map[string]*htmpl.Template
 
@@ -83,11 +94,27 @@ func New(r *Router, base *url.URL) (*App, error) {
 	r.r.Get(TraceSpanRoute).Handler(handlerFunc(app.serveTrace))
 	r.r.Get(TraceProfileRoute).Handler(handlerFunc(app.serveTrace))
 	r.r.Get(TraceSpanProfileRoute).Handler(handlerFunc(app.serveTrace))
+	r.r.Get(TraceFoldedRoute).Handler(handlerFunc(app.serveTrace))
+	r.r.Get(TraceSpanFoldedRoute).Handler(handlerFunc(app.serveTrace))
 	r.r.Get(TraceUploadRoute).Handler(handlerFunc(app.serveTraceUpload))
 	r.r.Get(TracesRoute).Handler(handlerFunc(app.serveTraces))
 	r.r.Get(DashboardRoute).Handler(handlerFunc(app.serveDashboard))
 	r.r.Get(DashboardDataRoute).Handler(handlerFunc(app.serveDashboardData))
 	r.r.Get(AggregateRoute).Handler(handlerFunc(app.serveAggregate))
+	r.r.Get(DependenciesRoute).Handler(handlerFunc(app.serveDependencies))
+	r.r.Get(FlameRoute).Handler(handlerFunc(app.serveFlame))
+	r.r.Get(FlameFoldedRoute).Handler(handlerFunc(app.serveFlameFolded))
+	r.r.Get(CompareRoute).Handler(handlerFunc(app.serveCompare))
+	r.r.Get(APITracesRoute).Handler(apiHandlerFunc(app.serveAPITraces))
+	r.r.Get(APITraceRoute).Handler(apiHandlerFunc(app.serveAPITrace))
+	r.r.Get(APISpanRoute).Handler(apiHandlerFunc(app.serveAPISpan))
+	r.r.Get(APIAggregateRoute).Handler(apiHandlerFunc(app.serveAPIAggregate))
+	r.r.Get(APIDashboardRoute).Handler(apiHandlerFunc(app.serveAPIDashboard))
+	r.r.Get(APIDependenciesRoute).Handler(apiHandlerFunc(app.serveAPIDependencies))
+	r.r.Get(APIDeleteTraceRoute).Handler(app.allowChange(apiHandlerFunc(app.serveAPIDeleteTrace)))
+	r.r.Get(APIPinTraceRoute).Handler(app.allowChange(apiHandlerFunc(app.serveAPIPinTrace)))
+	r.r.Get(APIUnpinTraceRoute).Handler(app.allowChange(apiHandlerFunc(app.serveAPIPinTrace)))
+	r.r.Get(APIPurgeRoute).Handler(app.allowChange(apiHandlerFunc(app.serveAPIPurge)))
 
 	// Static file serving.
 	r.r.Get(StaticRoute).Handler(http.StripPrefix("/static/", http.FileServer(static.Data)))
@@ -136,6 +163,10 @@ func (a *App) serveTrace(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
+	// Correct the times of spans recorded on hosts with skewed clocks, so
+	// that the timeline and profile are consistent.
+	trace = trace.CorrectClockSkew()
+
 	// Get sub-span if the Span route var is present.
 	if spanIDStr := v["Span"]; spanIDStr != "" {
 		spanID, err := appdash.ParseID(spanIDStr)
@@ -150,9 +181,12 @@ func (a *App) serveTrace(w http.ResponseWriter, r *http.Request) error {
 
 	// We could use a separate handler for this, but as we need the above to
 	// determine the correct trace (or therein sub-trace), we just handle any
-	// JSON profile requests here.
-	if path.Base(r.URL.Path) == "profile" {
+	// JSON profile and folded stacks requests here.
+	switch path.Base(r.URL.Path) {
+	case "profile":
 		return a.profile(trace, w)
+	case "folded":
+		return a.folded(trace, w)
 	}
 
 	// Do not show d3 timeline chart when timeline item fields are invalid.
@@ -168,16 +202,36 @@ func (a *App) serveTrace(w http.ResponseWriter, r *http.Request) error {
 		return err
 	}
 
-	// Determine the profile URL.
-	var profile *url.URL
+	// Determine the profile and folded stacks URLs.
+	var profile, folded *url.URL
 	if trace.ID.Parent == 0 {
 		profile, err = a.Router.URLToTraceProfile(trace.Span.ID.Trace)
+		if err == nil {
+			folded, err = a.Router.URLToTraceFolded(trace.Span.ID.Trace)
+		}
 	} else {
 		profile, err = a.Router.URLToTraceSpanProfile(trace.Span.ID.Trace, trace.Span.ID.Span)
+		if err == nil {
+			folded, err = a.Router.URLToTraceSpanFolded(trace.Span.ID.Trace, trace.Span.ID.Span)
+		}
 	}
 	if err != nil {
 		return err
 	}
+	flame, err := a.traceFlame(trace)
+	if err != nil {
+		return err
+	}
+	mergedFlame, err := a.Router.URLTo(FlameRoute)
+	if err != nil {
+		return err
+	}
+	mergedFlame.RawQuery = url.Values{"name": {trace.Span.Name()}}.Encode()
+	compare, err := a.Router.URLTo(CompareRoute)
+	if err != nil {
+		return err
+	}
+	compare.RawQuery = url.Values{"a": {trace.ID.Trace.String()}}.Encode()
 
 	// The JSON trace is the human-readable trace form for exporting.
 	jsonTrace, err := json.MarshalIndent([]*appdash.Trace{trace}, "", "  ")
@@ -207,68 +261,142 @@ func (a *App) serveTrace(w http.ResponseWriter, r *http.Request) error {
 		ShowTimelineChart bool
 		VisData           []timelineItem
 		ProfileURL        string
+		Flame             *flameNode
+		FoldedURL         string
+		MergedFlameURL    string
+		CompareURL        string
 		Permalink         string
 		JSONTrace         string
+		Pinned            bool
+		CanPin            bool
+		CanDelete         bool
 	}{
 		Trace:             trace,
 		ShowTimelineChart: showTimelineChart,
 		VisData:           visData,
 		ProfileURL:        profile.String(),
+		Flame:             flame,
+		FoldedURL:         folded.String(),
+		MergedFlameURL:    mergedFlame.String(),
+		CompareURL:        compare.String(),
 		Permalink:         permalink.String(),
 		JSONTrace:         string(jsonTrace),
+		Pinned:            a.Pins.Pinned(trace.ID.Trace),
+		CanPin:            a.canPin(),
+		CanDelete:         a.canDelete(),
 	})
 }
 
+// correctClockSkew corrects the clock skew of the given traces in place
+// (see appdash.Trace.CorrectClockSkew).
+func correctClockSkew(traces []*appdash.Trace) {
+	for i, t := range traces {
+		traces[i] = t.CorrectClockSkew()
+	}
+}
+
 func (a *App) serveTraces(w http.ResponseWriter, r *http.Request) error {
-	// Parse the query for a comma-separated list of traces that we should only
-	// show (all others are hidden).
-	var showJust []appdash.ID
-	if show := r.URL.Query().Get("show"); len(show) > 0 {
-		for _, idStr := range strings.Split(show, ",") {
-			id, err := appdash.ParseID(idStr)
-			if err == nil {
-				showJust = append(showJust, id)
-			}
+	search, err := parseTracesSearch(r.URL.Query())
+	if err != nil {
+		return badRequest(err)
+	}
+	if _, ok := r.URL.Query()["limit"]; !ok {
+		// Reading all traces may be slow (e.g. from a FileStore), so only
+		// list all of them when asked to.
+		search.Limit = tracesDefaultLimit
+	}
+	opts, err := search.TracesOpts()
+	if err != nil {
+		return badRequest(err)
+	}
+
+	var traces []*appdash.Trace
+	if search.Pinned == "" || a.restrictToPinned(&opts) {
+		traces, err = a.Queryer.Traces(opts)
+		if err != nil {
+			return err
 		}
 	}
 
-	traces, err := a.Queryer.Traces(appdash.TracesOpts{
-		TraceIDs: showJust,
-	})
-	if err != nil {
-		return err
+	// Determine the previous and next page URLs, if the results are
+	// paginated.
+	var prevPage, nextPage *url.URL
+	if search.Offset > 0 {
+		prev := search.Offset - search.Limit
+		if prev < 0 {
+			prev = 0
+		}
+		prevPage = search.pageURL(r.URL, prev)
+	}
+	if search.Limit > 0 && len(traces) == search.Limit {
+		nextPage = search.pageURL(r.URL, search.Offset+search.Limit)
 	}
 
 	return a.renderTemplate(w, r, "traces.html", http.StatusOK, &struct {
 		TemplateCommon
-		Traces  []*appdash.Trace
-		Visible func(*appdash.Trace) bool
+		Traces    []*appdash.Trace
+		Visible   func(*appdash.Trace) bool
+		Pinned    func(*appdash.Trace) bool
+		Search    *tracesSearch
+		PrevPage  *url.URL
+		NextPage  *url.URL
+		CanPin    bool
+		CanDelete bool
 	}{
 		Traces: traces,
 		Visible: func(t *appdash.Trace) bool {
 			return true
 		},
+		Pinned: func(t *appdash.Trace) bool {
+			return a.Pins.Pinned(t.ID.Trace)
+		},
+		Search:    search,
+		PrevPage:  prevPage,
+		NextPage:  nextPage,
+		CanPin:    a.canPin(),
+		CanDelete: a.canDelete(),
 	})
 }
 
 func (a *App) serveAggregate(w http.ResponseWriter, r *http.Request) error {
-	// By default we display all traces.
-	traces, err := a.Queryer.Traces(appdash.TracesOpts{})
+	q := r.URL.Query()
+	traces, err := a.selectedTraces(q.Get("selection"))
 	if err != nil {
 		return err
 	}
 
-	q := r.URL.Query()
+	// Perform the aggregation and render the data.
+	aggregated, err := a.aggregate(traces, parseAggMode(q.Get("view-mode")))
+	if err != nil {
+		return err
+	}
+	return a.renderTemplate(w, r, "aggregate.html", http.StatusOK, &struct {
+		TemplateCommon
+		Aggregated []*aggItem
+		Latency    *aggLatency
+	}{
+		Aggregated: aggregated,
+		Latency:    a.latency(traces),
+	})
+}
+
+// selectedTraces returns the traces whose IDs are in the given
+// comma-separated list, or all traces if it is empty.
+func (a *App) selectedTraces(selection string) ([]*appdash.Trace, error) {
+	// By default we display all traces.
+	traces, err := a.Queryer.Traces(appdash.TracesOpts{})
+	if err != nil {
+		return nil, err
+	}
 
 	// If they specified a comma-separated list of specific trace IDs that they
 	// are interested in, then we only show those.
-	selection := q.Get("selection")
 	if len(selection) > 0 {
 		var selected []*appdash.Trace
 		for _, idStr := range strings.Split(selection, ",") {
 			id, err := appdash.ParseID(idStr)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			for _, t := range traces {
 				if t.Span.ID.Trace == id {
@@ -278,18 +406,7 @@ func (a *App) serveAggregate(w http.ResponseWriter, r *http.Request) error {
 		}
 		traces = selected
 	}
-
-	// Perform the aggregation and render the data.
-	aggregated, err := a.aggregate(traces, parseAggMode(q.Get("view-mode")))
-	if err != nil {
-		return err
-	}
-	return a.renderTemplate(w, r, "aggregate.html", http.StatusOK, &struct {
-		TemplateCommon
-		Aggregated []*aggItem
-	}{
-		Aggregated: aggregated,
-	})
+	return traces, nil
 }
 
 func (a *App) serveTraceUpload(w http.ResponseWriter, r *http.Request) error {
@@ -0,0 +1,28 @@
+package traceapp
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestApp_serveTraces_statusCodes(t *testing.T) {
+	app, ms := newTestApp(t)
+	collectNamed(t, ms, "a", 1)
+
+	tests := map[string]int{
+		"/traces":                   http.StatusOK,
+		"/traces?name=a&limit=10":   http.StatusOK,
+		"/traces?limit=x":           http.StatusBadRequest,
+		"/traces?offset=-1":         http.StatusBadRequest,
+		"/traces?pinned=no":         http.StatusBadRequest,
+		"/traces?min=1x":            http.StatusBadRequest,
+		"/traces?max=x":             http.StatusBadRequest,
+		"/traces?ann=no-separator":  http.StatusBadRequest,
+		"/traces?show=1&sort=wrong": http.StatusBadRequest,
+	}
+	for path, want := range tests {
+		if w := serve(app, "GET", path, nil); w.Code != want {
+			t.Errorf("GET %s: got status %d, want %d (body %q)", path, w.Code, want, w.Body)
+		}
+	}
+}
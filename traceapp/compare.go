@@ -0,0 +1,257 @@
+package traceapp
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// compareRow is a span of one or both of two compared traces, A and B, in the
+// order of a depth-first walk of their aligned span trees. Spans are aligned
+// by the names of themselves and their ancestors, the path of span names: the
+// n-th child to start with a name in A is aligned with the n-th child to start
+// with the same name in B. Spans without a name are named unnamedSpan.
+type compareRow struct {
+	Name  string
+	Depth int
+
+	// A and B are the span in each trace, or nil if only the other trace has
+	// it.
+	A, B *compareSpan
+
+	// Delta is the time of the span in B minus that in A, in milliseconds,
+	// and DeltaPercent is that relative to the time in A. They are only set if
+	// both traces have the span.
+	Delta        int64
+	DeltaPercent float64
+
+	// Annotations are the annotations of the span that differ between A and
+	// B.
+	Annotations []annotationDiff
+}
+
+// unnamedSpan is the name under which spans without a name are aligned, as
+// their profiles are named by their (random) span IDs.
+const unnamedSpan = "(unnamed)"
+
+// compareName returns the name of the span t, with profile p, to align it by.
+func compareName(t *appdash.Trace, p *profile) string {
+	if t.Span.Name() == "" {
+		return unnamedSpan
+	}
+	return p.Name
+}
+
+// compareChildren is the children of a span and their profiles, sorted by
+// start time.
+type compareChildren struct {
+	traces []*appdash.Trace
+	profs  []*profile
+}
+
+// newCompareChildren returns the children of the span t, with profile p
+// (either of which may be nil), sorted by start time. Children without a
+// timespan keep their order, before the others.
+func newCompareChildren(t *appdash.Trace, p *profile) *compareChildren {
+	c := &compareChildren{}
+	if t != nil {
+		c.traces = append([]*appdash.Trace(nil), t.Sub...)
+		c.profs = append([]*profile(nil), p.sub...)
+	}
+	sort.Stable(c)
+	return c
+}
+
+func (c *compareChildren) Len() int { return len(c.traces) }
+func (c *compareChildren) Less(i, j int) bool {
+	return c.profs[i].start.Before(c.profs[j].start)
+}
+func (c *compareChildren) Swap(i, j int) {
+	c.traces[i], c.traces[j] = c.traces[j], c.traces[i]
+	c.profs[i], c.profs[j] = c.profs[j], c.profs[i]
+}
+
+// compareSpan is a span in a compareRow.
+type compareSpan struct {
+	ID     appdash.SpanID
+	URL    string
+	Time   int64 // milliseconds
+	Failed bool
+}
+
+// annotationDiff is an annotation that differs between the spans of a
+// compareRow. A and B are its values in each, and InA and InB whether they
+// have it at all.
+type annotationDiff struct {
+	Key      string
+	A, B     string
+	InA, InB bool
+}
+
+// compareTraces returns the rows of the comparison of traces ta and tb. The
+// times of spans are those of their profiles (see calcProfile).
+func (a *App) compareTraces(ta, tb *appdash.Trace) ([]*compareRow, error) {
+	_, pa, err := a.calcProfile(nil, ta)
+	if err != nil {
+		return nil, err
+	}
+	_, pb, err := a.calcProfile(nil, tb)
+	if err != nil {
+		return nil, err
+	}
+	var rows []*compareRow
+	compareSpans(&rows, 0, ta, pa, tb, pb)
+	return rows, nil
+}
+
+// compareSpans appends the rows of the aligned spans ta and tb (either of which
+// may be nil), with profiles pa and pb, and of their descendants to rows.
+func compareSpans(rows *[]*compareRow, depth int, ta *appdash.Trace, pa *profile, tb *appdash.Trace, pb *profile) {
+	row := &compareRow{Depth: depth}
+	if ta != nil {
+		row.Name = compareName(ta, pa)
+		row.A = &compareSpan{ID: ta.Span.ID, URL: pa.URL, Time: pa.Time, Failed: ta.Span.Failed()}
+	}
+	if tb != nil {
+		if ta == nil {
+			row.Name = compareName(tb, pb)
+		}
+		row.B = &compareSpan{ID: tb.Span.ID, URL: pb.URL, Time: pb.Time, Failed: tb.Span.Failed()}
+	}
+	if ta != nil && tb != nil {
+		row.Delta = pb.Time - pa.Time
+		if pa.dur > 0 {
+			row.DeltaPercent = 100 * float64(pb.dur-pa.dur) / float64(pa.dur)
+		}
+		row.Annotations = diffAnnotations(ta.Span.Annotations, tb.Span.Annotations)
+	}
+	*rows = append(*rows, row)
+
+	childrenA, childrenB := newCompareChildren(ta, pa), newCompareChildren(tb, pb)
+	subA, profA := childrenA.traces, childrenA.profs
+	subB, profB := childrenB.traces, childrenB.profs
+
+	// Align the children by name, in the order of A's children and then of
+	// those only B has.
+	matched := make([]bool, len(subB))
+	for i, ca := range subA {
+		name := compareName(ca, profA[i])
+		j := -1
+		for k := range subB {
+			if !matched[k] && compareName(subB[k], profB[k]) == name {
+				j = k
+				break
+			}
+		}
+		if j == -1 {
+			compareSpans(rows, depth+1, ca, profA[i], nil, nil)
+			continue
+		}
+		matched[j] = true
+		compareSpans(rows, depth+1, ca, profA[i], subB[j], profB[j])
+	}
+	for k, cb := range subB {
+		if !matched[k] {
+			compareSpans(rows, depth+1, nil, nil, cb, profB[k])
+		}
+	}
+}
+
+// diffAnnotations returns the annotations that differ between a and b, in the
+// order of a and then of those only b has. Timestamps, which always differ,
+// and annotations that are hidden by filterAnnotations are omitted.
+func diffAnnotations(a, b appdash.Annotations) []annotationDiff {
+	values := func(anns appdash.Annotations) (keys []string, m map[string]string) {
+		m = make(map[string]string)
+		for _, ann := range filterAnnotations(anns) {
+			v := string(ann.Value)
+			if _, err := time.Parse(time.RFC3339Nano, v); err == nil {
+				continue
+			}
+			if _, dup := m[ann.Key]; !dup {
+				keys = append(keys, ann.Key)
+			}
+			m[ann.Key] = v
+		}
+		return keys, m
+	}
+	keysA, valuesA := values(a)
+	keysB, valuesB := values(b)
+
+	var diffs []annotationDiff
+	for _, k := range keysA {
+		vb, inB := valuesB[k]
+		if !inB || vb != valuesA[k] {
+			diffs = append(diffs, annotationDiff{Key: k, A: valuesA[k], B: vb, InA: true, InB: inB})
+		}
+	}
+	for _, k := range keysB {
+		if _, inA := valuesA[k]; !inA {
+			diffs = append(diffs, annotationDiff{Key: k, B: valuesB[k], InB: true})
+		}
+	}
+	return diffs
+}
+
+// compareTrace returns the trace whose ID is the query parameter named name,
+// or its span whose ID is the parameter name + "-span" if it is set.
+func (a *App) compareTrace(q url.Values, name string) (*appdash.Trace, error) {
+	traceID, err := appdash.ParseID(q.Get(name))
+	if err != nil {
+		return nil, badRequest(fmt.Errorf("invalid %s %q", name, q.Get(name)))
+	}
+	t, err := a.Store.Trace(traceID)
+	if err != nil {
+		return nil, err
+	}
+	t = t.CorrectClockSkew()
+	if s := q.Get(name + "-span"); s != "" {
+		spanID, err := appdash.ParseID(s)
+		if err != nil {
+			return nil, badRequest(fmt.Errorf("invalid %s-span %q", name, s))
+		}
+		t = t.FindSpan(spanID)
+		if t == nil {
+			return nil, &apiError{status: http.StatusNotFound, err: fmt.Errorf("could not find span %s in trace %s", spanID, traceID)}
+		}
+	}
+	return t, nil
+}
+
+// serveCompare serves the side-by-side comparison of two traces (or spans of
+// them), given by the a, a-span, b and b-span query parameters. If a or b is
+// not set, it serves a form to choose them.
+func (a *App) serveCompare(w http.ResponseWriter, r *http.Request) error {
+	q := r.URL.Query()
+	data := &struct {
+		TemplateCommon
+		A, ASpan, B, BSpan string
+		Root               *compareRow // the roots of the two traces
+		Rows               []*compareRow
+	}{
+		A:     q.Get("a"),
+		ASpan: q.Get("a-span"),
+		B:     q.Get("b"),
+		BSpan: q.Get("b-span"),
+	}
+	if data.A != "" && data.B != "" {
+		ta, err := a.compareTrace(q, "a")
+		if err != nil {
+			return err
+		}
+		tb, err := a.compareTrace(q, "b")
+		if err != nil {
+			return err
+		}
+		data.Rows, err = a.compareTraces(ta, tb)
+		if err != nil {
+			return err
+		}
+		data.Root = data.Rows[0]
+	}
+	return a.renderTemplate(w, r, "compare.html", http.StatusOK, data)
+}
@@ -0,0 +1,144 @@
+package traceapp
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// compareTestSpan returns a trace of a span with a new ID and the given name (if
+// not empty), which lasts from start to end milliseconds, and children.
+func compareTestSpan(t *testing.T, name string, start, end int, sub ...*appdash.Trace) *appdash.Trace {
+	t0 := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []appdash.Event{appdash.Timespan{
+		S: t0.Add(time.Duration(start) * time.Millisecond),
+		E: t0.Add(time.Duration(end) * time.Millisecond),
+	}}
+	if name != "" {
+		events = append(events, appdash.SpanName(name))
+	}
+	tr := testSpan(t, "", events, sub...)
+	tr.Span.ID = appdash.NewRootSpanID()
+	return tr
+}
+
+// rowStrings returns the rows as "name A-time B-time delta" strings, indented
+// by depth, with "-" for the times of spans that a trace doesn't have.
+func rowStrings(rows []*compareRow) []string {
+	var s []string
+	for _, r := range rows {
+		a, b := "-", "-"
+		if r.A != nil {
+			a = fmt.Sprint(r.A.Time)
+		}
+		if r.B != nil {
+			b = fmt.Sprint(r.B.Time)
+		}
+		s = append(s, fmt.Sprintf("%*s%s %s %s %d", 2*r.Depth, "", r.Name, a, b, r.Delta))
+	}
+	return s
+}
+
+func TestCompareSpans(t *testing.T) {
+	span := compareTestSpan
+	tests := map[string]struct {
+		a, b *appdash.Trace
+		want []string
+	}{
+		"same spans": {
+			a: span(t, "/a", 0, 10, span(t, "query", 1, 3)),
+			b: span(t, "/a", 0, 20, span(t, "query", 1, 5)),
+			want: []string{
+				"/a 10 20 10",
+				"  query 2 4 2",
+			},
+		},
+		"children are aligned in start time order": {
+			a: span(t, "/a", 0, 10,
+				span(t, "query", 5, 8),
+				span(t, "render", 4, 5),
+				span(t, "query", 1, 2),
+			),
+			b: span(t, "/a", 0, 10,
+				span(t, "query", 1, 4),
+				span(t, "query", 6, 10),
+			),
+			want: []string{
+				"/a 10 10 0",
+				"  query 1 3 2",
+				"  render 1 - 0",
+				"  query 3 4 1",
+			},
+		},
+		"spans only one trace has": {
+			a: span(t, "/a", 0, 10, span(t, "cache", 1, 2, span(t, "get", 1, 2))),
+			b: span(t, "/a", 0, 10, span(t, "query", 1, 3)),
+			want: []string{
+				"/a 10 10 0",
+				"  cache 1 - 0",
+				"    get 1 - 0",
+				"  query - 2 0",
+			},
+		},
+		"unnamed spans": {
+			a: span(t, "/a", 0, 10, span(t, "", 1, 2), span(t, "", 3, 5)),
+			b: span(t, "/a", 0, 10, span(t, "", 1, 3)),
+			want: []string{
+				"/a 10 10 0",
+				"  (unnamed) 1 2 1",
+				"  (unnamed) 2 - 0",
+			},
+		},
+	}
+	app, _ := newTestApp(t)
+	for name, test := range tests {
+		rows, err := app.compareTraces(test.a, test.b)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if got := rowStrings(rows); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got rows\n%q\nwant\n%q", name, got, test.want)
+		}
+	}
+}
+
+func TestDiffAnnotations(t *testing.T) {
+	anns := func(kv ...string) appdash.Annotations {
+		var as appdash.Annotations
+		for i := 0; i < len(kv); i += 2 {
+			as = append(as, appdash.Annotation{Key: kv[i], Value: []byte(kv[i+1])})
+		}
+		return as
+	}
+	tests := map[string]struct {
+		a, b appdash.Annotations
+		want []annotationDiff
+	}{
+		"same": {
+			a: anns("k", "v"),
+			b: anns("k", "v"),
+		},
+		"changed, removed and added": {
+			a:    anns("same", "v", "changed", "1", "removed", "x"),
+			b:    anns("added", "y", "changed", "2", "same", "v"),
+			want: []annotationDiff{{Key: "changed", A: "1", B: "2", InA: true, InB: true}, {Key: "removed", A: "x", InA: true}, {Key: "added", B: "y", InB: true}},
+		},
+		"timestamps and hidden annotations are omitted": {
+			a: anns("Client.Send", "2016-01-01T00:00:00Z", "_schema:Timespan", "", "", "x"),
+			b: anns("Client.Send", "2016-01-01T00:00:01Z", "_hidden", "x"),
+		},
+		"the last of repeated keys": {
+			a:    anns("k", "1", "k", "2"),
+			b:    anns("k", "1"),
+			want: []annotationDiff{{Key: "k", A: "2", B: "1", InA: true, InB: true}},
+		},
+	}
+	for name, test := range tests {
+		if got := diffAnnotations(test.a, test.b); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %+v, want %+v", name, got, test.want)
+		}
+	}
+}
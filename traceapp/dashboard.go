@@ -3,21 +3,49 @@ package traceapp
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"strconv"
-	"strings"
 	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
 )
 
 // dashboardRow represents a single row in the dashboard. It is encoded to JSON.
 type dashboardRow struct {
 	Name                      string
 	Average, Min, Max, StdDev time.Duration
+	P50, P90, P95, P99        time.Duration
 	Timespans                 int
 	URL                       string
 }
 
+// groupBy returns the annotation keys that the dashboard offers to group
+// spans by: those of an AggregatingCollector, or else the default ones.
+func (a *App) groupBy() []string {
+	if ac, ok := a.Aggregator.(*appdash.AggregatingCollector); ok {
+		return ac.GroupBy
+	}
+	return appdash.DefaultGroupBy
+}
+
+// groupsBy reports whether the Aggregator can group spans by the annotation
+// key. Only an AggregatingCollector is limited to the keys it was created
+// with.
+func (a *App) groupsBy(key string) bool {
+	ac, ok := a.Aggregator.(*appdash.AggregatingCollector)
+	if !ok {
+		return true
+	}
+	for _, k := range ac.GroupBy {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
 // serverDashboard serves the dashboard page.
 func (a *App) serveDashboard(w http.ResponseWriter, r *http.Request) error {
 	uData, err := a.Router.URLTo(DashboardDataRoute)
@@ -28,8 +56,10 @@ func (a *App) serveDashboard(w http.ResponseWriter, r *http.Request) error {
 	return a.renderTemplate(w, r, "dashboard.html", http.StatusOK, &struct {
 		TemplateCommon
 		DataURL string
+		GroupBy []string
 	}{
 		DataURL: uData.String(),
+		GroupBy: a.groupBy(),
 	})
 }
 
@@ -57,31 +87,37 @@ func (a *App) serveDashboardData(w http.ResponseWriter, r *http.Request) error {
 		end -= 72 * time.Hour
 	}
 
-	results, err := a.Aggregator.Aggregate(start, end)
-	if err != nil {
-		return err
+	if a.Aggregator == nil {
+		return errors.New("the store does not support aggregation")
 	}
-
-	// Grab the URL to the traces page.
-	tracesURL, err := a.Router.URLTo(TracesRoute)
+	results, err := a.Aggregator.Aggregate(appdash.AggregateOpts{
+		Start:    start,
+		End:      end,
+		GroupBy:  query.Get("group-by"),
+		AllSpans: query.Get("all-spans") == "true",
+	})
 	if err != nil {
 		return err
 	}
 
 	rows := make([]*dashboardRow, len(results))
 	for i, r := range results {
-		var stringIDs []string
-		for _, slowest := range r.Slowest {
-			stringIDs = append(stringIDs, slowest.String())
+		// Link to the traces page showing the slowest traces.
+		tracesURL, err := a.slowestTracesURL(r.Slowest)
+		if err != nil {
+			return err
 		}
-		tracesURL.RawQuery = "show=" + strings.Join(stringIDs, ",")
 
 		rows[i] = &dashboardRow{
-			Name:      r.RootSpanName,
+			Name:      r.Group,
 			Average:   r.Average / time.Millisecond,
 			Min:       r.Min / time.Millisecond,
 			Max:       r.Max / time.Millisecond,
 			StdDev:    r.StdDev / time.Millisecond,
+			P50:       r.P50 / time.Millisecond,
+			P90:       r.P90 / time.Millisecond,
+			P95:       r.P95 / time.Millisecond,
+			P99:       r.P99 / time.Millisecond,
 			Timespans: int(r.Samples),
 			URL:       tracesURL.String(),
 		}
@@ -0,0 +1,369 @@
+package traceapp
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+)
+
+// depGraph is the topology of the services that recorded the spans of a set
+// of traces: the services are its nodes, and the calls between them its
+// edges.
+type depGraph struct {
+	Nodes []*depNode `json:"nodes"`
+	Edges []*depEdge `json:"edges"`
+}
+
+// depNode is a service in a depGraph. Spans is the number of spans that it
+// recorded, and Errors how many of them failed.
+type depNode struct {
+	Name      string  `json:"name"`
+	Spans     int64   `json:"spans"`
+	Errors    int64   `json:"errors"`
+	ErrorRate float64 `json:"errorRate"`
+}
+
+// depEdge is the calls from one service to another in a depGraph, and their
+// latency as seen by the caller.
+type depEdge struct {
+	From      string        `json:"from"`
+	To        string        `json:"to"`
+	Calls     int64         `json:"calls"`
+	Errors    int64         `json:"errors"`
+	ErrorRate float64       `json:"errorRate"`
+	Average   time.Duration `json:"average"`
+	P50       time.Duration `json:"p50"`
+	P90       time.Duration `json:"p90"`
+	P99       time.Duration `json:"p99"`
+
+	timed  int64 // the number of calls with a duration
+	total  time.Duration
+	sketch appdash.DurationSketch
+}
+
+// depBuilder builds a depGraph from traces.
+type depBuilder struct {
+	nodes map[string]*depNode
+	edges map[[2]string]*depEdge
+
+	// servers maps the spans in which clients recorded HTTP requests to
+	// the spans in which the servers recorded them, and called holds the
+	// latter, whose calls are added from their clients' spans.
+	servers map[*appdash.Trace]*httpServerSpan
+	called  map[*appdash.Trace]bool
+}
+
+// httpServerSpan is a span in which a server recorded an HTTP request.
+type httpServerSpan struct {
+	t *appdash.Trace
+	e *httptrace.ServerEvent
+}
+
+// dependencies returns the dependency graph of the services that recorded
+// the spans of the given traces.
+//
+// The service of a span is the one that recorded it (see appdash.Resource).
+// The httptrace package records the client and the server of an HTTP
+// request in separate spans, the server's being a child or a sibling of the
+// client's; the call is timed as seen by the client. Other tracers record
+// both in the same span, which then belongs to the service other than that
+// of its parent, the callee. Spans of services without a resource are
+// attributed to the host of their HTTP request, or else to the service of
+// their parent. HTTP requests to servers that aren't traced at all are
+// calls to their hosts.
+func dependencies(traces []*appdash.Trace) (*depGraph, error) {
+	b := &depBuilder{
+		nodes:   make(map[string]*depNode),
+		edges:   make(map[[2]string]*depEdge),
+		servers: make(map[*appdash.Trace]*httpServerSpan),
+		called:  make(map[*appdash.Trace]bool),
+	}
+	for _, t := range traces {
+		if err := b.walk(t, ""); err != nil {
+			return nil, err
+		}
+	}
+	return b.graph(), nil
+}
+
+// walk adds the span of t and its descendants to the graph, given the
+// service of the span's parent (or "" if it has none).
+func (b *depBuilder) walk(t *appdash.Trace, caller string) error {
+	client, server, err := httpEvents(t)
+	if err != nil {
+		return err
+	}
+	if err := b.matchServers(t); err != nil {
+		return err
+	}
+
+	service := spanService(&t.Span, caller, client, server)
+	failed := t.Span.Failed()
+	d := clientDuration(t, client)
+	if service != "" {
+		b.node(service).add(failed)
+		if caller != "" && service != caller && !b.called[t] {
+			b.edge(caller, service).add(d, failed)
+		}
+	}
+	if client != nil && server == nil && service != "" {
+		if srv := b.servers[t]; srv != nil {
+			if callee := spanService(&srv.t.Span, service, nil, srv.e); callee != service {
+				b.edge(service, callee).add(d, failed)
+			}
+		} else if client.Request.Host != "" {
+			// The server isn't traced, so it is known only by its host.
+			b.node(client.Request.Host).add(failed)
+			b.edge(service, client.Request.Host).add(d, failed)
+		}
+	}
+
+	for _, sub := range t.Sub {
+		if err := b.walk(sub, service); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchServers matches the spans among t's children in which clients
+// recorded HTTP requests with the spans in which the servers recorded them:
+// the client span's child or, failing that, the sibling that received the
+// same method and URI in order of time.
+func (b *depBuilder) matchServers(t *appdash.Trace) error {
+	var (
+		clients []*appdash.Trace
+		events  = make(map[*appdash.Trace]*httptrace.ClientEvent)
+		servers []*httpServerSpan // siblings
+	)
+	for _, sub := range t.Sub {
+		client, server, err := httpEvents(sub)
+		if err != nil {
+			return err
+		}
+		switch {
+		case server != nil && client == nil && !b.called[sub]:
+			servers = append(servers, &httpServerSpan{sub, server})
+		case client != nil && server == nil:
+			for _, subsub := range sub.Sub {
+				client2, server2, err := httpEvents(subsub)
+				if err != nil {
+					return err
+				}
+				if server2 != nil && client2 == nil {
+					b.servers[sub] = &httpServerSpan{subsub, server2}
+					b.called[subsub] = true
+					break
+				}
+			}
+			if b.servers[sub] == nil {
+				clients = append(clients, sub)
+				events[sub] = client
+			}
+		}
+	}
+
+	sort.Stable(clientSpansBySend{clients, events})
+	sort.Stable(serverSpansByRecv(servers))
+	for _, c := range clients {
+		e := events[c]
+		for i, srv := range servers {
+			if srv != nil && srv.e.Request.Method == e.Request.Method && srv.e.Request.URI == e.Request.URI {
+				b.servers[c] = srv
+				b.called[srv.t] = true
+				servers[i] = nil
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// httpEvents returns the HTTP client and server events of t's span, which
+// are nil if it has none.
+func httpEvents(t *appdash.Trace) (client *httptrace.ClientEvent, server *httptrace.ServerEvent, err error) {
+	var events []appdash.Event
+	if err := appdash.UnmarshalEvents(t.Span.Annotations, &events); err != nil {
+		return nil, nil, err
+	}
+	for _, e := range events {
+		switch e := e.(type) {
+		case httptrace.ClientEvent:
+			client = &e
+		case httptrace.ServerEvent:
+			server = &e
+		}
+	}
+	return client, server, nil
+}
+
+// clientDuration returns the duration of t's span as seen by the client of
+// its HTTP request, if it has one, or else its duration.
+func clientDuration(t *appdash.Trace, client *httptrace.ClientEvent) time.Duration {
+	if client != nil && !client.ClientSend.IsZero() && !client.ClientRecv.IsZero() {
+		return client.ClientRecv.Sub(client.ClientSend)
+	}
+	_, _, d := spanTimes(t)
+	return d
+}
+
+type clientSpansBySend struct {
+	ts     []*appdash.Trace
+	events map[*appdash.Trace]*httptrace.ClientEvent
+}
+
+func (v clientSpansBySend) Len() int      { return len(v.ts) }
+func (v clientSpansBySend) Swap(i, j int) { v.ts[i], v.ts[j] = v.ts[j], v.ts[i] }
+func (v clientSpansBySend) Less(i, j int) bool {
+	return v.events[v.ts[i]].ClientSend.Before(v.events[v.ts[j]].ClientSend)
+}
+
+type serverSpansByRecv []*httpServerSpan
+
+func (v serverSpansByRecv) Len() int           { return len(v) }
+func (v serverSpansByRecv) Less(i, j int) bool { return v[i].e.ServerRecv.Before(v[j].e.ServerRecv) }
+func (v serverSpansByRecv) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+
+// spanService returns the service of a span whose parent's service is
+// caller, and which has the given HTTP events (which may be nil).
+func spanService(s *appdash.Span, caller string, client *httptrace.ClientEvent, server *httptrace.ServerEvent) string {
+	services := s.Services()
+	for _, svc := range services {
+		if svc != caller {
+			return svc
+		}
+	}
+	if len(services) > 0 {
+		return services[0]
+	}
+	if server != nil {
+		// When the client and server record the same span, the client's
+		// request info is that of the server's host.
+		if client != nil && client.Request.Host != "" {
+			return client.Request.Host
+		}
+		if server.Request.Host != "" {
+			return server.Request.Host
+		}
+	}
+	return caller
+}
+
+func (b *depBuilder) node(name string) *depNode {
+	n, ok := b.nodes[name]
+	if !ok {
+		n = &depNode{Name: name}
+		b.nodes[name] = n
+	}
+	return n
+}
+
+func (b *depBuilder) edge(from, to string) *depEdge {
+	k := [2]string{from, to}
+	e, ok := b.edges[k]
+	if !ok {
+		e = &depEdge{From: from, To: to}
+		b.edges[k] = e
+	}
+	return e
+}
+
+// graph returns the graph, with nodes ordered by name and edges by the
+// names of the services that they connect.
+func (b *depBuilder) graph() *depGraph {
+	g := &depGraph{
+		Nodes: make([]*depNode, 0, len(b.nodes)),
+		Edges: make([]*depEdge, 0, len(b.edges)),
+	}
+	for _, n := range b.nodes {
+		n.ErrorRate = float64(n.Errors) / float64(n.Spans)
+		g.Nodes = append(g.Nodes, n)
+	}
+	for _, e := range b.edges {
+		e.ErrorRate = float64(e.Errors) / float64(e.Calls)
+		if e.timed > 0 {
+			e.Average = e.total / time.Duration(e.timed)
+			e.P50 = e.sketch.Quantile(0.5)
+			e.P90 = e.sketch.Quantile(0.9)
+			e.P99 = e.sketch.Quantile(0.99)
+		}
+		g.Edges = append(g.Edges, e)
+	}
+	sort.Sort(depNodesByName(g.Nodes))
+	sort.Sort(depEdgesByName(g.Edges))
+	return g
+}
+
+func (n *depNode) add(failed bool) {
+	n.Spans++
+	if failed {
+		n.Errors++
+	}
+}
+
+// add adds a call that took d (or zero if its duration is unknown).
+func (e *depEdge) add(d time.Duration, failed bool) {
+	e.Calls++
+	if failed {
+		e.Errors++
+	}
+	if d > 0 {
+		e.timed++
+		e.total += d
+		e.sketch.Add(d)
+	}
+}
+
+type depNodesByName []*depNode
+
+func (v depNodesByName) Len() int           { return len(v) }
+func (v depNodesByName) Less(i, j int) bool { return v[i].Name < v[j].Name }
+func (v depNodesByName) Swap(i, j int)      { v[i], v[j] = v[j], v[i] }
+
+type depEdgesByName []*depEdge
+
+func (v depEdgesByName) Len() int { return len(v) }
+func (v depEdgesByName) Less(i, j int) bool {
+	if v[i].From != v[j].From {
+		return v[i].From < v[j].From
+	}
+	return v[i].To < v[j].To
+}
+func (v depEdgesByName) Swap(i, j int) { v[i], v[j] = v[j], v[i] }
+
+// serveDependencies serves the dependency graph page.
+func (a *App) serveDependencies(w http.ResponseWriter, r *http.Request) error {
+	u, err := a.Router.URLTo(APIDependenciesRoute)
+	if err != nil {
+		return err
+	}
+	return a.renderTemplate(w, r, "dependencies.html", http.StatusOK, &struct {
+		TemplateCommon
+		DataURL string
+	}{
+		DataURL: u.String(),
+	})
+}
+
+// serveAPIDependencies serves the dependency graph of the services that
+// recorded the traces that started between the start and end query
+// parameters, which are durations relative to now (such as "-24h"). They
+// default to the last hour.
+func (a *App) serveAPIDependencies(r *http.Request) (interface{}, error) {
+	start, end, err := parseRelativeWindow(r.URL.Query(), -1*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	traces, err := a.allTraces(appdash.TracesOpts{
+		Timespan: appdash.Timespan{S: now.Add(start), E: now.Add(end)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	correctClockSkew(traces)
+	return dependencies(traces)
+}
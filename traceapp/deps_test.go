@@ -0,0 +1,197 @@
+package traceapp
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+)
+
+// graphStrings returns the nodes and edges of g as "name spans/errors" and
+// "from->to calls/errors" strings.
+func graphStrings(g *depGraph) (nodes, edges []string) {
+	for _, n := range g.Nodes {
+		nodes = append(nodes, fmt.Sprintf("%s %d/%d", n.Name, n.Spans, n.Errors))
+	}
+	for _, e := range g.Edges {
+		edges = append(edges, fmt.Sprintf("%s->%s %d/%d", e.From, e.To, e.Calls, e.Errors))
+	}
+	return nodes, edges
+}
+
+// testSpan returns a trace of a span recorded by the given service (if not
+// empty) with the given events and children.
+func testSpan(t *testing.T, service string, events []appdash.Event, sub ...*appdash.Trace) *appdash.Trace {
+	var as appdash.Annotations
+	if service != "" {
+		as = append(as, appdash.Annotation{Key: appdash.ServiceKey, Value: []byte(service)})
+	}
+	for _, e := range events {
+		anns, err := appdash.MarshalEvent(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		as = append(as, anns...)
+	}
+	return &appdash.Trace{Span: appdash.Span{Annotations: as}, Sub: sub}
+}
+
+func TestDependencies(t *testing.T) {
+	t0 := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := func(host string, code int) httptrace.ClientEvent {
+		return httptrace.ClientEvent{
+			Request:    httptrace.RequestInfo{Method: "GET", URI: "/", Host: host},
+			Response:   httptrace.ResponseInfo{StatusCode: code},
+			ClientSend: t0,
+			ClientRecv: t0.Add(100 * time.Millisecond),
+		}
+	}
+	server := func(host string, code int) httptrace.ServerEvent {
+		return httptrace.ServerEvent{
+			Request:    httptrace.RequestInfo{Method: "GET", URI: "/", Host: host},
+			Response:   httptrace.ResponseInfo{StatusCode: code},
+			ServerRecv: t0.Add(10 * time.Millisecond),
+			ServerSend: t0.Add(90 * time.Millisecond),
+		}
+	}
+	db := appdash.Timespan{S: t0, E: t0.Add(time.Millisecond)}
+
+	tests := map[string]struct {
+		trace        *appdash.Trace
+		nodes, edges []string
+	}{
+		"server span is the client span's child": {
+			trace: testSpan(t, "frontend", nil,
+				testSpan(t, "frontend", []appdash.Event{client("backend:80", 200)},
+					testSpan(t, "backend", []appdash.Event{server("backend:80", 200)}),
+				),
+			),
+			nodes: []string{"backend 1/0", "frontend 2/0"},
+			edges: []string{"frontend->backend 1/0"},
+		},
+		"server span is the client span's sibling": {
+			trace: testSpan(t, "frontend", nil,
+				testSpan(t, "backend", []appdash.Event{server("backend:80", 500)}),
+				testSpan(t, "frontend", []appdash.Event{client("backend:80", 500)}),
+			),
+			nodes: []string{"backend 1/1", "frontend 2/1"},
+			edges: []string{"frontend->backend 1/1"},
+		},
+		"client and server record the same span": {
+			trace: testSpan(t, "frontend", nil,
+				testSpan(t, "", []appdash.Event{client("backend:80", 200), server("backend:80", 200)}),
+			),
+			nodes: []string{"backend:80 1/0", "frontend 1/0"},
+			edges: []string{"frontend->backend:80 1/0"},
+		},
+		"spans without resources": {
+			trace: testSpan(t, "", []appdash.Event{server("frontend:80", 200)},
+				testSpan(t, "", []appdash.Event{client("backend:80", 200)},
+					testSpan(t, "", []appdash.Event{server("backend:80", 200)},
+						testSpan(t, "", []appdash.Event{db}),
+					),
+				),
+			),
+			nodes: []string{"backend:80 2/0", "frontend:80 2/0"},
+			edges: []string{"frontend:80->backend:80 1/0"},
+		},
+		"untraced server": {
+			trace: testSpan(t, "frontend", nil,
+				testSpan(t, "frontend", []appdash.Event{client("example.com", -1)}),
+			),
+			nodes: []string{"example.com 1/1", "frontend 2/1"},
+			edges: []string{"frontend->example.com 1/1"},
+		},
+		"span recorded by another service": {
+			trace: testSpan(t, "frontend", nil,
+				testSpan(t, "postgres", []appdash.Event{db}),
+			),
+			nodes: []string{"frontend 1/0", "postgres 1/0"},
+			edges: []string{"frontend->postgres 1/0"},
+		},
+	}
+	for name, test := range tests {
+		g, err := dependencies([]*appdash.Trace{test.trace})
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		nodes, edges := graphStrings(g)
+		if !reflect.DeepEqual(nodes, test.nodes) {
+			t.Errorf("%s: got nodes %q, want %q", name, nodes, test.nodes)
+		}
+		if !reflect.DeepEqual(edges, test.edges) {
+			t.Errorf("%s: got edges %q, want %q", name, edges, test.edges)
+		}
+		for _, e := range g.Edges {
+			if e.Average != 100*time.Millisecond && e.To != "postgres" {
+				t.Errorf("%s: got edge %s->%s latency %s, want the client's 100ms", name, e.From, e.To, e.Average)
+			}
+		}
+	}
+}
+
+func TestDependencies_httptrace(t *testing.T) {
+	tests := map[string][]httptrace.Propagator{
+		"Span-ID header":         nil,
+		"SpanIDPropagator":       {httptrace.SpanIDPropagator{}},
+		"TraceContextPropagator": {httptrace.TraceContextPropagator{}},
+	}
+	for name, props := range tests {
+		ms := appdash.NewMemoryStore()
+
+		// frontend calls backend, which calls a server that isn't traced.
+		untraced := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		backendCollector := appdash.NewResourceCollector(ms, &appdash.Resource{Service: "backend"})
+		mw := httptrace.Middleware(backendCollector, &httptrace.MiddlewareConfig{Propagators: props})
+		backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mw(w, r, func(w http.ResponseWriter, r *http.Request) {
+				client := &http.Client{Transport: &httptrace.Transport{Propagators: props}}
+				req, _ := http.NewRequest("GET", untraced.URL, nil)
+				resp, err := client.Do(req.WithContext(r.Context()))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				resp.Body.Close()
+			})
+		}))
+
+		root := appdash.NewRootSpanID()
+		rec := appdash.NewRecorder(root, appdash.NewResourceCollector(ms, &appdash.Resource{Service: "frontend"}))
+		client := &http.Client{Transport: &httptrace.Transport{Recorder: rec, Propagators: props}}
+		resp, err := client.Get(backend.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		rec.Name("frontend")
+		rec.Finish()
+		backend.Close()
+		untraced.Close()
+
+		tr, err := ms.Trace(root.Trace)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g, err := dependencies([]*appdash.Trace{tr})
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, _ := url.Parse(untraced.URL)
+		nodes, edges := graphStrings(g)
+		wantNodes := []string{u.Host + " 1/0", "backend 2/0", "frontend 2/0"}
+		wantEdges := []string{"backend->" + u.Host + " 1/0", "frontend->backend 1/0"}
+		if !reflect.DeepEqual(nodes, wantNodes) {
+			t.Errorf("%s: got nodes %q, want %q", name, nodes, wantNodes)
+		}
+		if !reflect.DeepEqual(edges, wantEdges) {
+			t.Errorf("%s: got edges %q, want %q", name, edges, wantEdges)
+		}
+	}
+}
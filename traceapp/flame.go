@@ -0,0 +1,194 @@
+package traceapp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// flameNode is a frame of a flame graph: a span name, beneath the names of
+// its ancestors. Self is the time spent in the span itself, not in its
+// children, and Value is the time including them. Both are in microseconds.
+type flameNode struct {
+	Name     string       `json:"name"`
+	Value    int64        `json:"value"`
+	Self     int64        `json:"self"`
+	Children []*flameNode `json:"children,omitempty"`
+}
+
+// flameTree returns the flame graph of the span with the given profile (see
+// calcProfile). Children with the same name are merged. As children may run
+// concurrently, Value may exceed the duration of the span.
+func flameTree(p *profile) *flameNode {
+	n := &flameNode{Name: p.Name}
+	self := p.dur
+	for _, c := range p.sub {
+		self -= c.dur
+		n.add(flameTree(c))
+	}
+	if self > 0 {
+		n.Self = int64(self / time.Microsecond)
+		n.Value += n.Self
+	}
+	return n
+}
+
+// add adds c as a child of n, merging it with the child of the same name if
+// there is one.
+func (n *flameNode) add(c *flameNode) {
+	n.Value += c.Value
+	for _, existing := range n.Children {
+		if existing.Name == c.Name {
+			existing.merge(c)
+			return
+		}
+	}
+	n.Children = append(n.Children, c)
+}
+
+// merge adds the times of o, which has the same name as n, to n.
+func (n *flameNode) merge(o *flameNode) {
+	n.Self += o.Self
+	n.Value += o.Self
+	for _, c := range o.Children {
+		n.add(c)
+	}
+}
+
+// foldedFrameReplacer replaces the characters that separate frames and
+// stacks in the folded stacks format.
+var foldedFrameReplacer = strings.NewReplacer(";", ":", "\n", " ", "\r", " ")
+
+// writeFolded writes the flame graph in the folded stacks format read by
+// flame graph tools (such as flamegraph.pl): a line for each stack of span
+// names with its self time in microseconds, such as "/a;query 1200".
+func (n *flameNode) writeFolded(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	n.writeFoldedStack(bw, "")
+	return bw.Flush()
+}
+
+func (n *flameNode) writeFoldedStack(w *bufio.Writer, parent string) {
+	stack := foldedFrameReplacer.Replace(n.Name)
+	if parent != "" {
+		stack = parent + ";" + stack
+	}
+	if n.Self > 0 {
+		fmt.Fprintf(w, "%s %d\n", stack, n.Self)
+	}
+	for _, c := range n.Children {
+		c.writeFoldedStack(w, stack)
+	}
+}
+
+// traceFlame returns the flame graph of t.
+func (a *App) traceFlame(t *appdash.Trace) (*flameNode, error) {
+	_, p, err := a.calcProfile(nil, t)
+	if err != nil {
+		return nil, err
+	}
+	return flameTree(p), nil
+}
+
+// mergedFlame returns the flame graph of the given traces merged together.
+// If they have different root span names, their roots are the children of
+// a root named "all".
+func (a *App) mergedFlame(traces []*appdash.Trace) (*flameNode, error) {
+	all := &flameNode{Name: "all"}
+	for _, t := range traces {
+		n, err := a.traceFlame(t)
+		if err != nil {
+			return nil, err
+		}
+		all.add(n)
+	}
+	if len(all.Children) == 1 {
+		return all.Children[0], nil
+	}
+	return all, nil
+}
+
+// folded writes the flame graph of t in the folded stacks format.
+func (a *App) folded(t *appdash.Trace, w http.ResponseWriter) error {
+	n, err := a.traceFlame(t)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	return n.writeFolded(w)
+}
+
+// flameWindow returns the traces whose root span has the name query
+// parameter (or any name, if it is empty), and that started between the
+// start and end query parameters. They are durations relative to now (such
+// as "-24h"), and default to the last hour. Their clock skew is corrected.
+func (a *App) flameWindow(r *http.Request) ([]*appdash.Trace, error) {
+	q := r.URL.Query()
+	start, end, err := parseRelativeWindow(q, -1*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	traces, err := a.allTraces(appdash.TracesOpts{
+		Name:     q.Get("name"),
+		Timespan: appdash.Timespan{S: now.Add(start), E: now.Add(end)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	correctClockSkew(traces)
+	return traces, nil
+}
+
+// serveFlame serves the page of the flame graph of the traces selected by
+// flameWindow, merged together.
+func (a *App) serveFlame(w http.ResponseWriter, r *http.Request) error {
+	traces, err := a.flameWindow(r)
+	if err != nil {
+		return err
+	}
+	flame, err := a.mergedFlame(traces)
+	if err != nil {
+		return err
+	}
+	folded, err := a.Router.URLTo(FlameFoldedRoute)
+	if err != nil {
+		return err
+	}
+	folded.RawQuery = r.URL.RawQuery
+
+	q := r.URL.Query()
+	return a.renderTemplate(w, r, "flame.html", http.StatusOK, &struct {
+		TemplateCommon
+		Name, Start string
+		Traces      int
+		Flame       *flameNode
+		FoldedURL   string
+	}{
+		Name:      q.Get("name"),
+		Start:     q.Get("start"),
+		Traces:    len(traces),
+		Flame:     flame,
+		FoldedURL: folded.String(),
+	})
+}
+
+// serveFlameFolded serves the flame graph of serveFlame in the folded stacks
+// format.
+func (a *App) serveFlameFolded(w http.ResponseWriter, r *http.Request) error {
+	traces, err := a.flameWindow(r)
+	if err != nil {
+		return err
+	}
+	flame, err := a.mergedFlame(traces)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	return flame.writeFolded(w)
+}
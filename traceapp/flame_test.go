@@ -0,0 +1,84 @@
+package traceapp
+
+import (
+	"bytes"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFlameTree(t *testing.T) {
+	ms := time.Millisecond
+	tests := map[string]struct {
+		profile *profile
+		want    *flameNode
+		folded  string
+	}{
+		"leaf": {
+			profile: &profile{Name: "a", dur: 2 * ms},
+			want:    &flameNode{Name: "a", Value: 2000, Self: 2000},
+			folded:  "a 2000\n",
+		},
+		"children with the same name are merged": {
+			profile: &profile{Name: "/a", dur: 10 * ms, sub: []*profile{
+				{Name: "query", dur: 3 * ms},
+				{Name: "render", dur: 2 * ms, sub: []*profile{{Name: "query", dur: ms}}},
+				{Name: "query", dur: 4 * ms},
+			}},
+			want: &flameNode{Name: "/a", Value: 10000, Self: 1000, Children: []*flameNode{
+				{Name: "query", Value: 7000, Self: 7000},
+				{Name: "render", Value: 2000, Self: 1000, Children: []*flameNode{
+					{Name: "query", Value: 1000, Self: 1000},
+				}},
+			}},
+			folded: "/a 1000\n/a;query 7000\n/a;render 1000\n/a;render;query 1000\n",
+		},
+		"concurrent children": {
+			profile: &profile{Name: "a", dur: 2 * ms, sub: []*profile{
+				{Name: "b", dur: 2 * ms},
+				{Name: "c", dur: 2 * ms},
+			}},
+			want: &flameNode{Name: "a", Value: 4000, Children: []*flameNode{
+				{Name: "b", Value: 2000, Self: 2000},
+				{Name: "c", Value: 2000, Self: 2000},
+			}},
+			folded: "a;b 2000\na;c 2000\n",
+		},
+		"separators in names": {
+			profile: &profile{Name: "a;b\nc", dur: ms},
+			want:    &flameNode{Name: "a;b\nc", Value: 1000, Self: 1000},
+			folded:  "a:b c 1000\n",
+		},
+	}
+	for name, test := range tests {
+		got := flameTree(test.profile)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got %+v, want %+v", name, got, test.want)
+		}
+		var buf bytes.Buffer
+		if err := got.writeFolded(&buf); err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if buf.String() != test.folded {
+			t.Errorf("%s: got folded stacks %q, want %q", name, buf.String(), test.folded)
+		}
+	}
+}
+
+func TestApp_serveFlame_statusCodes(t *testing.T) {
+	app, ms := newTestApp(t)
+	collectNamed(t, ms, "a", 1)
+
+	tests := map[string]int{
+		"/flame/folded":                   http.StatusOK,
+		"/flame/folded?name=a&start=-48h": http.StatusOK,
+		"/flame/folded?start=yesterday":   http.StatusBadRequest,
+		"/flame?end=now":                  http.StatusBadRequest,
+	}
+	for path, want := range tests {
+		if w := serve(app, "GET", path, nil); w.Code != want {
+			t.Errorf("GET %s: got status %d, want %d (body %q)", path, w.Code, want, w.Body)
+		}
+	}
+}
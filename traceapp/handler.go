@@ -5,6 +5,8 @@ import (
 	"log"
 	"net/http"
 	"runtime/debug"
+
+	"sourcegraph.com/sourcegraph/appdash"
 )
 
 type handlerFunc func(http.ResponseWriter, *http.Request) error
@@ -28,10 +30,18 @@ func (h handlerFunc) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 }
 
 func handleError(w http.ResponseWriter, r *http.Request, err error) {
-	log.Printf("%s %s: error: %s", r.Method, r.URL.RequestURI(), err.Error())
+	status := http.StatusInternalServerError
+	if err == appdash.ErrTraceNotFound {
+		status = http.StatusNotFound
+	} else if e, ok := err.(*apiError); ok {
+		status = e.status
+	}
+	if status == http.StatusInternalServerError {
+		log.Printf("%s %s: error: %s", r.Method, r.URL.RequestURI(), err.Error())
+	}
 
 	// Never cache error responses.
 	w.Header().Set("cache-control", "no-cache, max-age=0")
 
-	http.Error(w, err.Error(), http.StatusInternalServerError)
+	http.Error(w, err.Error(), status)
 }
@@ -14,6 +14,15 @@ type profile struct {
 	Name                        string
 	URL                         string
 	Time, TimeChildren, TimeCum int64
+
+	// TimeCritical is the time the span was on the critical path of the
+	// trace (see appdash.Trace.CriticalPath). It is only set by profile.
+	TimeCritical int64
+
+	span  appdash.SpanID
+	dur   time.Duration // Time, unrounded
+	start time.Time     // the start of the timespan of dur
+	sub   []*profile    // the profiles of the span's children
 }
 
 // calcProfile calculates a profile for the given trace and appends it to the
@@ -46,6 +55,7 @@ func (a *App) calcProfile(buf []*profile, t *appdash.Trace) (prof []*profile, ch
 	p := &profile{
 		Name: t.Span.Name(),
 		URL:  u.String(),
+		span: t.Span.ID,
 	}
 	if len(p.Name) == 0 {
 		p.Name = t.Span.ID.Span.String()
@@ -58,11 +68,9 @@ func (a *App) calcProfile(buf []*profile, t *appdash.Trace) (prof []*profile, ch
 		if !ok {
 			continue
 		}
-		// To match the timeline properly we use floats and round up.
-		msf := float64(ts.End().Sub(ts.Start())) / float64(time.Millisecond)
-		ms := int64(msf + 0.5)
-		if ms > p.Time {
-			p.Time = ms
+		if d := ts.End().Sub(ts.Start()); d > p.dur {
+			p.dur, p.start = d, ts.Start()
+			p.Time = msRound(d)
 		}
 	}
 
@@ -80,6 +88,8 @@ func (a *App) calcProfile(buf []*profile, t *appdash.Trace) (prof []*profile, ch
 			return nil, nil, err
 		}
 
+		p.sub = append(p.sub, childProf)
+
 		// Aggregate our direct children's time.
 		p.TimeChildren += childProf.Time
 
@@ -98,6 +108,13 @@ func (a *App) profile(t *appdash.Trace, out io.Writer) error {
 	if err != nil {
 		return err
 	}
+	critical, err := t.CriticalPathTimes()
+	if err != nil {
+		return err
+	}
+	for _, p := range prof {
+		p.TimeCritical = msRound(critical[p.span])
+	}
 
 	// Encode to JSON.
 	j, err := json.Marshal(prof)
@@ -109,3 +126,9 @@ func (a *App) profile(t *appdash.Trace, out io.Writer) error {
 	_, err = io.Copy(out, bytes.NewReader(j))
 	return err
 }
+
+// msRound returns d in milliseconds. To match the timeline properly we use
+// floats and round up.
+func msRound(d time.Duration) int64 {
+	return int64(float64(d)/float64(time.Millisecond) + 0.5)
+}
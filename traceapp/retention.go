@@ -0,0 +1,218 @@
+package traceapp
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// apiDeleteResult lists the traces deleted by a request. Pinned lists the
+// traces that matched a purge but were kept because they are pinned.
+type apiDeleteResult struct {
+	Deleted []appdash.ID `json:"deleted"`
+	Pinned  []appdash.ID `json:"pinned,omitempty"`
+}
+
+// apiPinResult is whether a trace is pinned after a request.
+type apiPinResult struct {
+	TraceID appdash.ID `json:"traceId"`
+	Pinned  bool       `json:"pinned"`
+}
+
+// allowChange wraps a handler that changes the store, rejecting all requests
+// unless a.AllowChanges is set, and requests made from pages of other sites.
+// Browsers send HTTP Basic auth credentials with such requests, so otherwise
+// any site a user visits could delete their traces.
+func (a *App) allowChange(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.AllowChanges {
+			writeAPIError(w, r, &apiError{status: http.StatusForbidden, err: errors.New("deleting and pinning traces is not enabled")})
+			return
+		}
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			origin = r.Header.Get("Referer")
+		}
+		if origin != "" {
+			u, err := url.Parse(origin)
+			if err != nil || (u.Host != r.Host && u.Host != a.baseURL.Host) {
+				writeAPIError(w, r, &apiError{status: http.StatusForbidden, err: errors.New("cross-origin request refused")})
+				return
+			}
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// canDelete reports whether users can delete traces.
+func (a *App) canDelete() bool {
+	_, ok := a.Store.(appdash.DeleteStore)
+	return ok && a.AllowChanges
+}
+
+// canPin reports whether users can pin and unpin traces.
+func (a *App) canPin() bool {
+	return a.Pins != nil && a.AllowChanges
+}
+
+// deleteStore returns the store as a DeleteStore, or an error if it can't
+// delete traces.
+func (a *App) deleteStore() (appdash.DeleteStore, error) {
+	ds, ok := a.Store.(appdash.DeleteStore)
+	if !ok {
+		return nil, &apiError{status: http.StatusNotImplemented, err: errors.New("the store does not support deleting traces")}
+	}
+	return ds, nil
+}
+
+// serveAPIDeleteTrace deletes a trace, whether or not it is pinned, and
+// unpins it.
+func (a *App) serveAPIDeleteTrace(r *http.Request) (interface{}, error) {
+	ds, err := a.deleteStore()
+	if err != nil {
+		return nil, err
+	}
+	trace, err := a.apiTrace(r)
+	if err != nil {
+		return nil, err
+	}
+	id := trace.ID.Trace
+	if err := ds.Delete(id); err != nil {
+		return nil, err
+	}
+	if a.Pins != nil {
+		if err := a.Pins.Unpin(id); err != nil {
+			return nil, err
+		}
+	}
+	return &apiDeleteResult{Deleted: []appdash.ID{id}}, nil
+}
+
+// serveAPIPinTrace pins (for PUT requests) or unpins (for DELETE requests) a
+// trace.
+func (a *App) serveAPIPinTrace(r *http.Request) (interface{}, error) {
+	if a.Pins == nil {
+		return nil, &apiError{status: http.StatusNotImplemented, err: errors.New("pinning traces is not enabled")}
+	}
+	trace, err := a.apiTrace(r)
+	if err != nil {
+		return nil, err
+	}
+	id := trace.ID.Trace
+	if r.Method == "DELETE" {
+		err = a.Pins.Unpin(id)
+	} else {
+		err = a.Pins.Pin(id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &apiPinResult{TraceID: id, Pinned: a.Pins.Pinned(id)}, nil
+}
+
+// serveAPIPurge deletes the traces that match all of the given form values,
+// of which at least one is required, except for pinned traces:
+//
+//	name=<name>   root span name
+//	start=<time>  traces that started at or after the time
+//	end=<time>    traces that started at or before the time
+//
+// Times are either RFC 3339 times or durations relative to now, such as
+// "-24h". Traces without a timespan event never match start and end.
+func (a *App) serveAPIPurge(r *http.Request) (interface{}, error) {
+	ds, err := a.deleteStore()
+	if err != nil {
+		return nil, err
+	}
+	opts := appdash.TracesOpts{Name: r.FormValue("name")}
+	now := time.Now()
+	for _, v := range []struct {
+		name string
+		dst  *time.Time
+	}{{"start", &opts.Timespan.S}, {"end", &opts.Timespan.E}} {
+		s := r.FormValue(v.name)
+		if s == "" {
+			continue
+		}
+		if *v.dst, err = parseTime(s, now); err != nil {
+			return nil, badRequest(fmt.Errorf("invalid %s %q", v.name, s))
+		}
+	}
+	if opts.Name == "" && opts.Timespan == (appdash.Timespan{}) {
+		return nil, badRequest(errors.New("a name, start or end is required"))
+	}
+
+	// Queryers may return only a page of the matching traces (e.g.
+	// InfluxDBStore), so query them until no more are left. The pinned
+	// traces, which remain, are skipped with the offset.
+	res := &apiDeleteResult{Deleted: []appdash.ID{}}
+	seen := make(map[appdash.ID]bool)
+	for {
+		opts.Offset = len(res.Pinned)
+		traces, err := a.Queryer.Traces(opts)
+		if err != nil {
+			return nil, err
+		}
+		var deleted []appdash.ID
+		progress := false
+		for _, t := range traces {
+			id := t.ID.Trace
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			progress = true
+			if a.Pins.Pinned(id) {
+				res.Pinned = append(res.Pinned, id)
+			} else {
+				deleted = append(deleted, id)
+			}
+		}
+		if len(deleted) > 0 {
+			if err := ds.Delete(deleted...); err != nil {
+				return nil, err
+			}
+			res.Deleted = append(res.Deleted, deleted...)
+		}
+		if !progress {
+			return res, nil
+		}
+	}
+}
+
+// restrictToPinned restricts opts to the pinned traces. It returns false if
+// no trace can match.
+func (a *App) restrictToPinned(opts *appdash.TracesOpts) bool {
+	var pinned []appdash.ID
+	if a.Pins != nil {
+		pinned = a.Pins.List()
+	}
+	if len(opts.TraceIDs) > 0 {
+		var ids []appdash.ID
+		for _, id := range opts.TraceIDs {
+			if a.Pins.Pinned(id) {
+				ids = append(ids, id)
+			}
+		}
+		pinned = ids
+	}
+	opts.TraceIDs = pinned
+	return len(pinned) > 0
+}
+
+// parseTime parses s as either an RFC 3339 time or a duration relative to
+// now.
+func parseTime(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return now.Add(d), nil
+}
@@ -0,0 +1,181 @@
+package traceapp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// newTestApp returns an App that serves the traces of a new MemoryStore.
+func newTestApp(t *testing.T) (*App, *appdash.MemoryStore) {
+	app, err := New(nil, &url.URL{Scheme: "http", Host: "example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms := appdash.NewMemoryStore()
+	app.Store, app.Queryer = ms, ms
+	return app, ms
+}
+
+// collectNamed collects root spans with the given name for each trace.
+func collectNamed(t *testing.T, c appdash.Collector, name string, traces ...appdash.ID) {
+	anns, err := appdash.MarshalEvent(appdash.SpanName(name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range traces {
+		if err := c.Collect(appdash.SpanID{Trace: id, Span: id}, anns...); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// serve serves a request to h, with the given form values (if any) in the
+// request body.
+func serve(h http.Handler, method, path string, form url.Values) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "http://example.com"+path, strings.NewReader(form.Encode()))
+	if form != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	return w
+}
+
+// storedTraceIDs returns the IDs of the traces in ms, in ascending order.
+func storedTraceIDs(t *testing.T, ms *appdash.MemoryStore) []appdash.ID {
+	traces, err := ms.Traces(appdash.TracesOpts{Order: appdash.OrderOldest})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := []appdash.ID{}
+	for _, tr := range traces {
+		ids = append(ids, tr.ID.Trace)
+	}
+	sortIDs(ids)
+	return ids
+}
+
+func sortIDs(ids []appdash.ID) {
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+}
+
+func TestRetention_statusCodes(t *testing.T) {
+	app, ms := newTestApp(t)
+	collectNamed(t, ms, "a", 1, 2)
+
+	// Changes are refused unless allowed.
+	if w := serve(app, "DELETE", "/api/v1/traces/1", nil); w.Code != http.StatusForbidden {
+		t.Errorf("DELETE without AllowChanges: got status %d, want %d", w.Code, http.StatusForbidden)
+	}
+	app.AllowChanges = true
+
+	tests := []struct {
+		method, path string
+		form         url.Values
+		origin       string
+		want         int
+	}{
+		{"DELETE", "/api/v1/traces/1", nil, "http://evil.example.org", http.StatusForbidden},
+		{"DELETE", "/api/v1/traces/1", nil, "http://example.com/traces", http.StatusOK},
+		{"DELETE", "/api/v1/traces/1", nil, "", http.StatusNotFound},
+		{"DELETE", "/api/v1/traces/x", nil, "", http.StatusBadRequest},
+		{"PUT", "/api/v1/traces/2/pin", nil, "", http.StatusNotImplemented}, // no Pins
+		{"POST", "/api/v1/purge", url.Values{}, "", http.StatusBadRequest},
+		{"POST", "/api/v1/purge", url.Values{"start": {"yesterday"}}, "", http.StatusBadRequest},
+		{"POST", "/api/v1/purge", url.Values{"name": {"b"}}, "", http.StatusOK},
+	}
+	for _, test := range tests {
+		req := httptest.NewRequest(test.method, "http://example.com"+test.path, strings.NewReader(test.form.Encode()))
+		if test.form != nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		if test.origin != "" {
+			req.Header.Set("Origin", test.origin)
+		}
+		w := httptest.NewRecorder()
+		app.ServeHTTP(w, req)
+		if w.Code != test.want {
+			t.Errorf("%s %s (Origin %q): got status %d, want %d (body %q)", test.method, test.path, test.origin, w.Code, test.want, w.Body)
+		}
+	}
+	if got, want := storedTraceIDs(t, ms), []appdash.ID{2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+}
+
+func TestRetention_pin(t *testing.T) {
+	app, ms := newTestApp(t)
+	app.AllowChanges = true
+	app.Pins = new(appdash.Pins)
+	collectNamed(t, ms, "a", 1)
+
+	for _, test := range []struct {
+		method string
+		want   bool
+	}{{"PUT", true}, {"DELETE", false}} {
+		w := serve(app, test.method, "/api/v1/traces/1/pin", nil)
+		var res apiPinResult
+		if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+			t.Fatalf("%s: %s (body %q)", test.method, err, w.Body)
+		}
+		if res.Pinned != test.want || app.Pins.Pinned(1) != test.want {
+			t.Errorf("%s: got pinned %v (in Pins %v), want %v", test.method, res.Pinned, app.Pins.Pinned(1), test.want)
+		}
+	}
+
+	// Deleting a pinned trace unpins it.
+	app.Pins.Pin(1)
+	if w := serve(app, "DELETE", "/api/v1/traces/1", nil); w.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", w.Code, http.StatusOK)
+	}
+	if app.Pins.Pinned(1) {
+		t.Error("got deleted trace pinned")
+	}
+}
+
+// pagingQueryer is a Queryer that returns at most max traces per query, as
+// InfluxDBStore does.
+type pagingQueryer struct {
+	appdash.Queryer
+	max int
+}
+
+func (q pagingQueryer) Traces(opts appdash.TracesOpts) ([]*appdash.Trace, error) {
+	if opts.Limit == 0 || opts.Limit > q.max {
+		opts.Limit = q.max
+	}
+	return q.Queryer.Traces(opts)
+}
+
+func TestRetention_purge(t *testing.T) {
+	app, ms := newTestApp(t)
+	app.AllowChanges = true
+	app.Pins = new(appdash.Pins)
+	app.Queryer = pagingQueryer{ms, 2}
+	collectNamed(t, ms, "a", 1, 2, 3, 4, 5, 6, 7)
+	collectNamed(t, ms, "b", 8)
+	app.Pins.Pin(2, 3, 6)
+
+	w := serve(app, "POST", "/api/v1/purge", url.Values{"name": {"a"}})
+	var res apiDeleteResult
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatalf("%s (body %q)", err, w.Body)
+	}
+	sortIDs(res.Deleted)
+	sortIDs(res.Pinned)
+	want := apiDeleteResult{Deleted: []appdash.ID{1, 4, 5, 7}, Pinned: []appdash.ID{2, 3, 6}}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("got result %+v, want %+v", res, want)
+	}
+	if got, want := storedTraceIDs(t, ms), []appdash.ID{2, 3, 6, 8}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got traces %v, want %v", got, want)
+	}
+}
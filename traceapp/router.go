@@ -16,11 +16,30 @@ const (
 	TraceSpanRoute        = "traceapp.trace.span"         // route name for a single trace sub-span page
 	TraceProfileRoute     = "traceapp.trace.profile"      // route name for a JSON trace profile
 	TraceSpanProfileRoute = "traceapp.trace.span.profile" // route name for a JSON trace sub-span profile
+	TraceFoldedRoute      = "traceapp.trace.folded"       // route name for a trace's folded stacks
+	TraceSpanFoldedRoute  = "traceapp.trace.span.folded"  // route name for a trace sub-span's folded stacks
 	TraceUploadRoute      = "traceapp.trace.upload"       // route name for a JSON trace upload
 	TracesRoute           = "traceapp.traces"             // route name for traces page
 	DashboardRoute        = "traceapp.dashboard"          // route name for dashboard page
 	DashboardDataRoute    = "traceapp.dashboard.data"     // route name for dashboard JSON data
 	AggregateRoute        = "traceapp.aggregate"          // route name for aggregate trace view
+	DependenciesRoute     = "traceapp.dependencies"       // route name for the service dependency graph page
+	FlameRoute            = "traceapp.flame"              // route name for the merged flame graph page
+	FlameFoldedRoute      = "traceapp.flame.folded"       // route name for the merged flame graph's folded stacks
+	CompareRoute          = "traceapp.compare"            // route name for the trace comparison page
+
+	APITracesRoute    = "traceapp.api.traces"    // route name for the JSON API trace list
+	APITraceRoute     = "traceapp.api.trace"     // route name for the JSON API trace tree
+	APISpanRoute      = "traceapp.api.span"      // route name for the JSON API span
+	APIAggregateRoute = "traceapp.api.aggregate" // route name for the JSON API aggregates
+	APIDashboardRoute = "traceapp.api.dashboard" // route name for the JSON API dashboard rows
+
+	APIDependenciesRoute = "traceapp.api.dependencies" // route name for the JSON API service dependency graph
+
+	APIDeleteTraceRoute = "traceapp.api.trace.delete" // route name for deleting a trace
+	APIPinTraceRoute    = "traceapp.api.trace.pin"    // route name for pinning a trace
+	APIUnpinTraceRoute  = "traceapp.api.trace.unpin"  // route name for unpinning a trace
+	APIPurgeRoute       = "traceapp.api.purge"        // route name for purging traces
 )
 
 // Router is a URL router for traceapp applications. It should be created via
@@ -37,12 +56,28 @@ func NewRouter(base *mux.Router) *Router {
 	base.Path("/traces/{Trace}").Methods("GET").Name(TraceRoute)
 	base.Path("/traces/{Trace}/profile").Methods("GET").Name(TraceProfileRoute)
 	base.Path("/traces/{Trace}/{Span}/profile").Methods("GET").Name(TraceSpanProfileRoute)
+	base.Path("/traces/{Trace}/folded").Methods("GET").Name(TraceFoldedRoute)
+	base.Path("/traces/{Trace}/{Span}/folded").Methods("GET").Name(TraceSpanFoldedRoute)
 	base.Path("/traces/upload").Methods("POST").Name(TraceUploadRoute)
 	base.Path("/traces/{Trace}/{Span}").Methods("GET").Name(TraceSpanRoute)
 	base.Path("/traces").Methods("GET").Name(TracesRoute)
 	base.Path("/dashboard").Methods("GET").Name(DashboardRoute)
 	base.Path("/dashboard/data").Methods("GET").Name(DashboardDataRoute)
 	base.Path("/aggregate").Methods("GET").Name(AggregateRoute)
+	base.Path("/dependencies").Methods("GET").Name(DependenciesRoute)
+	base.Path("/flame").Methods("GET").Name(FlameRoute)
+	base.Path("/flame/folded").Methods("GET").Name(FlameFoldedRoute)
+	base.Path("/compare").Methods("GET").Name(CompareRoute)
+	base.Path("/api/v1/traces").Methods("GET").Name(APITracesRoute)
+	base.Path("/api/v1/traces/{Trace}").Methods("GET").Name(APITraceRoute)
+	base.Path("/api/v1/traces/{Trace}/spans/{Span}").Methods("GET").Name(APISpanRoute)
+	base.Path("/api/v1/aggregate").Methods("GET").Name(APIAggregateRoute)
+	base.Path("/api/v1/dashboard").Methods("GET").Name(APIDashboardRoute)
+	base.Path("/api/v1/dependencies").Methods("GET").Name(APIDependenciesRoute)
+	base.Path("/api/v1/traces/{Trace}").Methods("DELETE").Name(APIDeleteTraceRoute)
+	base.Path("/api/v1/traces/{Trace}/pin").Methods("PUT").Name(APIPinTraceRoute)
+	base.Path("/api/v1/traces/{Trace}/pin").Methods("DELETE").Name(APIUnpinTraceRoute)
+	base.Path("/api/v1/purge").Methods("POST").Name(APIPurgeRoute)
 	return &Router{base}
 }
 
@@ -75,3 +110,14 @@ func (r *Router) URLToTraceProfile(trace appdash.ID) (*url.URL, error) {
 func (r *Router) URLToTraceSpanProfile(trace, span appdash.ID) (*url.URL, error) {
 	return r.r.Get(TraceSpanProfileRoute).URL("Trace", trace.String(), "Span", span.String())
 }
+
+// URLToTraceFolded constructs a URL to a trace's folded stacks.
+func (r *Router) URLToTraceFolded(trace appdash.ID) (*url.URL, error) {
+	return r.r.Get(TraceFoldedRoute).URL("Trace", trace.String())
+}
+
+// URLToTraceSpanFolded constructs a URL to a sub-span's folded stacks in a
+// trace.
+func (r *Router) URLToTraceSpanFolded(trace, span appdash.ID) (*url.URL, error) {
+	return r.r.Get(TraceSpanFoldedRoute).URL("Trace", trace.String(), "Span", span.String())
+}
@@ -0,0 +1,176 @@
+package traceapp
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// tracesSearch is the set of trace search parameters given in a URL query.
+// It is passed to the traces.html template so the search form can be
+// refilled.
+type tracesSearch struct {
+	Show        string // comma-separated list of trace IDs
+	Name        string
+	MinDuration string
+	MaxDuration string
+	Annotation  string // "key=value"
+	User        string
+	Route       string
+	Service     string
+	Errors      string // "", "yes" or "no"
+	Sort        string // "", "newest", "oldest", "slowest" or "fastest"
+	Pinned      string // "" or "yes"
+	Limit       int
+	Offset      int
+}
+
+// tracesDefaultLimit is the number of traces listed per page of the traces
+// page when the search doesn't specify a limit.
+const tracesDefaultLimit = 100
+
+// Empty reports whether no search parameters other than the default limit
+// were specified.
+func (s *tracesSearch) Empty() bool {
+	cpy := *s
+	if cpy.Limit == tracesDefaultLimit {
+		cpy.Limit = 0
+	}
+	return cpy == (tracesSearch{})
+}
+
+// parseTracesSearch parses the trace search parameters from the given URL
+// query:
+//
+//  show=<id>,<id>     traces with the given IDs
+//  name=<name>        root span name
+//  min=<duration>     minimum duration, e.g. "250ms"
+//  max=<duration>     maximum duration, e.g. "2s"
+//  ann=<key>=<value>  annotation match, e.g. "Server.Response.StatusCode=500"
+//  user=<user>        HTTP server user
+//  route=<route>      HTTP server route
+//  service=<service>  service that recorded a span
+//  errors=yes|no      traces with or without errors
+//  sort=newest|oldest|slowest|fastest
+//  pinned=yes         only pinned traces
+//  limit=<n>          maximum number of traces
+//  offset=<n>         number of traces to skip
+//
+func parseTracesSearch(q url.Values) (*tracesSearch, error) {
+	s := &tracesSearch{
+		Show:        strings.TrimSpace(q.Get("show")),
+		Name:        strings.TrimSpace(q.Get("name")),
+		MinDuration: strings.TrimSpace(q.Get("min")),
+		MaxDuration: strings.TrimSpace(q.Get("max")),
+		Annotation:  strings.TrimSpace(q.Get("ann")),
+		User:        strings.TrimSpace(q.Get("user")),
+		Route:       strings.TrimSpace(q.Get("route")),
+		Service:     strings.TrimSpace(q.Get("service")),
+		Errors:      q.Get("errors"),
+		Sort:        q.Get("sort"),
+		Pinned:      q.Get("pinned"),
+	}
+	if s.Pinned != "" && s.Pinned != "yes" {
+		return nil, fmt.Errorf("invalid pinned filter %q", s.Pinned)
+	}
+	for _, v := range []struct {
+		name string
+		dst  *int
+	}{{"limit", &s.Limit}, {"offset", &s.Offset}} {
+		str := q.Get(v.name)
+		if str == "" {
+			continue
+		}
+		n, err := strconv.Atoi(str)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid %s %q", v.name, str)
+		}
+		*v.dst = n
+	}
+	return s, nil
+}
+
+// TracesOpts converts the search parameters into options for a Queryer.
+func (s *tracesSearch) TracesOpts() (appdash.TracesOpts, error) {
+	opts := appdash.TracesOpts{
+		Name:    s.Name,
+		User:    s.User,
+		Route:   s.Route,
+		Service: s.Service,
+		Offset:  s.Offset,
+		Limit:   s.Limit,
+	}
+
+	// Parse the comma-separated list of traces that we should only show (all
+	// others are hidden).
+	if s.Show != "" {
+		for _, idStr := range strings.Split(s.Show, ",") {
+			id, err := appdash.ParseID(idStr)
+			if err == nil {
+				opts.TraceIDs = append(opts.TraceIDs, id)
+			}
+		}
+	}
+
+	var err error
+	if s.MinDuration != "" {
+		if opts.MinDuration, err = time.ParseDuration(s.MinDuration); err != nil {
+			return opts, err
+		}
+	}
+	if s.MaxDuration != "" {
+		if opts.MaxDuration, err = time.ParseDuration(s.MaxDuration); err != nil {
+			return opts, err
+		}
+	}
+
+	if s.Annotation != "" {
+		kv := strings.SplitN(s.Annotation, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return opts, fmt.Errorf("invalid annotation filter %q (expected key=value)", s.Annotation)
+		}
+		opts.Annotations = map[string]string{kv[0]: kv[1]}
+	}
+
+	switch s.Errors {
+	case "":
+	case "yes":
+		opts.Errors = appdash.WithErrors
+	case "no":
+		opts.Errors = appdash.WithoutErrors
+	default:
+		return opts, fmt.Errorf("invalid errors filter %q", s.Errors)
+	}
+
+	switch s.Sort {
+	case "":
+	case "newest":
+		opts.Order = appdash.OrderNewest
+	case "oldest":
+		opts.Order = appdash.OrderOldest
+	case "slowest":
+		opts.Order = appdash.OrderSlowest
+	case "fastest":
+		opts.Order = appdash.OrderFastest
+	default:
+		return opts, fmt.Errorf("invalid sort order %q", s.Sort)
+	}
+	return opts, nil
+}
+
+// pageURL returns a copy of u with the offset query parameter set, or nil if
+// the search isn't paginated or offset is out of range.
+func (s *tracesSearch) pageURL(u *url.URL, offset int) *url.URL {
+	if s.Limit == 0 || offset < 0 {
+		return nil
+	}
+	cpy := *u
+	q := cpy.Query()
+	q.Set("offset", strconv.Itoa(offset))
+	cpy.RawQuery = q.Encode()
+	return &cpy
+}
@@ -27,10 +27,13 @@ var (
 
 var templates = [][]string{
 	{"root.html", "layout.html"},
-	{"trace.html", "layout.html"},
+	{"trace.html", "layout.html", "flamegraph.html"},
 	{"traces.html", "layout.html"},
 	{"dashboard.html", "layout.html"},
 	{"aggregate.html", "layout.html"},
+	{"dependencies.html", "layout.html"},
+	{"flame.html", "layout.html", "flamegraph.html"},
+	{"compare.html", "layout.html"},
 }
 
 // TemplateCommon is data that is passed to (and available to) all templates.
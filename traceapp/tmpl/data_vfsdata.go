@@ -29,49 +29,77 @@ var Data = func() http.FileSystem {
 	fs := _vfsgen_fs{
 		"/": &_vfsgen_dirInfo{
 			name:    "/",
-			modTime: mustUnmarshalTextTime("2016-02-03T04:11:50Z"),
+			modTime: mustUnmarshalTextTime("2026-10-17T01:47:10.330436325Z"),
 		},
 		"/aggregate.html": &_vfsgen_compressedFileInfo{
 			name:              "aggregate.html",
-			modTime:           mustUnmarshalTextTime("2015-10-04T03:50:12Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x57\x6d\x73\x13\x39\x12\xfe\x8c\x7f\x45\xa3\x04\x62\x73\x9e\x99\x98\x2b\xee\xc0\xd8\x4e\x71\x70\xc5\x5d\xd5\x51\xb9\x4b\xb8\xe5\x03\xc5\x07\x79\xa6\x3d\x16\x91\xa5\x59\x49\xe3\xc4\xeb\x9d\xff\xbe\x2d\x8d\xe7\xc5\x24\x6c\xed\x16\x5b\xb5\x8e\xdd\x6a\x3d\xdd\xfd\xf4\x9b\x18\xec\xf7\x19\xae\x84\x42\x60\x1f\x85\x93\xc8\xaa\xea\x4d\x9e\x1b\xcc\xb9\x43\xf8\x49\xe0\x2d\x44\xc0\x8b\x22\xe3\x76\xbd\xdf\xa3\xca\xaa\xaa\x77\xe3\x03\x17\x8a\x2e\x0c\x06\x33\xeb\x76\x12\xc1\xed\x0a\x9c\x33\x87\x77\x2e\x49\xad\x65\x8b\x01\xc0\xda\x6d\xe4\x78\xa9\xb3\x1d\xec\xe9\x17\x40\xf2\x0c\xde\x09\xcb\x97\xa4\xbd\x45\xe3\x44\xca\x25\xd8\xd4\x68\x29\x97\xdc\x40\x56\x12\x88\x86\x94\xab\x2d\xb7\xb0\x44\xa1\x72\xaf\xb6\x03\xa9\x55\x1e\xc3\xb3\x24\x60\x68\x12\xad\xa4\xbe\x8d\x76\x53\x58\x8b\x2c\x43\xf5\x9a\xe4\x15\xfd\x7f\xe2\x74\x11\x19\x91\xaf\x5d\xb4\x74\xca\x1e\x6c\x6e\xb8\xc9\x85\x8a\xe8\x6c\x0a\xcf\x5f\x14\x77\xad\x76\x21\xf0\xed\x9a\x1b\x77\xd0\xbb\x15\x99\x5b\x4f\xe1\xe5\xf9\x79\xad\x43\xde\xa3\xc7\x9a\xc2\xdf\x3a\xd1\x01\x4c\xe2\x8a\xe4\xbc\x74\xfa\x48\x6c\x6a\xfd\x5a\x7e\x08\x38\xfc\x01\x78\x5b\x07\x25\x6c\x17\xd1\x18\xac\x86\x5b\x04\xbd\x5a\x59\x74\x20\x1c\x2c\x77\x30\xf1\xb6\x62\xf8\x84\x90\xea\x52\x66\xb0\xe6\x2a\x27\x56\xd6\x78\xa0\xe5\x00\x67\xc5\x2f\x08\xcb\x32\xdc\xba\x0d\x8a\xb8\x5a\x61\xea\xc4\x16\xe5\x0e\x88\xd1\x02\x34\x9d\x5a\xbd\xf1\xf8\xe1\xbe\xe4\x4b\x94\x16\x28\x3d\x94\x48\x4f\x2d\x29\xdc\x72\x93\x1d\x10\x57\x46\x6f\x6a\x3b\x9e\x93\x20\x3c\x10\x5e\x68\x2b\x9c\xd0\x6a\x0a\x06\x25\xf7\x26\xea\xa0\x03\xa3\xd1\xa4\x21\xa7\x1a\xcc\x92\x50\x08\x0b\xaa\x88\xc7\x51\x54\xd7\xcf\x07\x9d\x21\x6c\x50\x95\x10\x45\x8b\xc1\x2c\x13\x5b\x48\x25\xb7\x76\xce\x28\x45\x51\x6e\x74\x59\x40\x51\x4a\x59\x73\xc7\x80\x6a\x81\x8a\x28\xc8\x19\x70\x23\x78\x14\xfc\x9e\xb3\x38\x8e\x19\x88\x8c\x0a\xec\x28\xc9\xa1\xcc\x66\xc4\x84\xd3\xea\x50\x81\xf5\x0f\xd6\xb3\x03\xde\x16\x95\x2d\x2f\xa5\x83\x8c\xc8\xc9\xf4\xad\xaf\x88\x3c\xa7\x92\x87\x8c\x3b\x7e\xf8\x31\x67\xcd\xe9\xc1\x38\xde\x15\x5c\x65\x48\x66\x57\x5c\x5a\x52\x76\xbe\x4d\xe6\x2c\x5d\x6b\x6d\xeb\xbc\xf0\xa6\x61\xb2\x80\x04\x5b\x0a\xdb\xd3\xbb\xa1\xc8\x83\x77\xd0\x63\x62\x66\x09\xaf\xf1\x2c\xe5\x06\x1d\x5b\x10\x6d\x24\x0c\x71\x24\xb5\xef\xe1\x7b\x29\x1b\xbd\xd6\x63\xcf\x63\x43\x51\xf8\x5e\xc3\xcf\xa4\x58\xcc\x38\xac\x0d\xae\xe6\xec\xa4\x66\xc9\x7b\x11\x79\x17\x22\x67\x78\x8a\x91\x56\x72\xd7\x7a\xdf\xba\x0c\xe1\x90\x10\xb5\x8b\x82\x67\x8a\x6f\xd0\x42\x50\x5e\x5c\x91\x14\xae\x49\x6a\x67\x09\x27\x2f\xc9\xca\x1f\x30\xe7\x61\x7e\xdf\x9a\x2d\x97\xf7\x8d\x5d\x97\xcb\x3f\x6f\xab\x0e\x8d\x12\x14\xf0\x1e\x30\xc8\xa5\x6c\x8c\xb6\x06\xd9\xe2\x0d\x49\xef\xd9\x9a\x25\xa5\xa4\x02\x4d\xa8\x42\x7d\x01\xaf\x27\x8b\x37\x5d\x62\x7d\x02\x67\x09\xc9\x06\x75\x09\x7b\x37\x9a\xf1\xe1\x13\x78\xb8\x44\xa3\x4c\x14\xd4\x75\x26\x9d\xb3\xfd\x3e\xfe\x07\xb7\xf8\xff\xab\xff\x54\x95\x75\xd4\x36\x69\xb2\x44\x75\x83\xa8\x92\xec\xaf\x74\xb7\xfe\x8c\x37\x42\xc5\x5f\x6d\x28\x82\x70\x79\xd1\xa2\xf4\xc6\xe9\x57\x4e\x9d\x1f\xa4\x21\xe3\xa7\x43\x2a\x30\x2a\x88\x51\x2c\x35\xcf\x86\xab\x52\xa5\xbe\x3f\x87\xa3\x66\xca\x26\xf0\xde\xf0\xe5\x83\xd5\xd9\xb6\xb9\xc3\x4d\x21\x3d\x47\x05\x37\x44\x8b\x43\x33\x06\x6d\x40\x84\x61\x61\x90\x26\xd5\xe0\xd1\x23\x42\x52\x5a\xe1\x18\x56\xfc\x86\xb0\x68\xec\x28\xea\x92\x1a\x09\x25\x52\x0d\x3a\x58\xd1\x2d\x0f\x48\xd1\xd4\xb3\xc3\xcf\x70\xeb\x04\x91\x6c\x68\xd2\xa0\x89\x83\x53\x5b\x3f\xe0\xfd\xbd\x39\x10\x35\x1d\xb7\x55\x55\x4f\x13\xb1\x1a\xfa\xe3\x58\xa2\xca\xdd\x1a\xe6\x73\x38\x6f\xe2\x81\xe6\xe2\xe7\x3d\x0b\xd3\x80\x4d\x81\x29\x5d\x67\xd6\x7a\x73\x6d\x94\x6c\x0c\x6c\xcb\x65\x89\xa4\x32\xa9\xbe\xd4\xd0\xb4\xa2\x42\x28\x6f\x0d\x86\x2a\xec\x3b\xdb\x39\xe7\x45\x73\x50\xd4\xa9\x21\x33\xc3\x2e\xc1\xe3\xd6\x0f\xe6\xe7\x2e\x41\x37\xbf\x49\x52\x8f\xe5\x7f\x85\x55\xc1\xc2\xfa\x18\x7f\x7b\xf8\xc9\x6f\x96\x7b\x67\x04\xff\x6f\xa5\xd0\x5c\xf1\x4c\x94\xd6\x87\xf4\xf2\xfc\x09\x6b\x14\xaa\xe6\x0b\xcd\x3b\x2d\x9d\x28\xec\xb1\x59\x54\x7e\x8b\x66\x24\x74\xa6\xc4\x1e\xac\x2f\x1b\x0f\x46\xc9\x4d\x71\xad\x25\x25\x80\xf5\x8e\xad\x33\x94\x43\xaf\xb0\x0f\x54\x56\xb4\xe1\xf7\x81\xb1\x6a\x63\xfd\xf7\x02\x4d\x4a\x69\xe5\x39\x56\x4f\x8e\x2f\xd2\x68\x3f\x76\x82\xa4\x4b\x9e\xde\xf8\x61\xad\xb2\xcb\x82\xa7\xc2\xed\x48\xe1\x3c\xfe\xfb\x8b\x56\xa7\xba\x17\x8f\xcf\xe5\x71\x2c\x56\x1b\x77\x69\xbc\xa3\xd3\x43\xf6\x68\x58\xdb\xf4\xc8\xfa\x86\x5a\xf9\x1a\x73\x5f\x71\xef\xfd\x72\xa8\x83\x38\xf2\xe5\x7b\x94\x40\xaf\x24\xee\x4b\x3f\x36\x7c\xb5\x71\xb3\x23\xa5\xb6\xe0\x2e\x7d\x5f\xc0\x90\x48\xa0\x92\xa3\xb5\x0c\x93\x27\xa3\x63\xd5\x54\x4b\x1d\x62\x38\x49\xc3\x7f\xac\x63\xa1\x5f\x13\x5a\xd1\x06\xf6\xb5\xe2\x99\x18\xf4\x69\xaa\x46\x61\x91\x8e\xc2\xe3\x81\x0a\xf6\x1a\x91\x9e\x50\xae\x98\x26\xb4\x59\x89\xe9\xe6\xe9\x13\xa7\x7a\x93\xfc\x5c\xa2\xf5\x6d\x6f\x93\x17\xaf\x5e\xbd\x9a\x4c\x5e\x26\x3c\xcb\x22\x6d\xa2\x92\xde\x6b\x0e\x23\x3a\x37\xbb\xa8\xce\x77\xd4\x36\x39\x01\x37\xf3\x02\x6a\xc5\xff\x79\xbd\xeb\xa0\xf6\xdf\x46\x6b\x58\x1a\x31\x86\x1b\xdc\x8d\x21\x90\xd4\x74\xa2\xef\x13\xd3\xb4\xc9\x15\xe6\xff\xbc\x2b\x86\x6c\xf8\xf9\xe2\xe9\x97\x11\x83\xbf\xf8\x0b\xf4\xc9\xe6\xf1\xb3\x8b\xe1\xd3\x5f\x4f\x47\xbe\x1b\x05\x1b\xbd\x6e\xef\x5a\xf4\x8e\x38\x9a\x18\x73\x20\x13\x31\x8d\x31\xbc\xbb\x5c\x0d\xcf\x2e\xce\x46\xf0\x98\x7a\x3e\x9a\xc0\x05\xb0\xa7\x0c\x88\xc5\x0b\xd6\x4c\x06\xf0\xfe\xc4\x1b\xee\xd2\xf5\xd0\xe0\xa8\x9b\x0b\xb4\x3f\x4b\xa3\x02\x94\xc1\x50\xf1\x74\x3e\x86\xb3\xd3\xc9\x59\xcf\x1d\xef\x5a\x08\x83\xfe\x9e\x9d\x3e\x3f\x1b\x35\x63\xc1\x7f\xd2\x9b\x08\x1f\xc0\x23\xd5\xce\xd9\x07\xa0\x3a\x88\x6a\xd0\xe7\xd4\x2f\x27\xbf\xe9\x87\x7e\xd7\xf4\x69\x2b\x7d\xc8\xdf\x27\xbc\x9e\xe8\x34\xd0\x53\xee\x71\x62\xbf\xf1\xfc\x2c\x6b\x76\x1d\x51\x19\x10\x3b\x4a\x1e\xba\x41\x1c\x42\xd9\xd1\xf3\xa0\x0a\x69\x7c\xe3\xfc\xe9\x90\x9d\x3c\xf8\x5e\x18\xc5\xa9\x14\xe9\x4d\xb7\x61\xda\x88\x30\x2e\x0c\x6e\xa9\x8c\xdf\xd5\xaf\xaa\x61\x93\xe4\x26\x7c\xd6\x87\xa9\xab\xfa\x9e\xa5\xee\xa9\xf0\x23\x86\x7a\x28\xdf\xb1\xf3\xcd\x33\xe1\xc7\xa3\xea\xa0\x0e\x16\xbb\xed\x3d\x68\xfe\x9d\xf4\x5b\x00\x00\x00\xff\xff\x5c\xee\xf8\x38\x5b\x0d\x00\x00"),
-			uncompressedSize:  3419,
+			modTime:           mustUnmarshalTextTime("2026-10-17T01:25:09.384007654Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\xdd\x96\xdb\xb6\x11\xbe\x8e\x9e\x62\x02\x3b\x5e\xd2\x16\x49\x69\x9d\x6d\xb3\xb2\x24\x9f\xfc\x35\xe9\x39\x49\x9d\xda\x6e\x73\xe1\xe3\x0b\x88\x1c\x89\xc8\x42\x00\x03\x80\xfa\xa9\xca\x07\xe8\x7b\xf4\xc9\xfa\x24\x3d\x03\xfe\x88\x5a\x69\x1d\xe7\xc4\x17\x5e\x11\x98\x9f\x6f\xbe\xc1\x00\x03\x0c\x0e\x87\x0c\x97\x42\x21\xb0\xb7\xc2\x49\x64\x55\xf5\xe5\x6a\x65\x70\xc5\x1d\xc2\x3f\x05\x6e\x21\x02\x5e\x14\x19\xb7\xf9\xe1\x80\x2a\xab\xaa\x9e\xc6\x8f\x5c\x28\x56\x55\x83\xc1\xd4\xba\xbd\x44\x70\xfb\x02\x67\xcc\xe1\xce\x25\xa9\xb5\x6c\x3e\x00\x78\xe4\x74\x11\x19\xb1\xca\x5d\xb4\x70\xca\xc2\x61\x00\x00\xb0\xe6\x66\x25\x54\xe4\x74\x31\x81\xeb\x9b\x62\xf7\x62\x00\x50\x91\x74\x21\xf0\xeb\x9c\x1b\xd7\xc8\x6d\x45\xe6\xf2\x09\x7c\x31\x1a\xd5\x32\x00\x39\x92\xad\x09\xfc\xe9\x38\xd4\x18\x93\xb8\x74\x13\xe0\xa5\xd3\x27\xc3\xde\x77\x3b\xee\x27\x92\xa7\xfe\x0f\xc0\xd7\x5c\x6d\xb8\x05\x61\x61\x83\x66\x0f\x52\xab\xd5\x10\xac\x86\x2d\x82\x5e\x2e\x2d\x3a\x10\x0e\x16\x7b\x18\x93\xaf\x18\x7e\x46\x48\x75\x29\x33\xc8\xb9\x5a\x21\xb8\x1c\x21\xf5\x16\x1a\x73\x56\xfc\x0b\x61\x51\x7a\xad\xad\x17\xc4\xe5\x12\x53\x27\x36\x28\xf7\x90\x1a\x5d\x80\x2e\x1d\x58\xbd\x26\xfb\x5e\x5f\xf2\x05\x4a\x0b\xb8\x73\xa8\x32\xa1\x56\x24\xb0\xe5\x26\x6b\x2c\x2e\x8d\x5e\x7b\xb9\x94\x38\xf1\x83\x4f\x13\xff\xa7\xd0\x56\x38\xa1\xd5\x04\x0c\x4a\x4e\x2e\xea\xa0\x3d\xa3\xd1\xb8\x25\xc7\x73\x2a\xb9\x43\x95\xee\x1b\x4a\x93\xa7\xf0\x23\xbf\x43\x28\x0b\x58\x6a\xe3\xad\x37\xc1\x36\x98\x0a\xd1\xf8\x03\xbe\xd0\x1b\x8c\xe1\x69\xd2\xe7\xf3\x92\x87\x5c\x58\xa7\x57\x86\xaf\x21\x5e\x70\x03\x06\xd3\x36\x81\x4b\x21\xe5\x04\xac\x43\x94\x0b\x59\xe2\x43\x1a\xb4\x64\x4e\x34\xb6\xb9\x70\x4d\x48\x4b\xad\x5c\x44\xdc\x4e\x60\x3c\x6e\x73\x4e\x0a\x11\x57\x69\xae\xcd\x04\xd6\x22\xcb\xe4\x45\xdb\x7c\x27\x2c\x14\xdc\xe5\xc3\xf3\x61\x49\x4b\xb8\xef\x53\x69\xd5\xb8\xb4\xce\xe8\x3b\x9c\xc0\xa3\xd1\x68\xd4\x8c\xe4\xbc\xc0\xc8\xa0\xca\xd0\x08\xb5\x9a\x40\x6a\x84\x2d\xbe\xcd\x56\x68\x49\xa0\x1a\x4c\x13\x5f\x02\xf3\xc1\x60\xfa\x69\x14\xd5\x95\xf3\xa3\xce\x10\xd6\xa8\x4a\x88\xa2\xf9\x60\x9a\x89\x0d\xa4\x92\x5b\x3b\x63\x0b\xa7\xa2\x95\xd1\x65\x01\x45\x29\x65\x5d\x1f\x0c\x8c\x96\x38\x63\x7e\x9c\x01\x37\x82\x47\x7e\x7d\xcc\x58\x1c\xc7\x0c\x44\x36\x63\xa7\xc5\xe4\x0b\x6c\xba\x28\x9d\xd3\xaa\xa9\xbd\xfa\x83\xf5\xfc\x00\xf9\xca\x70\xc9\x4b\xe9\x20\x33\xba\xc8\xf4\x96\xb2\xb8\x5a\x49\x64\x90\x71\xc7\x9b\x8f\x19\x6b\x67\x1b\xe7\xb8\x2b\xb8\xca\x30\x9b\xb1\x25\x97\x16\x19\x38\xda\x20\x66\x2c\xcd\xb5\xb6\xf5\xfa\xe7\xed\x56\x91\x79\x4b\xb0\x11\xb8\xa5\x65\xbc\xd6\x19\x7a\x74\xd0\x63\x62\x6a\x0b\xae\x5a\x64\x29\x37\xe8\xd8\x7c\x9a\xd0\x20\x49\x4e\x93\x1a\xbb\xff\x5d\xca\x56\xae\x43\x4c\x3c\xb6\x14\xf9\xdf\x24\x08\x30\x95\x62\x3e\xe5\x90\x1b\x5c\xce\xd8\xa3\x9a\x25\x42\x11\x11\x84\xc8\x19\x9e\x62\xa4\x95\xdc\x77\xe8\x3b\xc8\xe0\x27\xc1\x68\xed\x22\x02\x01\x8a\xaf\xd1\x82\x17\x9e\xbf\xd6\xda\xc1\x9b\x82\x2b\x3b\x4d\xf8\x7c\x9a\x48\xf1\x31\xee\xc8\xcc\x87\xbd\xd9\x72\x71\xee\xec\x4d\xb9\xf8\xfd\xbe\xbc\xbd\x88\xab\xcc\xdb\xbb\xe0\x90\x4b\xd9\x3a\xed\x1c\xb2\xf9\x97\x52\x9e\xfb\x9a\x26\xa5\x9c\x0f\xa6\x49\x26\x36\xb4\x80\xf3\xf1\xbc\x3b\x03\x32\x9f\xc0\x69\x92\x8f\x69\x86\x96\x30\xc1\x68\xb7\x69\x36\xef\x94\xda\xa9\x66\xb7\xf1\xd9\x9f\xe6\xd7\xf3\xb7\x1e\xc2\x0f\xf5\xe8\x34\xc9\xaf\xfd\x44\xd1\x84\x48\x95\xa6\x56\xf3\xe2\x66\x34\xa1\x0a\xf2\x1f\x70\x38\xc4\x8d\x7c\xfc\xd3\xcd\xa8\xaa\xd6\x16\x9e\x50\x8d\x6b\xf7\xe2\x54\xeb\xf6\x21\xad\xdb\x0f\x6a\xdd\x3c\xa4\x75\xf3\x21\xad\xdb\x87\xb4\x6e\x49\x8b\x82\x4a\x7c\x54\x1d\x49\xdd\x86\xd3\xb1\xd4\x91\x65\x53\x23\x0a\x07\xd6\xa4\x33\x76\x38\xc4\x5f\x71\x8b\xff\x78\xfd\x43\x55\x59\xc7\x9d\x48\x93\x05\xaa\x3b\x44\x95\x64\xcf\x0b\x81\xf5\xff\xf1\x5a\xa8\xf8\x17\x4b\xa6\x6a\xe5\x79\x67\xa5\x77\xea\xfe\xc2\x37\xbc\x1e\xf5\xfc\x3f\x0e\xb6\x42\x65\x7a\x1b\xc6\x52\xf3\x2c\x58\x96\x2a\xa5\x43\x23\x08\x9b\x7d\x2f\x49\xe0\x3b\xc3\x17\x17\x4b\xb9\x3b\x7b\x1c\xae\x0b\x4a\x2a\x14\xdc\xf0\x35\x3a\x34\x43\xd0\x06\x84\x3f\x2d\x0c\x82\xb0\x83\x4f\x3e\x49\x12\xbf\x7d\x0e\x61\x49\x67\x0b\x07\x2b\xd4\x4a\x62\xbd\x29\xa0\xc4\x35\x2a\xd7\x1d\x37\xc7\x03\xc6\x69\xb0\x4e\x48\x09\xf5\xc6\x1a\x7b\x50\x1b\x6e\x6a\xbd\x19\xd1\x7c\x5c\x88\x55\x55\x67\x44\x2c\x03\x9a\x8e\x25\xaa\x95\xcb\x61\x36\x83\x51\x1b\x0f\xb4\x8a\xef\x0e\xcc\x6f\x9d\x6c\x02\x4c\xe9\xba\x0c\x2c\x38\x7d\x8c\x92\x0d\x81\x6d\xb8\x2c\x91\x4d\x60\x5c\xbd\xaf\x4d\x57\x83\x3a\x94\xaf\x0d\x52\xc0\x27\x60\x8f\xe0\x68\x68\x06\x0a\xb7\xe0\x33\x13\x1c\xab\x61\xd8\xe1\x60\x74\x60\xb1\x49\xf7\x0d\xc0\xea\x5e\xe1\x7b\xdf\xbf\x30\xdf\xd3\x0c\xef\x4f\xfe\x4c\xed\xce\xd9\x5c\x21\xf0\xaf\x4a\xa1\x79\xcd\x33\x51\x5a\x0a\xe9\x8b\xd1\x67\xac\x15\xa8\xda\x1f\xcc\x69\x2d\x9d\x28\xec\xa9\x5b\x54\x7c\x21\x31\x63\x13\x70\xa6\xc4\x56\x98\xc4\xf7\x05\x05\xcf\x0a\xc9\x53\xcc\xb5\xcc\xd0\x74\x46\x01\x98\x75\x74\xd0\x91\xc0\xc1\x53\x59\x41\x04\x07\xcf\x18\xd5\x48\x04\x87\x02\x4d\x8a\xca\xf1\x15\x56\x47\x34\x5e\x71\x2f\xf1\x14\x04\x00\x5b\xf0\xf4\x8e\x4e\x36\x95\xbd\x2a\x78\x2a\xdc\x9e\x4d\x60\x14\xff\xf9\xa6\x93\xa9\xce\xe2\xa1\x5c\x9e\xc6\x62\xb5\x71\xaf\x0c\x01\x9d\x34\xd9\x8b\x32\xb4\xe9\x89\xf7\x35\x97\xf2\x0d\xae\x68\xc5\x7d\x47\x27\x69\x1d\xc4\x09\x96\x87\x28\x81\xde\x92\x38\x1f\x7d\xdb\xf2\xd5\xc5\xdd\xf3\x0b\x70\x5c\x70\xaf\xa8\x2e\x20\x90\x68\x2d\xb8\x9c\x2b\x18\x7f\x16\x9e\x8a\xa6\x5a\x6a\x1f\xc3\xa3\xd4\xff\x63\xdd\x64\x17\x3d\x2d\x18\xad\x1c\x2a\xc7\x26\x7e\x55\x37\xe3\x35\x4d\x55\x48\x0b\x96\xfe\x1f\x00\x24\x09\x7c\x63\xf8\x16\x38\x74\x3b\x4e\xdb\x5f\x66\xa5\xe1\x54\xf1\xb6\x1d\xa8\x2b\xe1\xca\x9f\x7a\xfe\x5c\xb0\x43\xd8\x0a\x97\x03\x87\x05\x37\xb5\x31\xaa\x53\xe4\x69\x0e\x8b\x32\xbd\x43\xbf\xf0\x1f\x9f\xef\x1d\x54\x09\xb5\x80\x85\x59\x7f\x43\xfc\xbe\x05\xd1\x2b\xd8\x46\xf0\x72\xcd\x3e\x0e\xd8\xb1\x39\x63\x61\x4c\x3b\x59\xc0\xfe\xd6\x95\xad\xc7\xe7\xc4\x1a\x3d\xde\x98\xf9\xd8\x49\xd1\xa0\x2b\x8d\x6a\x4b\xb7\x05\x55\xf7\xfd\x84\xc9\xb7\xaa\xe3\xd1\x10\x9a\x3b\xc0\xf5\x68\x08\x0b\xed\x9c\x5e\x4f\xe0\xf3\xd1\x10\xea\x1b\xc3\xcd\xa8\x47\xba\xbf\x70\xc0\x8c\x2a\x10\xa2\xc6\x54\x4c\x72\xc7\x2f\x6f\xec\xa8\x51\x5f\x48\x60\x06\xcf\xfb\x2a\x4e\x17\xc7\x8f\xda\x67\x73\xfb\x20\x88\x3b\x98\x41\xf6\x3c\xb6\x29\x97\x18\x6b\x93\x09\xc5\x65\x10\x76\x26\xe3\x4c\xaf\xb9\x50\x1d\x6b\x6b\x5e\x1c\xf9\x5f\x84\x70\x68\x22\x07\xf6\xbf\xff\xfc\x17\x18\x3c\x83\x45\xbc\xe6\x3b\x78\x06\x6c\x6d\xd9\x0b\xa8\xc2\x9e\x2d\x43\x97\x95\xd7\x54\x78\x5f\x71\x95\xd9\xe0\xdd\x88\x32\x9e\xb9\xfc\xfd\x10\x46\xf1\xb8\x21\x93\x50\xed\xfb\xa8\xa8\x3b\xe6\xe6\x02\x28\xd2\xcf\x9e\x93\xbf\x16\xdf\x10\x2e\x82\x5b\xc4\xa9\x2e\x95\x23\x38\xef\xef\xe3\x09\xde\xd5\xb4\x0d\x61\xf4\x3e\xec\x11\x63\x37\xab\x06\x04\x4a\x4c\xdd\xbd\x85\xc1\x8b\x02\x55\x16\x30\xbb\x59\xb1\x9e\x45\xee\x9c\x09\x98\x8f\x89\x35\xb1\xc1\xb3\x96\x7b\x9f\xbb\xee\xcb\xe7\xee\x4c\xb5\xc6\xc2\x86\x6d\x2e\x3b\x71\xca\x62\xf7\x51\x67\xb1\x55\xee\xc0\x5c\x80\xe2\x0c\x57\x76\xa9\xcd\x9a\x0e\x19\xff\x41\xe7\x67\xc0\xce\x50\xb1\x61\x6f\xac\x76\xc6\x42\xd6\x12\x62\x37\xab\x0f\x79\xf1\x0d\x32\x79\xd8\x01\x5d\x73\x3e\x1a\xc6\xc8\x3b\xed\x42\x65\x61\x5f\x33\xe5\x52\x06\xb4\x34\xc9\xf7\x4e\xd8\x20\xac\x57\x69\xb0\x0b\x63\x6d\x04\x2a\x17\xb0\x9a\x08\x16\x86\x2f\x7e\x17\xcc\xfd\x19\xcc\x07\x9d\xed\x8f\xce\x28\x7f\x2c\x8c\x9d\x48\xef\x6c\x70\x53\xff\xf8\x8b\x36\x6b\xee\x48\x93\x48\xe6\x2e\x60\x19\x0b\xbb\x35\xdf\x81\xa1\x7d\xe4\x37\x58\x31\xda\x51\x66\xa2\xdb\xd1\x09\x0b\xb5\xe4\x9e\x0d\x21\xfa\x7c\x74\x36\xbe\xa3\xf1\x86\xbf\x04\xae\x7b\xf3\xfe\x0a\x18\xb0\xde\xe5\x94\xf2\x43\xed\xa4\xc4\xbe\x7d\x12\x08\x98\xef\x8c\x6d\x97\x6e\x5a\xff\x74\x17\x9e\x79\x46\xeb\x02\xf8\x52\xca\x80\xd1\x9d\xba\xaf\x4d\xa7\x41\x5b\x7b\xed\x70\x8c\xca\xa1\x09\xc2\x8f\xca\xc4\x3d\x7b\xe7\xbc\x5c\xde\x6c\x4e\x57\xf2\x2e\xb8\xb8\xfb\x84\xf4\x77\x38\x0a\xfd\x36\xf4\xc2\xa3\x5b\x70\xd3\xc1\xa2\xa7\x81\x73\xdf\xfb\x87\x7c\xee\x83\x66\x17\x09\xc9\xdc\x43\x25\xbf\xab\x37\x15\xda\xdf\x82\xf0\xe1\xea\xbe\xe8\xa1\xc9\x63\x74\xd9\x55\x87\xdb\x5f\xe7\xfa\xc0\x7d\x0a\x2f\x5a\x6c\xcc\x10\x0f\xed\x09\x56\x16\xd4\x7b\xde\xa7\xea\x32\x43\x97\x57\xed\xee\x7e\x98\xf7\x56\xde\x47\xf3\x08\xcf\x60\x7c\x7d\x4a\xe6\x6f\x87\x32\x87\x11\xbc\xec\xbe\x26\xc0\xd8\x8b\xb3\x16\xe4\x0d\x22\xe4\xce\x15\x93\x24\xb1\x8e\xa7\x77\x7a\x83\x66\x29\xf5\x36\x4e\xf5\x3a\xf9\xb5\x44\x4b\xa0\x6c\x72\x73\x7b\x7b\x3b\x1e\x7f\x91\xf0\x2c\x8b\xb4\x89\xca\x22\xe3\x0e\xa3\x5f\x4b\x34\xfb\xa8\x6e\x39\xa3\xee\x9e\x31\x80\x2e\x1a\xa8\x05\xff\x4e\x72\x6f\xbc\xd8\x4f\xad\x54\x50\x1a\x31\x84\x3b\xdc\x0f\xc1\x77\x6f\xfd\x06\xc5\xb4\x9d\xfa\x6b\x5c\x7d\xbb\x2b\x02\x16\xbc\x7b\xf9\xe4\x7d\x48\x99\xb8\xc3\x3d\xa5\x68\x16\x3f\x7d\x19\x3c\xf9\xf7\xe3\x90\x0a\x43\xb4\xbd\x05\xe9\x5a\x24\x20\x4e\x53\x45\x96\x46\xc4\x42\x65\xb8\x7b\xb5\x0c\xae\x5e\x5e\x85\xf0\xe9\x6c\x06\xd1\x18\x5e\x02\x7b\xc2\x60\x02\xec\x25\x6b\x7b\x1d\x20\x3c\xf1\x9a\xbb\x34\x0f\x0c\x86\xc7\x36\xa7\xe1\x94\x66\x0d\xfa\xa6\x3b\x30\x38\x84\xab\xc7\xe3\xab\x1e\x1c\x82\xe6\xc3\x80\x67\x70\xf5\xf8\xfa\xaa\x01\x54\xb7\x37\x28\x2d\x5e\xb0\x07\xcf\x7a\x60\x2f\x98\x3a\x9a\xa8\x06\x7d\x4e\x37\x02\xb7\xf4\x32\x13\xd0\xdb\x40\x9f\xb6\x12\x66\x1f\x22\xbc\xbe\x54\xc6\x52\xa7\xbe\xb9\x8c\xe9\xf1\x65\x08\xc7\xb7\x09\x36\xf4\x6f\x29\x0d\x74\xa2\xe4\x92\x06\x7c\x3a\x83\xf2\x48\xcf\x45\x91\x19\x94\xf7\xc0\x53\xb3\x78\xff\x11\xc4\xbf\xb8\x84\x71\x2a\x45\x7a\x77\x5c\xc8\x5d\x44\x18\x17\x06\x37\xa8\xdc\x37\xf5\x2b\x58\xd0\x00\xeb\xc2\x67\x7d\x33\x34\x57\x85\x67\x9e\x8e\x4f\x3b\x7f\xc4\x51\xcf\xca\x03\x7e\xee\x3d\xeb\xfc\xf1\xa8\x8e\xa6\x1a\x8f\xc7\x07\x84\xc1\xe1\x80\x2a\xab\xaa\xc1\xff\x07\x00\x8d\x10\x19\x7f\x05\x18\x00\x00"),
+			uncompressedSize:  6149,
+		},
+		"/compare.html": &_vfsgen_compressedFileInfo{
+			name:              "compare.html",
+			modTime:           mustUnmarshalTextTime("2026-10-17T01:47:10.32467839Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\xff\x8e\xdb\x36\x12\xfe\x5f\x4f\x31\xc7\x5c\x0e\x09\x2e\x92\x76\x83\xbb\x06\x71\x64\x01\x72\xb7\x2d\x82\xfe\x40\x91\x6e\x1f\x80\x16\xc7\x16\x51\x89\x14\xc8\xf1\xee\xba\x02\xdf\xbd\x20\x45\xd9\x72\xec\xdd\x02\x4d\x82\xd6\xbb\x90\xa5\xd1\xf0\xe3\x37\xdf\x0c\x67\x3c\x0c\x02\x37\x52\x21\xb0\x5b\x49\x2d\x32\xe7\xbe\xd6\x5d\xcf\x0d\xc2\xad\xe1\x35\x5a\x48\x81\xf7\xbd\xe0\xb6\x19\x06\x54\xc2\xb9\x24\x39\x2e\xf9\x91\x4b\xc5\xbc\xa9\xb0\xb4\x6f\x11\x68\xdf\xe3\x92\x11\x3e\x50\x5e\x5b\xcb\xca\x04\xe0\x59\x3d\xc2\xa5\x1b\x6d\x3a\x18\x12\x00\x80\x8e\x9b\xad\x54\xe9\x5a\x13\xe9\x6e\x01\xaf\xaf\xfa\x87\x77\x09\x80\x4b\x00\xb2\xe8\x0e\x99\x56\xed\x3e\xfa\xd7\xba\xd5\x66\x01\xcf\xde\xbe\x7d\x7b\xee\xc7\x95\xd2\xc4\x49\x6a\x65\x4f\xe0\x17\xf0\xbf\xfe\x01\xae\xfc\x9f\x5f\x04\xb0\xd1\x8a\x52\x2b\x7f\xc7\x05\x5c\xbf\xee\x1f\x9e\x46\xa2\x26\x82\x85\x55\xf7\x28\xb7\x0d\x2d\x40\x69\xd3\xf1\xf6\xdd\x09\xa9\x37\x6f\xde\x8c\x86\x9e\x0b\x21\xd5\x36\x35\xa3\xef\xf5\xd5\x9f\xee\x21\x60\x78\x6a\x25\xc0\xbd\x36\x22\x5d\x1b\xe4\xbf\x2d\x20\x7c\xa5\xbc\x0d\xdb\xbb\xa4\xc8\x83\xe4\x65\x92\x14\xff\x4a\x53\xe8\xf9\x16\x81\x7c\x06\x21\x4d\xcb\xa4\x68\xae\xcb\xd3\x3c\x16\x79\x73\x5d\x26\x45\x0f\x75\xcb\xad\x1d\x93\x94\x76\x3b\x42\x11\xd2\x74\xdb\x20\xd8\x9e\x2b\x0b\x7a\x03\x74\xaf\x81\xc6\xec\x5b\x29\x10\xd6\xfb\xf0\xfd\x0a\x78\x2b\xb7\x0a\x85\x37\x50\x83\xd2\x80\xe2\x1d\x5a\xe0\x4a\x00\x35\x38\x3e\x25\x10\x20\xc2\x6b\xae\x6a\xb4\xa4\x8d\xcd\xe0\x06\x5b\xe2\x16\x3c\x21\xef\x4a\xb2\x43\xef\xc7\xc3\xae\x20\xd5\xb8\x21\xac\xa0\x93\x6a\xe7\xf5\xe7\x04\x52\x25\x10\xed\x55\x96\x14\x79\xef\xa3\x0d\x75\x14\x83\xf0\xf7\xa9\x54\xad\x54\xc8\x40\x8a\x25\x8b\x3a\x87\x62\x63\xd0\x21\x35\x5a\x2c\xd9\x77\xdf\xdc\x32\xe0\xb5\xcf\xec\xc1\x25\x44\x5d\x08\x79\x37\x09\xe2\x97\xa4\x5b\xa3\x77\x7d\x78\x05\x50\x48\xd5\xef\x68\x56\xd3\xec\xc4\xb5\xd6\x8a\x8c\x6e\x21\x78\xa5\xb6\x63\x21\xfc\x25\xe3\x0c\xee\x78\xbb\xc3\x25\x1b\x86\xac\x72\x8e\x41\xdf\xf2\x1a\x1b\xdd\x0a\x34\x4b\x16\xb2\x01\x15\x1b\x93\xb5\x64\xef\x6f\xa2\x5c\xb0\x91\xc6\xd2\x18\xee\x27\x32\x48\xbd\xa6\x27\x34\x7e\xe9\xb9\x3a\xa3\xe2\x8d\xf0\x42\xf7\x5e\x17\xde\xbe\x64\xe0\x0f\xc7\x92\x5d\x7f\x75\x81\x9c\x47\xbc\x40\x14\x48\x43\x14\xf4\x15\x48\x65\x09\xb9\xf0\x6e\x92\x2c\x18\xad\x29\x64\x37\x44\x53\xe4\x42\xde\x7d\x29\xcd\xd7\xf3\x60\x57\x67\x81\x8e\x9a\xaf\x2e\x85\x85\xb5\x56\xe2\x73\x88\xbe\x3e\x13\x7d\xf5\x59\x45\x9f\x33\xfd\x2b\xaa\xaf\x77\x44\x5a\xc5\xb0\xec\x6e\xdd\xc9\x63\x60\x6b\x52\xb0\x26\x95\xf6\x46\x76\xdc\xec\xc3\xbd\xed\xd8\xd4\x41\x8a\x7c\x5c\x5c\x26\x45\xee\x33\x56\xfa\x11\x70\x2f\xa9\x81\xec\x83\xd6\xe4\x5c\x52\x10\x5f\xb7\x38\xc1\x8d\x0f\xe1\x9a\x7a\xd2\xa8\x2c\x8a\x89\x70\x50\xb9\xa0\x06\xb9\x88\x7a\x93\x19\x6f\xfc\x6d\x53\x7a\xd1\x8a\x9c\x9a\xb9\xad\xe0\xd0\x18\xdc\x04\x55\xab\xec\xd7\x0f\x3f\x38\x77\x50\x2b\x98\xde\xdf\x38\xc7\xca\xaa\xc8\x79\x09\x2f\x3a\xfb\xf2\x29\x80\xd5\x39\xc0\x2a\x02\xac\x1e\x07\xf0\xc9\x9d\x56\x84\xde\x25\xd5\xb1\x57\xc5\xe7\x8a\x95\xa1\xcb\x45\x04\xbf\xa4\x7c\x12\x48\x04\x6f\x83\x2d\x27\x79\x17\xb2\x7a\x68\x8d\x27\x70\xcf\xcf\xd1\x8a\x7c\x94\xad\xc8\x0f\x52\x16\xb4\xd6\x62\xef\x8d\x00\xc3\x60\xb8\xda\x22\xfc\x3b\xfb\xa0\xef\xad\x73\xc1\x78\xa2\xb4\x80\x30\x3f\x96\x6c\x1a\x3e\x2d\x6e\x68\x01\xc3\x90\xdd\x60\x4f\x8d\x73\xd9\xff\xb1\x8b\x47\xc2\xff\x0f\x83\xdc\x84\x46\x9f\x55\x90\xad\x22\x62\x04\xb3\x64\xb4\xda\x96\xc3\x90\xfd\xc4\x3b\x74\xce\xcf\xa6\x60\x99\xad\xc6\xd6\x22\xc8\x0d\x64\xd5\xa5\xb5\x53\xe9\xf8\xa1\xcf\x2e\x00\xc1\xa8\x5b\xf4\x6a\xf9\x1a\x5b\x08\xd7\x54\xe0\x86\xef\x5a\x3a\xa4\x93\x1a\x69\xe3\x4c\xb1\xa0\x34\xcd\x46\x0b\x2b\x3d\xbc\x37\x54\x51\xcf\x8f\xf8\xfd\x5d\xcc\xaa\x23\xb3\xd5\x05\x66\x4a\xcc\x88\x85\x3c\x64\xd5\xf1\x67\xc4\xec\xdd\xe9\x31\x9c\xfd\xd4\x98\xe5\xf1\x58\x1b\x8f\x80\x9c\xd4\xc9\x64\x68\x7c\x4e\xbe\xc7\xbd\x73\xc7\x12\x9c\x3e\x05\x89\x72\x64\xf5\x5e\x55\xce\xf9\x03\xe9\xaf\x3e\xe1\xce\x15\x78\x18\xd6\x3e\x44\x56\x2a\xad\xb0\xc8\xb1\x2b\x63\x60\x45\x4e\xe2\x1c\xef\x3f\x86\x1b\xf3\xee\xf2\xbb\x69\xaf\x95\xdf\x25\x5b\x7d\xc2\x5e\xd3\x21\x9a\x3e\xd1\xed\x60\x29\xf2\x20\x68\x99\x5c\x76\x98\xc3\x8d\xc4\xc6\xa6\x58\x39\x37\x6f\x38\x67\xed\x66\x6c\x36\xc3\x90\xdd\xca\xb1\xc8\x79\x8c\xe9\x5b\x2e\x5b\x14\xce\x3d\x51\x53\xfe\x54\x1b\x56\x8e\x9e\xb1\x56\x22\xab\x0b\x41\xce\x59\xad\xfe\x31\xac\x1e\x69\x25\xbe\x27\x45\xf0\xe0\xb1\x25\xc8\xc6\x06\x78\xe5\x9c\x1f\xc4\x71\x9f\x63\x2f\x69\xcf\x3c\xec\xae\xae\xd1\xda\xb8\xa9\x17\xb9\x37\x52\xd1\x06\xd8\xf3\xff\x0a\x16\xbd\xcf\x34\xfa\x02\xdb\x7a\xe1\xaa\x28\xe5\x9c\x44\x76\xbd\x99\x68\xfc\x8c\xa6\x46\x45\x8f\x48\x74\xd2\x8f\x7c\x1e\x3f\xe2\x7c\xf2\x1c\x11\x4e\x26\xc3\xdc\x5a\xe4\x71\x36\x1c\x2a\x7a\x7a\x97\x0c\x03\x2a\xe1\x5c\xf2\xc7\x00\x00\xe0\x77\x2d\x08\x0e\x00\x00"),
+			uncompressedSize:  3592,
 		},
 		"/dashboard.html": &_vfsgen_compressedFileInfo{
 			name:              "dashboard.html",
-			modTime:           mustUnmarshalTextTime("2015-10-04T03:50:12Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x57\x5b\x6f\xdb\xbe\x15\x7f\xf7\xa7\x38\xe3\xbf\x43\xec\xce\x92\x9c\x04\x45\x80\x34\xf6\xd0\x2d\x1b\x56\x60\xbd\x60\x71\xf7\x52\xf4\x81\x96\x8e\x25\x36\x92\xa8\x91\x94\x2f\x33\xfc\xdd\xf7\x23\x25\x5f\x92\x74\x0f\x5b\x8a\x22\x11\x0f\x0f\xcf\xe5\x77\xae\xd9\xed\x32\x5e\xaa\x9a\x49\xcc\x95\x2b\x59\xec\xf7\xf7\xd2\x16\x0b\x2d\x4d\x46\x11\xc9\xa6\xc9\x70\xdc\xed\xb8\xce\xf6\xfb\xc1\xe0\xc4\xfd\x49\xaa\x5a\x78\xd2\x9d\x4d\x8d\x6a\x1c\x59\x93\x4e\xc5\x6e\x17\xff\x49\x5a\xfe\xf6\x8f\xbf\xef\xf7\xd6\x49\xa7\xd2\xc4\xb2\xda\x1a\x25\x93\x85\xd6\xce\x3a\x23\x9b\xc8\x96\x2a\x63\xf3\x82\x10\x57\xaa\x8e\x7f\x5a\x31\xbb\x4b\x3a\x91\xb3\xc1\x5d\xa9\xea\x47\x2a\x0c\x2f\x5f\x29\x3a\xb5\x56\x90\xe1\x72\x2a\xac\xdb\x96\x6c\x0b\x66\x27\x66\x03\x6f\xbd\x3f\xcf\x06\xbf\x65\xd2\xc9\xb9\x5c\xe0\xdb\xf9\x9f\xb4\x1b\x10\x25\x6f\xe9\xc1\x19\x76\x69\x41\x32\x35\xda\x5a\x4a\x75\xed\xe0\x38\x1b\x7a\x9b\x80\xa1\xd1\x56\x39\xa5\xeb\x5b\x92\x0b\xab\xcb\xd6\xf1\x7b\x50\x9d\x6e\x6e\x69\xe2\xbf\x16\xda\x39\x5d\xf5\x87\x92\x97\xae\xff\x34\x2a\x2f\xba\x6f\x1c\x2a\x69\x72\x55\xf7\x37\x8d\xcc\x32\x55\xe7\xe1\x04\x70\x93\xb7\xa0\xfd\x55\x6d\xd8\x92\xb2\xb6\x65\x5a\x17\x6c\x98\xd2\x52\xa5\x8f\x60\x23\x5d\x93\x84\x51\x65\x5b\x41\x2b\x59\x6d\x1c\x2d\xb6\xa4\x1c\xad\x75\x5b\x66\x94\xca\xd6\x32\xb9\x82\x3b\x9e\x1a\xc2\xd6\x2a\x73\x85\x67\xfe\xd9\x56\x0d\x65\x10\x89\x6f\x69\x8c\x5e\x53\xa6\xd7\x75\xd2\x36\xa4\xe0\x25\x15\x72\xe5\x15\xc8\xee\xc1\x00\xee\x9e\x20\x22\xdb\xc8\x3a\xd6\x06\xc8\x06\x9c\x32\x65\x9b\x52\x6e\x6f\x49\xd5\x08\x18\x47\x8b\x52\xa7\x8f\xef\x0f\xca\x0e\xbe\x9c\xbd\xdf\x3d\xc1\x0e\x71\x41\x38\x57\x27\xec\xa2\xeb\x77\xcd\x26\xbc\x89\x9d\xaa\xd8\xcb\x0c\x4f\x1c\x6f\x5c\x24\x4b\x95\xe3\x51\xca\xb5\x63\xe3\x99\x4e\x3c\x71\x17\xed\xc0\xdb\xab\xbe\x9c\x4c\x7e\xef\x99\x90\x53\x5d\xa0\x07\x77\xbf\x8b\x22\xc0\x9c\xc3\x71\x9f\xf0\x14\x45\xc8\xb3\xe2\x72\x76\x4c\xfb\xbb\x04\x27\xf0\x65\x6a\x05\xa0\xa5\xb5\x53\x71\xd0\x80\x94\x21\xf2\x02\xf0\x8b\x68\xfe\xe5\xfe\xcb\x10\x2a\x2b\x2b\xf3\xd1\x2d\x7d\xc8\x73\xc3\xb9\x74\xfc\xe0\x34\x62\xa4\x2c\xd5\xda\xc1\x39\x24\xa2\x4a\x1d\x67\x1e\xe8\x9b\xab\xa8\xd0\xad\xb1\x63\xc4\x0a\x71\x51\x36\x08\xb2\x85\x8f\x56\x7d\x81\xe0\x31\xb1\x42\xbc\x4c\x8c\x0b\x6f\x19\xd4\x15\xd7\xb3\x79\xaf\x1f\x50\x46\x37\x57\x14\x44\x90\xcc\x35\x4c\xbd\x0e\x3c\xaa\x6e\x5a\x47\x2a\x43\x7e\x07\x08\x04\xb9\x6d\xc3\x30\x1c\x88\x89\x83\x17\x3e\x6a\x57\x82\x56\xb2\x6c\x71\x25\xc8\xc7\xa3\x2f\x90\x08\xb5\x37\x15\x93\x67\x34\xb9\x99\x8a\x9b\xab\xa7\x44\xeb\xb8\x99\x8a\xcb\xa7\xc4\x5e\xe4\xf7\xc9\xf8\xe6\xea\x87\x48\x00\x68\x02\xf4\x3c\x88\x85\x49\x0e\x58\x7a\xeb\x8e\x29\xd0\x21\xd9\x95\x5a\x90\xe4\x74\x9e\x97\xde\xe2\x70\xdb\xd1\x5a\x53\x86\xba\xbf\xc7\x21\xd4\xfd\xd1\x95\xee\x61\xf8\x19\x21\x5b\x33\xae\x2d\x67\x22\x80\xd9\xd9\x85\x4a\x88\x6a\x59\x41\xe0\x87\x15\x1b\x44\xfb\xec\xf2\x5f\x2d\x9b\x6d\xd4\x48\x23\x2b\x88\x0a\xa7\xaf\xe1\x70\x2e\x80\xa5\x49\x0b\x28\x32\xed\xf9\x53\x44\x6a\x1d\xa1\x1d\x21\xaa\xff\xe5\xb2\x2b\x34\xfb\xf2\xd2\x5b\x14\x4a\x06\x28\xb0\x4d\x7b\x00\x0a\x96\xd9\x2c\x70\xdd\x39\xd3\x7d\x04\xf2\xe9\x91\xf7\xb1\x97\xd6\x11\x97\x8a\x4b\x40\xf9\x19\xde\xa1\x57\xfa\xa0\x76\x99\xdc\x91\x48\x2f\x43\xc1\x1b\xb4\xc0\x50\xa7\x34\xe4\x38\x8f\x69\xa9\x0d\xfd\x6d\x3e\xff\x8a\x84\x84\xc7\xd6\xd9\x9e\x0b\x3d\x6b\x24\x66\xfe\x25\x4a\x04\xec\xe8\xbe\xae\xf8\x9f\x0c\x39\x00\xfc\xd4\x96\x9e\x9a\x54\x1c\x68\x15\x87\xcb\x92\xeb\xdc\x15\x62\xd6\xdf\xd2\xb0\xb2\xa3\xff\x57\xef\x27\x0c\xa0\xa7\x3a\x41\x51\x55\x5b\x25\xb6\x92\x25\x9a\xbc\x7b\xa9\x17\x1c\xaf\xd3\x29\x37\xcf\x75\xca\x4d\xd0\x59\xa2\x8b\xff\x5a\xa5\xdc\xbc\x4a\xe5\x83\xcb\xee\x79\xf5\x4c\xeb\x83\x93\x75\xe6\x47\x74\xc6\x2b\x25\x7d\x1b\x0d\x71\x7f\xae\x1b\x6f\x63\xba\x3f\xb2\xbc\xc6\x8c\x79\x2f\xdb\x3e\xcf\xb9\xb6\x5a\xa0\xe5\x9e\x69\xf7\x9d\xa9\xef\x84\x99\x98\x1d\xdf\xbd\x50\x8c\xaf\x90\xf1\x9e\xd2\x57\xc1\x9d\x5b\xe8\x6c\x7b\xb4\xcb\x77\xea\xbf\x6c\x64\xd5\xf4\x4d\x82\x30\xcd\x96\x6d\x19\x72\xb9\x31\x70\x8b\xd7\x7e\x46\xf9\x44\xee\x1a\xc2\xb7\x8f\x47\x97\x8e\xc5\xe4\x0f\xd9\x2c\x71\x55\xf3\xc7\xa5\xd6\x53\xef\x19\x34\x66\x4f\xaf\x2f\x27\xef\x26\x2f\xa9\xd7\x93\xc9\x2f\xa8\x57\xcf\xc9\x07\x47\xfc\xbf\xbe\x67\x27\x47\x47\xf0\x19\xb6\x8a\x53\x4b\x3c\x2c\x37\x44\xcb\xb6\x4e\x43\x60\xce\x7a\xd0\x70\x14\xc6\x17\xa1\x4d\x1b\x72\x34\xa5\x37\x43\xf1\x5b\xdf\xd4\x47\xfd\x80\x1b\x5e\xe4\xec\xfe\xe9\x7b\xee\xc5\xe8\x7d\x60\xc6\x92\xd2\x9a\x9a\x76\x58\x6f\xa4\x71\xe2\x96\xdc\xf7\xc9\x8f\x31\x09\xac\x6d\xe1\x70\xf9\x63\xef\x19\xf7\x7e\xdf\x48\x12\xfa\x58\x63\xf4\x62\x90\xfe\x1b\xa3\xbc\x5b\x90\x06\x27\x85\x75\x5b\x96\x9e\xf9\x57\x8a\x77\x4e\xeb\xd2\x29\xcc\x68\x51\x80\x20\xf6\xa3\x58\xd7\x43\x91\x16\xb2\x46\x07\x18\x1f\x3d\x1a\xf2\xb9\x1b\x2b\x48\xe5\x38\x0c\x89\xb8\xe6\x75\xb0\xbc\xb3\x1b\x3a\x4e\xe3\xbb\xb8\x86\xa2\xc2\x55\xe5\x50\x9c\x06\x9e\xa0\x3f\xd0\x0a\xce\xe0\x97\x88\xba\xc3\x65\x38\x9c\xa6\xa0\x18\x0d\x82\x30\x38\xe6\xb7\x52\xbf\xa0\x61\x6f\xf1\x62\xd1\xdd\x08\xb9\xc2\x25\xb6\xa0\x35\xfe\xab\xb2\xf4\x23\x56\xda\xc7\x2e\x6f\xa4\x0b\xc9\x63\xd9\xa0\x1f\x61\xff\xa1\xaa\x4d\x8b\x83\xac\xca\x4f\x71\xf0\xd4\x7e\x9f\xaa\x99\x33\xb4\x4c\x1d\x87\xdb\xb4\xc4\x78\x98\x77\x0a\x86\xae\x8f\x81\xc7\xce\xb2\x3b\x90\x8f\x50\x1c\x90\xf0\xde\x5e\x9c\x2d\x41\x21\x2f\x2e\x46\xf1\x71\x59\x0d\xe4\xa1\xe8\xc7\x0b\xd0\xdc\x1d\xf3\xce\x2a\x94\x34\x36\x46\x9f\xbd\xe3\x9e\xba\xef\xf5\xee\xc7\x7e\xcb\x09\x07\x4f\x0a\x91\x7b\xa1\x3c\x2c\x92\xfe\xdf\x9f\x9f\xac\x8e\xa1\xaa\xba\xda\xf1\x1b\x60\x00\xc8\x70\xa6\x0c\xa7\x8e\xb6\xba\xf5\x3b\x4b\x28\x2f\x23\x53\xec\xa0\x7e\x6d\x1a\xfb\x75\xc5\xd7\x5d\x2f\xf0\x67\x6b\x3b\x14\x3f\x63\x1b\xd0\xeb\xd0\x03\x3b\x6e\x5f\xa6\x01\xe3\x1c\x63\xa6\xe9\x90\x0b\x6b\x73\x97\x5b\xcf\x90\x10\x21\x95\x2e\xc2\x6a\x1b\xc1\x98\x78\x61\xe3\x0e\xa2\x53\x5a\x61\x96\x8d\xbd\xa1\x63\x7a\xc3\x25\x57\x80\xe4\x04\x2e\x4c\xc2\x02\x1b\x63\xf5\x0c\xcd\x2e\xf6\x7f\x35\x20\x22\x5e\x12\x76\x87\x1e\xaa\x1e\xa5\xc1\xe9\x4f\x8c\xc1\xe1\x6f\x9b\xff\x04\x00\x00\xff\xff\x44\x93\x97\x2b\x09\x0d\x00\x00"),
-			uncompressedSize:  3337,
+			modTime:           mustUnmarshalTextTime("2026-10-17T01:28:02.951273244Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\x5f\x6f\xdb\x38\x12\x7f\xd7\xa7\x98\xe3\xf6\x10\xbb\x6b\x49\x76\x8a\xa0\x88\x23\xfb\xd0\xbd\xdc\x9f\x05\xb6\xbb\x45\x93\xde\x4b\xd1\x07\x5a\x1c\x4b\x6c\x29\x52\x4b\x8e\xec\xf8\x0c\x7f\xf7\x03\xa9\x3f\x76\x9c\x14\xd8\xdb\xbc\x24\x22\x39\x9c\xdf\xcc\x6f\x86\xe4\x8c\xf7\x7b\x81\x6b\xa9\x11\xd8\xbd\x24\x85\xec\x70\xb8\xe5\xae\x5c\x19\x6e\x05\xc4\xc0\xeb\x5a\x70\x57\xee\xf7\xa8\xc5\xe1\x10\x45\x47\xe9\xf7\x5c\x6a\xe6\xa7\x32\x97\x5b\x59\x13\x38\x9b\x2f\xd8\x7e\x9f\xfc\xc4\x1d\x7e\xfa\xf8\xcb\xe1\xe0\x88\x93\xcc\x53\x87\x72\x67\x25\x4f\x57\xc6\x90\x23\xcb\xeb\xd8\x29\x29\xd0\x3e\x99\x48\x2a\xa9\x93\xaf\x8e\x2d\xb3\xb4\x55\xb9\x8c\x32\x25\xf5\x37\x28\x2d\xae\x5f\xa8\x3a\x77\x8e\x81\x45\xb5\x60\x8e\x76\x0a\x5d\x89\x48\x6c\x19\x79\xeb\xfd\x78\x19\xfd\x20\x38\xf1\x7b\xbe\x52\xb8\x24\xff\x17\xf6\x11\x40\xfa\x1a\xee\xc8\x22\xe5\x25\xf0\xdc\x1a\xe7\x20\x37\x9a\xb8\xd4\x68\xe1\x75\x1a\x01\xd4\xc6\x49\x92\x46\xcf\x81\xaf\x9c\x51\x0d\xe1\x4d\x04\x40\xa6\x9e\xc3\xd4\x7f\xad\x0c\x91\xa9\xba\x81\xc2\x35\x75\x9f\x56\x16\x65\xfb\x1d\x01\x54\xdc\x16\x52\x77\x2b\x35\x17\x42\xea\x22\x8c\x0e\x51\x94\xbe\x8e\x00\xfe\x29\x1f\xd0\x81\x74\xae\x41\xd8\x96\x68\x11\x72\x25\xf3\x6f\x52\x17\x60\x34\x70\xc8\x8d\x6a\x2a\x20\x03\xce\x58\x82\xd5\x0e\x24\xc1\xd6\x34\x4a\x40\xce\x1b\x87\x40\x25\xb6\x32\x3a\x02\xd8\x4a\x41\xa5\x17\xfe\xda\x54\x35\x88\x06\xfd\x37\xb7\xd6\x6c\x41\x98\xad\x4e\x9b\x1a\x64\x6e\x34\x94\x7c\xe3\x01\x78\xbb\x21\x7a\x9d\x9e\x50\x04\xae\xe6\x3a\x31\x56\xa0\x0d\x3c\x09\xe9\x6a\xc5\x77\x73\x90\x5a\x49\x8d\xf1\x4a\x99\xfc\xdb\x4d\x0f\xd6\xfb\x72\xb2\x7f\xff\x88\x3b\x8b\x8a\x93\xdc\x1c\xb9\x8b\xdf\x5c\xd5\x0f\x61\x4f\x42\xb2\x42\xaf\x33\xe0\x10\x3e\x50\xcc\x95\x2c\xf4\x1c\x72\xd4\x84\xd6\x0b\x1d\x65\x92\x36\xda\xb0\x3f\x42\xcf\xa6\xd3\xbf\x06\xa1\xc2\x9a\xa6\x8e\x57\xbb\xb0\xd8\x52\x1e\x87\x40\xcd\xa6\x1e\xeb\xbb\xca\x87\x7d\x49\x5e\x62\xfe\x6d\x65\x1e\xe2\xd6\xcb\x53\x45\x6d\x68\x67\x9d\xd5\x59\xda\x25\x55\x94\xfd\x25\x8e\xa1\xe6\x05\x02\xf9\xc3\x05\x71\xbc\x8c\xb2\x72\xb6\x1c\x8e\x58\x96\x96\xb3\x65\x14\x65\x42\x6e\x20\x57\xdc\xb9\x05\xeb\xbd\x61\xcb\x08\xc0\x2b\x88\x00\x00\xee\x7f\xbb\xfd\x6d\xe4\x94\xac\x1c\x2f\xc6\x73\x78\x57\x14\x16\x0b\x4e\x78\x47\xc6\x22\x48\x07\xda\x10\x58\x74\x64\x65\x4e\x28\x7c\x50\xdf\x5e\xc6\xa5\x69\xac\x9b\x80\x33\x40\xa5\x74\x41\x91\x2b\x7d\x66\xe8\x0b\x82\x15\x02\x4a\x2a\xd1\x26\x11\x04\xcb\x00\xb2\xf2\xcd\xf2\xbe\xc3\x9f\xc3\x34\x7e\x7b\x09\x41\x05\xf0\xc2\x64\x69\xf9\x26\x98\x24\x75\xdd\x10\x48\xb1\x60\x2d\xdd\x0c\x68\x57\xe3\x82\x79\x02\x59\xef\x85\xcf\x90\x4b\x06\x1b\xae\x1a\x5c\x30\x06\x3e\xf6\xdd\x61\x8c\x2b\xa9\x17\x6c\x7a\x36\xc7\x1f\x16\xec\xed\xe5\xe3\x49\x47\x58\x2f\xd8\xec\xf1\x64\xa7\xf2\xf3\x74\xf2\xf6\xf2\x0b\x4b\x97\x51\x96\x0a\xb9\x39\x23\x71\x88\xda\xda\xd8\xaa\x0b\x58\x4b\xa8\xe2\x2b\x54\xb0\x36\xf6\x28\xc4\x96\xff\xf2\xe2\xb0\xda\x65\x69\x58\x0e\x82\x0e\x15\xe6\xad\x9f\x83\x60\xaf\x3e\x68\xf5\x77\x81\x35\x0a\x02\x1f\xb1\xab\x58\x1b\xe4\x05\x7b\xa7\xb5\xf1\x77\x9f\xd1\xb0\x2d\x8d\xc3\x96\x05\x07\x41\x4d\x38\x8d\x9e\x1d\x17\xec\x01\xc8\x4c\x1d\x44\x7b\xaa\x96\x77\x35\xd7\xa0\x79\x85\x59\xda\x2e\xb5\x72\xfb\xbd\xe5\xba\x40\x48\x82\xb1\x3f\xed\x0e\x87\xb3\x9d\xfb\x7d\x72\x38\xb0\x65\xf8\x77\xbe\xb5\xbd\xbe\x01\xb2\xb4\x75\xeb\x84\x8a\xce\xa5\xb3\xec\x3e\x3a\xd3\x67\x1a\x70\xa5\xc2\xc1\x77\x60\xb9\x4f\x1b\xa0\x92\x6b\x30\x5a\xed\xc0\x1a\x43\x8f\x9d\x0a\xa4\x74\x89\xd1\xab\x66\x81\x4c\xae\x54\xdc\x89\xc2\xbb\x5e\xa5\x37\xa7\xe7\x7e\x88\x67\x69\xd3\x3e\xac\x7e\xe3\x70\x7d\x04\x8c\xac\xbd\xa6\xfd\x64\x4c\xa6\x28\x3c\xf1\x61\xaa\xcb\x96\xc6\xaa\xf0\x66\xdc\x72\xe2\xe1\x39\x1a\x52\x33\x48\x41\xf8\x1b\xe7\x46\x0b\xd4\x0e\x05\x0b\x66\x87\x9d\xfe\x16\x8d\x3d\xff\x0b\xf6\x6e\x83\x96\x17\x78\xb2\xf8\x7b\x83\x76\x17\xd7\xdc\xf2\xca\x2d\x58\x18\x7d\x08\x83\x53\x05\xc8\x6d\x5e\x2e\x18\xd9\xe6\x74\xab\x2b\xcd\x36\xb6\xb8\xb6\xe8\xbe\xb3\xd8\x5e\xd2\xee\xe9\xa2\xb7\x28\x5c\xb7\x0b\x26\xd0\xe5\x1d\x01\x25\x72\xd1\xd1\x4d\xb6\xfd\xf0\x9f\xe5\x71\x93\xf7\xb1\xd3\xd6\x4e\xae\x25\x2a\xb1\x60\xbf\xf2\x0a\xd9\x32\xf3\x61\xe8\xe3\xec\xa7\xc0\xac\x43\x7a\x0e\xe1\x84\x11\x26\x45\xe2\x4f\x0b\xfc\xfb\xfe\xfe\x03\x58\xfc\xbd\x41\x47\xae\x93\x6a\x08\xc7\x13\x30\xb6\x4d\xf0\x7e\x37\x3f\xa6\x7f\xc8\x78\x14\xe0\xcf\x98\x07\xc8\x52\x0f\xb9\xcc\x52\x2a\xff\x2f\x7b\xfb\x38\x3c\x36\xb9\x9b\x4d\x2b\x0c\x73\x15\x86\x45\x85\xba\xa0\x92\x2d\xbb\x55\x18\x55\x6e\xfc\x67\x71\xdf\x4b\x7d\x86\xf9\x5e\x6a\x59\x35\x55\xea\x2a\xae\x14\x3a\x7a\x8a\xfb\x5e\xea\x97\x61\xf2\x87\x73\x4c\xfe\x10\x30\x15\xb7\xc5\xf3\x90\xfc\xe1\x45\x90\x77\x24\x6e\x71\x73\x86\x7a\x47\x5c\x0b\x5f\x05\x0a\xdc\xc8\x36\x9c\x66\xfd\x14\xfb\x8e\x44\x02\xb7\x83\xc8\x4b\xcc\xf8\x70\x35\x3d\xf7\x1c\x85\x7c\x2e\xb6\xf5\xd5\xf4\x45\x0e\x7f\xb8\x3e\x47\xba\x9e\x52\x09\x35\x5a\xff\xf2\x4b\x85\xcf\xba\x5a\x5f\xbf\x14\xf5\xea\x1c\xf5\xea\x8f\xa0\x5e\xbd\x10\xf5\xfa\x1c\xf5\xfa\x8f\xa0\x5e\xbf\x08\xf5\xbe\x53\xe8\xce\xb0\x7f\x6d\xaa\x15\xda\x53\x48\x07\xbc\x7f\x62\x04\x5b\x0e\xfb\x9e\x00\x67\x69\x7b\xc9\x65\xe9\x70\xf1\x65\xb4\x32\x62\x37\xd8\xe5\x8b\xad\x7f\x3c\xf0\xaa\xee\xde\x05\x68\x1c\xae\x9b\xf0\xd8\x43\x6d\x71\x23\x71\xeb\x4b\x5a\x7f\x47\x05\xbb\xe1\xd3\xcf\x83\x4b\xc3\xfd\xe9\xfd\x13\xcb\x94\xaa\xfa\x6f\x6b\x63\x16\x3e\x8a\x59\x4a\xe2\xf1\xf2\x6c\x7a\x35\x7d\x3a\xfb\x66\x3a\x7d\x66\xf6\xf2\x7c\xba\x77\x04\x60\x28\xbb\xd2\xc1\x91\x2c\x0d\xa6\x9d\xbc\x82\x7d\x2f\x04\xb0\x6e\x74\x1e\x0e\xd9\xc9\xb3\x33\x1a\x87\x3a\x14\x60\xc3\x2d\x10\x2c\xe0\xd5\x88\xfd\xd0\xd5\x65\xe3\xae\x1e\x1e\x5d\x14\x48\xff\xf1\x57\xf4\xc5\xd8\x57\xba\x00\x16\xa9\xb1\xba\xdb\x09\xc0\x1c\x71\x4b\x6c\x0e\xf4\x79\xfa\x65\xd2\x4f\xa2\x16\x61\x6a\x76\x9c\x1a\x0a\xa1\x79\xc0\x19\x86\xe3\x64\xc3\xd5\x68\x3c\xc8\x1d\xdf\xf8\x56\xf0\x38\x1e\x27\xd2\x8d\xd8\x3c\x94\x04\x28\x58\xb7\xe5\xe0\xcd\x3a\x44\xbe\xe5\x4a\xe1\x23\x1e\x0b\xa5\x40\x86\x6f\x7a\x74\x78\x72\x7a\x40\x68\xab\x18\xcf\x45\x5e\xfa\xaa\xc8\xf9\x1a\xf6\xd4\xa4\x09\x3c\x02\x35\x7a\xc4\x5a\x49\x36\x19\x78\x1c\xb8\x7b\x35\xba\x38\x69\x4e\x02\xe6\xc5\x38\x19\x9a\xc8\x50\x74\x8c\x58\xf7\x74\xb3\xc9\xc0\x9b\x93\x0a\x35\xcd\xc1\x27\x49\xe7\x48\x20\xf8\x30\xbe\xe9\x7c\xf9\x59\x4b\x92\x5c\xc9\xff\x22\x74\x9d\x68\x74\x0c\x95\x6e\x94\xba\x89\xe0\xd9\x90\xed\xc9\x18\x45\xb2\x9e\x03\x2b\xa5\x40\x76\xf8\x9e\x0f\x78\x9a\x00\x1b\x58\x00\xfa\x58\x34\x98\x68\xdc\x86\x98\xdf\x74\x2e\xb2\x63\x9f\x54\xbe\x61\xe3\xa4\xa4\x4a\x8d\xd8\xb1\xda\x67\xf0\x23\x6c\x3e\x4f\xbf\xc0\x8f\xc0\xe2\x76\x30\x0b\x83\x63\x0b\xc0\xc6\xde\xad\x10\x24\xdf\xfe\xfb\x4e\x18\x78\xb8\x3b\x4c\x43\xbe\x0e\x40\xe5\x10\xb6\x08\x5b\xa9\x94\xef\x2f\xb8\x0b\x5d\x2a\x95\x9c\x42\xfc\x1c\xda\x0d\x5a\x10\x06\xaa\x26\x2f\x7b\x5d\x95\x6f\x61\x42\x31\x29\x09\x34\xa2\x70\x40\xc6\x07\x14\x20\x57\xc8\xad\xb7\xd1\x34\x34\xa2\x2e\x7b\x3d\x77\x0e\xa9\x9f\x7e\x12\xce\x3f\x1f\xd0\xe7\x42\xda\x07\x15\xe0\x30\x81\xd9\x74\x7a\x1a\xe1\x57\x4f\xc1\x43\xc7\xee\x3f\xfe\xfe\xa8\x47\xf7\xd6\x74\xd9\xec\x5b\xed\x40\x90\x45\x21\xad\xef\x31\x76\xa6\xf1\x0d\x9b\x67\x88\x2c\xcf\xd1\x85\x9e\x71\x02\xbe\x28\x94\xba\xe8\x14\x7e\x6d\xfc\xdb\x5f\x22\xfc\x1a\x3b\x65\xb6\xa1\x12\x68\xa5\xfd\x05\x17\x38\x0e\xd9\xdf\x32\x17\x7e\x9f\xf0\x16\xb2\x73\x26\xda\xe3\x70\x11\x7e\x43\x88\xad\xd9\x26\x2b\x97\x04\xcb\x2e\x8e\x69\x05\x23\x9c\x80\x35\xdb\x09\xbc\x42\x85\x15\x6a\x3a\x92\xbb\x95\x5a\x98\x6d\xa2\x4c\x1e\xaa\x82\xc4\xff\x3c\x03\x0b\x2f\x9d\x7c\xfa\xf8\xcb\xcd\xa3\x73\x10\x1d\x7f\xcb\x89\xf6\x7b\xd4\xe2\x70\x88\xfe\x37\x00\xc4\x44\x25\xf0\x72\x12\x00\x00"),
+			uncompressedSize:  4722,
+		},
+		"/dependencies.html": &_vfsgen_compressedFileInfo{
+			name:              "dependencies.html",
+			modTime:           mustUnmarshalTextTime("2026-10-17T01:40:33.140528529Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x18\xdb\x8e\xdb\xb8\xf5\xdd\x5f\x71\xca\x64\x01\xb9\x91\x65\x7b\x12\xcf\xc6\x8e\xac\x62\xdb\x6c\x50\x14\x49\x5a\x6c\xa7\x68\x81\x6d\x1e\x38\xd2\xb1\xc4\x46\x22\x05\x92\xf6\x8c\x6b\xf8\xb5\x1f\xd0\x97\x7e\x50\xff\xa4\x5f\x52\xf0\x50\x92\xe5\x5b\x66\xf6\xc5\x96\xce\xfd\xce\x43\xed\x76\x19\xae\x84\x44\x60\x77\xc2\x96\xc8\xf6\xfb\xf7\x58\xa3\xcc\x50\xa6\x02\x0d\x8c\x80\xd7\x75\xc6\x4d\xb1\xdb\xa1\xcc\xf6\xfb\xc1\xe0\xc0\xf0\x89\x0b\xc9\x1c\x28\x36\x76\x5b\x22\xd8\x6d\x8d\x4b\x66\xf1\xd1\x8e\x53\x63\x58\x32\x00\x88\x1e\x84\xcc\xd4\x03\xec\x06\x00\x00\x15\xd7\xb9\x90\x23\xab\xea\x05\x4c\x27\xf5\xe3\x3b\x82\x3a\x86\x11\x2f\x45\x2e\x17\x90\xa2\xb4\xa8\x1d\x7c\x3f\x00\x78\x91\x6b\x5e\x17\x0d\xf3\x83\xc8\x6c\xe1\xf8\x26\xdf\x79\xbe\x02\x45\x5e\xd8\x05\xdc\x4e\x1a\x51\x3d\x96\xa8\x14\xf2\x6b\xc3\xb8\x12\x65\xb9\x00\xa9\x24\x7a\x3e\x63\xb5\xfa\x8a\x0b\x78\x31\x9f\xcf\xfb\x90\x91\xaa\x79\x2a\xec\x76\x01\x93\xe8\xed\x25\x79\xd1\x8a\x8b\x52\xc8\x1c\x76\xc7\x72\xb2\xf9\xec\xf5\x9b\xd5\x25\x8e\x51\xc9\xef\xb1\x3c\xb2\xe3\xc5\x6c\x36\xf3\x76\xac\x94\xb4\x23\x23\xfe\x89\x0b\x98\x4e\x8f\x83\x21\xd3\x42\xe9\x05\x54\x22\xcb\x4a\x3c\x15\x2c\x55\x86\x90\x0a\x9d\x96\x78\x24\xd9\x58\xc4\xf2\xbe\x5c\x9f\xba\xb9\x5a\xad\x8e\xdc\x6c\x02\x79\x73\x1e\x34\x27\xb9\x73\xf2\x82\x86\x2b\x9e\x3a\x36\x32\x1c\x76\x67\x8e\x9d\x6b\xa9\xb8\xfe\x8a\x1a\x6a\x6e\x8b\x63\xe1\x4d\x3a\xf6\x83\x78\x4c\xf5\x94\x0c\x06\xf1\xaf\x46\x23\xa8\x79\x8e\x60\x5d\x71\xc2\x68\x94\x0c\xe2\x62\x9a\xf4\x4b\x34\x1e\x17\xd3\x64\x10\xd7\x90\x96\xdc\x18\x5f\x7f\xa3\x6a\x6d\x31\xa3\x0a\xbc\x2b\x10\x0c\xea\x8d\x48\xd1\x80\x2d\xb8\x05\x5b\x20\x58\xcd\xdd\x7b\xcd\x8d\x03\x6a\xb5\xce\x8b\x10\xb8\xcc\x08\x99\xf2\xb2\x34\x70\x8f\xf6\x01\x51\x3a\x48\x15\x0d\x00\x7e\xcc\x72\x34\xc0\x35\x02\x25\x15\x33\x78\x10\xb6\x70\x68\x90\xeb\xea\x1e\x35\xa8\x55\xc3\xda\x08\x12\x1a\x2a\xcc\x04\x97\x50\x72\x8b\x32\xdd\xbe\x03\x74\x42\x06\x40\xaa\x3a\xab\x48\x0e\x6a\xad\xb4\x97\xaf\x31\x8b\x06\xf1\xb8\x76\x01\xc8\xc4\xa6\x75\xac\x69\xa5\x95\xd2\xd5\x48\xc8\x52\x48\x24\x07\x63\x32\x07\x56\x4a\xb7\x24\x2c\xb9\xf3\xee\xa9\x15\x99\x57\x72\x63\xe3\x31\x91\x11\x83\xc1\x12\x53\x0b\x22\xeb\x18\x5a\x15\x24\x3b\x55\xd2\x6a\x55\x82\x90\xf5\xda\x8e\x4c\xc5\x7c\xf0\x97\xec\x4e\x54\x08\x8d\x15\x6a\xd5\x8f\xe3\x43\xa1\x0c\x82\xa9\xb9\xf4\x1e\x98\x42\x3d\x48\xb2\x0e\x20\x56\xb5\x15\x4a\xc2\x86\x97\x6b\x5c\xb2\xd1\x74\x56\xb1\x64\x3a\x83\x4a\xc8\xb5\x75\xe9\xf3\xf8\x2b\xc4\x05\x03\x6f\x2e\x66\x49\xa1\xd6\xfa\xdb\xe4\xb7\x05\x4b\x6e\xc1\xd1\x3d\x21\xf7\xe6\x4d\xc1\x92\x9b\x37\xcf\x21\xfd\xfe\xa6\x60\xc9\xf7\x37\xe7\xa4\xf1\xd8\x5b\x96\x0c\xe2\x71\x26\x36\x6d\xb6\x5c\x58\xa9\xd2\x59\xd2\xc1\x2d\xbf\x2f\xb1\x0d\xb2\x7f\xa1\xdf\x51\xaa\x5c\x19\x1b\xcc\x18\xa5\x83\xca\x83\xe2\x16\xdb\x02\x79\xd6\x58\x64\xb5\x7f\x70\x8f\x45\xf2\x41\xab\x2a\x1e\xdb\xa2\x0f\xbb\x53\xa7\x90\xd8\x65\xa3\xcd\xdc\xe7\xe3\x02\x65\xc9\xef\xdc\x5f\x3c\x76\x34\xc9\x37\x39\xff\x84\xda\xcd\x64\xd7\x82\x5d\x79\x53\x17\xb9\x31\xe1\x5a\xec\x47\x5f\xb7\xc1\x77\xc3\xe7\x88\xfb\x61\x83\x9a\xe7\x38\xae\x90\x4b\x92\xd6\xb6\x06\x4b\x1a\x14\x04\x95\x79\x96\xa8\x4f\xbe\xb5\x8e\x85\xd4\xb3\xc9\xb3\x05\xcc\x27\xb6\x80\xda\xfb\x27\xca\xce\xbf\x9e\xb0\xf9\x2f\x10\x36\x7f\x52\xd8\xfc\xb2\xb0\x78\xec\xd3\x1b\x8f\xbb\x94\xc7\xf6\x5e\x65\x5b\xa7\x90\xfe\x07\xf1\x98\xaa\xc5\x55\x98\x49\xb5\xa8\x6d\xff\xa8\xfd\x07\xdf\x70\x0f\xa5\xc2\x59\xad\x65\xea\x2a\x14\x2a\x13\x48\x33\x6c\x46\xac\x46\xbb\xd6\x12\x3e\x71\x5b\x44\x5a\xad\x65\x16\x48\x03\x63\x98\xe2\xed\xb0\x1d\xcf\x1d\x63\xe3\x45\xa0\xb9\xc5\x13\x7e\x82\xc1\xaf\xdd\x21\x3c\x8c\xac\xfa\x20\x1e\x31\x0b\xa6\x8d\x88\x01\xc0\x78\x0c\xef\x35\x7f\xa0\xd1\x40\x3d\xe0\x82\xda\xcd\x39\x6e\x80\xbb\x39\x95\xe2\x28\x13\x9a\x7a\x1a\x4a\xbe\x55\x6b\x1b\xfa\x59\xca\x25\x70\xad\xfd\x8c\xf3\xd2\x90\xa7\x05\x0d\x4d\x58\x69\x55\x75\xd3\x19\x35\x58\x75\x78\xc3\xa8\x6f\x7f\xa6\xf9\x43\x90\xb7\x96\xbf\x0c\x98\x3f\xde\xd8\x30\xc2\xaa\xb6\xdb\x80\xcc\x05\xd8\x70\x0d\x14\x67\x58\x12\x91\xd3\x62\x3c\xe4\x94\x54\xac\x82\x9c\x4e\x39\x13\x95\x28\x73\x5b\xc0\x72\x09\x93\x56\xc3\xb1\x0e\x77\xfc\x04\xec\xb3\xea\x46\xa3\x73\xac\x0b\x81\x70\xe7\x89\x30\x60\x0f\xa3\x34\x62\x8d\x96\x36\xca\xfe\x6d\x3f\xe8\xac\x14\x32\xc3\x47\x58\xc2\x6e\xef\x51\x2f\x23\x17\x96\xd6\xa4\xb0\xf3\x3c\x10\x21\xc8\x21\xec\x3c\xc3\xcf\x32\x92\xbc\xc2\x2f\xb0\x04\xf1\x0e\xf6\x8d\x12\xe7\xb5\xdb\x4c\x8c\xf3\x3a\xaa\x78\x1d\xe4\x11\x79\xde\x13\xd3\x65\xbd\xcb\xfb\xce\xa8\xb5\x4e\x71\xd1\x48\xc6\xc8\x65\xe3\x4b\x08\x96\xeb\x1c\xed\x01\x6c\xd5\x97\x90\xd2\xb5\x00\x6c\x8c\x75\x8a\x3b\xcd\xb4\x7a\xc0\xf2\x28\x60\x04\x0b\x86\x61\xb3\xcf\x9d\x60\x3d\xb0\x9f\x33\xb3\xc9\x61\x09\xd9\xeb\xc8\x4f\xdf\x1e\x2d\xaf\xdd\x56\x10\x30\xb3\xc9\xd9\xb0\x71\x00\x20\xe2\xd6\xea\x80\x91\x1a\xe6\x0a\x2d\xb3\xc5\x19\xd6\xab\x61\xad\x11\x8d\x3a\xb3\xc9\x3b\xa1\x19\xae\x4c\x4f\x87\xdf\x62\xce\xd5\x88\x8c\x85\xc0\xa8\x8a\xcf\x91\x1b\x81\x0f\xbf\x55\x8f\x8e\x62\x02\xa3\x19\x4c\x27\x30\x9d\x9c\x93\x69\x5c\xfd\x8d\x85\x70\x73\x73\x86\xf1\x5a\xff\xda\xb8\x72\x7b\x05\xff\xfb\xd6\x99\x73\x02\xa5\x05\x4a\x87\x62\x7c\x6d\x55\xa7\xba\x73\xcb\x6d\x65\xe7\x06\x91\x4f\x9f\x26\xe1\x68\xf6\x71\x3a\x09\x27\x1f\x27\xe1\x8c\xf5\xd3\x4a\x3d\xed\x93\xe2\xfb\x39\x22\x48\xd0\x13\x44\xdd\xd3\x96\x6c\x0f\x4e\xb5\x18\xd0\x6f\x0f\xea\x96\xc7\xe0\x67\x4a\x55\x9b\x92\x2f\x27\x4c\xef\x85\xb1\x5c\xa6\x18\x4c\xdf\x4e\x7a\xa8\xb4\x70\x25\x19\x8c\x6e\x27\x7d\xa8\xb1\x5c\xdb\xa0\x6f\xb2\xd3\x08\x4b\xca\xb0\xaf\xa3\x1f\xca\x32\x60\x24\xb9\xef\x7f\xc6\x2d\x3f\xb6\x2e\xa2\x1b\x4a\x70\xa8\x04\x5a\xc4\x7a\x2c\x3e\x64\x74\xd6\xb3\x5e\x53\x95\xae\x31\x9b\x76\x2a\xa9\xe5\xa2\x66\xe5\x4b\x60\x02\xbf\x01\xe6\xb4\x40\xb3\x7c\x33\x58\x78\x00\x73\x9d\x7b\x2a\xdb\x57\xc1\x08\x25\xe5\x65\xad\xcb\xe0\x05\x15\xdc\xb0\x6f\x39\xad\xd0\x01\xeb\xef\xfc\xd7\xcc\xa1\x53\xa1\x12\x32\x98\xc2\x2b\x7f\x44\x94\x2a\x0f\x1a\x23\xdd\xf9\x6a\x86\x21\xbc\x1d\x1e\x86\x88\xb3\xac\xf3\x9f\x4e\xd3\xbe\x66\x9a\x80\x47\x8a\x3a\x9c\x2f\x17\x84\x25\x78\xe9\xed\xe0\xeb\x8d\x1a\x3f\x5b\xe0\x15\x30\xf8\xdf\xbf\xfe\x0d\x0c\x5e\x81\x9b\x2b\x0e\xf0\x77\xe9\xdf\xc8\x24\x07\xa0\xe9\x6f\x42\x22\x6a\x4f\xad\x26\xac\x3f\xd1\xe1\xf5\x0a\xd8\x77\x40\xef\x86\x98\x7b\xea\x00\x98\x5b\x16\x1c\x6b\x65\x02\x8c\xea\xd9\x64\xe8\x64\x56\x26\x84\x7a\xde\x47\xcc\xfb\x88\x79\x1f\x31\x6f\x10\xec\xe0\xc7\xe9\x9c\xfd\xe8\xb6\xee\x2b\x85\xe6\x6f\x87\xbf\xb0\xdc\x5c\x74\xaf\x97\x1b\xbb\x2c\xf7\x3c\x25\x27\xa5\x78\x08\xe9\x7f\xff\xd3\x7a\xd8\xa0\x0e\x81\xa1\x62\xec\xf5\x90\xeb\xe9\x0b\xae\x39\x70\x5f\x39\x39\x75\xd2\xf9\x67\x6e\xe5\xd7\x7d\xea\xec\x96\x3d\xbb\xe5\x49\xf7\x38\xe9\x47\xdd\xe3\x00\x27\xdd\xe3\x9c\x0c\x68\x32\x45\x99\xe6\x79\x93\x29\x47\xd8\x99\xe1\x2f\xbd\xe7\xb6\x68\x16\xc2\xf4\xe6\x12\xc7\xe5\x7c\xb8\x19\x3f\x3d\x1f\xbf\xd9\xd6\x75\x6c\xf4\x7a\x86\xd5\xf5\xf4\x1c\xbb\xe9\xce\xf0\x43\xe7\x1d\xab\xfe\x76\xe7\x9d\xcb\x39\x34\x91\x8c\xdc\xf2\xea\x33\x4e\x4f\xc7\x4d\x24\xaf\x34\x51\xbf\x02\x7c\x20\x95\x0c\x98\x15\xe9\xd7\x7e\x9e\x0e\x1d\xef\xaa\xb1\x8d\xc8\xf4\xda\xf8\x29\x23\xbf\x5d\x44\x8f\x47\xe9\xea\x82\xb6\x7d\x9a\x73\x7b\x99\xf3\xf1\xe6\x3a\xa7\x5f\x5d\xae\xea\x7c\x9a\x73\x7b\xc8\x0a\x1c\x5a\xbd\xd5\x7c\x8d\x3d\x38\x78\x0b\xaf\x7a\x66\x0c\x61\x0c\x37\x57\x6c\x79\x5a\xd6\xb6\x2f\x6b\x4b\xb2\x60\x04\x6f\xfa\x16\xfa\xca\x21\xe3\xac\xe6\xd2\xb8\xab\x7e\x5f\x70\xbf\x5a\x3c\x85\xbb\xba\x04\xae\x2a\x24\xd9\xca\x42\xff\xec\x74\xb1\x61\x53\x09\x47\x1b\x5e\xb7\x97\x9e\x2e\x94\x22\x84\xde\x4e\xf9\x32\x60\xb1\xd5\xc9\x61\x99\x6a\xe0\x0d\x26\x4b\xda\x2d\xda\x1f\x05\xc3\xf0\x3a\xde\xaa\x6f\x61\x9b\xa3\xeb\x1a\xc1\xa5\x13\xe3\x3a\x35\x9d\x05\xdc\xdf\x51\x9f\x22\x73\x23\xf3\x29\x92\xf9\x33\x48\xe6\xc3\xb6\x1c\xda\x58\xdd\xa9\x80\x6e\x28\xbd\xd0\x37\x57\x85\x36\xd8\x50\x2a\x9e\x75\x2d\xf8\x32\xca\xd1\xfe\xe1\xcf\x7f\xfc\x1c\xec\x76\xd1\x7b\x6e\xf9\x5f\x7e\xfa\xb8\xdf\x87\xb0\x63\xb4\x12\xb1\x85\xd3\xfc\xa2\xf9\x0a\x34\x8c\x36\xbc\x0c\x86\xfb\x90\xae\x50\xad\x6a\xfa\x20\x78\x98\x2a\x8f\x85\x3e\xa4\xf2\xd2\xd5\xe7\x03\x7d\x18\x70\xf7\x33\x67\x09\x5d\xd2\xb2\xf6\x73\xdd\x16\x88\x76\x41\xc3\xe6\xb1\xd0\x91\x46\x53\x2b\x69\xf0\x0e\x1f\xdb\xa5\xfb\xc8\xab\x23\xeb\xdc\xac\x49\x0b\x2e\x73\x64\x21\xb9\x49\x1c\x2f\x83\xe6\x31\x1e\xfb\x6b\x70\x32\x18\xec\x76\x28\xb3\xfd\x7e\xf0\xff\x01\x00\x5e\x24\x56\xf6\xdd\x16\x00\x00"),
+			uncompressedSize:  5853,
+		},
+		"/flame.html": &_vfsgen_compressedFileInfo{
+			name:              "flame.html",
+			modTime:           mustUnmarshalTextTime("2026-10-17T01:44:09.611532576Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x95\x5f\x6f\xdb\x36\x10\xc0\xdf\xf5\x29\x6e\x6c\x1f\x6c\x20\xb2\x66\x23\x4d\x80\x44\xd6\xd3\xd6\x61\x40\xb7\x87\x36\xfb\x00\x34\x79\x32\xb9\x50\xa4\x46\x9e\xe3\xa4\x02\xbf\xfb\x40\x4a\x72\x1c\xb4\x5e\xf7\x22\x50\xc7\xbb\xdf\xfd\xe3\x91\xc3\x20\xb1\xd5\x16\x81\x3d\x68\x32\xc8\x62\xfc\x68\x78\x87\xf0\x9b\xe7\xbd\x82\x12\x78\xdf\x4b\x1e\xd4\x30\xa0\x95\x31\x16\xc5\xab\xfe\x1f\x5c\x5b\x36\x8a\x08\xbb\xde\x70\x42\x60\xd9\x38\xdb\xe6\xad\x3a\xd0\x8b\x41\xa0\x97\x1e\xb7\x8c\xf0\x99\x2a\x11\x02\x6b\x0a\x80\xd5\x51\x5b\xe9\x8e\x30\x14\x00\x00\x1d\xf7\x7b\x6d\x4b\x72\xfd\x1d\xac\x7f\xee\x9f\xef\xcf\xa5\x3b\x47\xe4\xba\x3b\xd8\x9c\x36\x12\xa9\xe4\x46\xef\xed\x1d\x08\xb4\x84\x3e\x19\xc4\xa2\xae\xb2\xbf\xa6\x28\xea\x9f\xca\x12\x7a\xbe\x47\xa0\x94\x16\x94\x65\x53\xd4\x6a\xdd\x9c\x25\x37\x0c\xba\x85\xd5\x9f\xbc\xc3\x18\xc1\xb5\x30\x0c\xd3\xcf\x94\x6b\x5d\xa9\x75\x53\xd4\x3d\x08\xc3\x43\x18\xc3\x2f\xbb\x03\xa1\xcc\x09\x3c\xa8\xc4\xee\x10\x42\x8f\x96\x40\x5b\x20\x95\x7e\xb8\x0d\x13\xed\xc1\x73\x81\x21\x46\xa0\xb4\xc8\xee\x2c\xc2\x24\x86\x75\x8c\x61\xf2\x04\x1d\xfa\x3d\x4a\x20\xb7\x47\x52\xe8\xaf\x60\xf7\x92\x68\x05\x80\xe5\x1d\x66\xde\x2b\x9c\x5b\x99\x36\xb5\x07\x6e\x05\x06\x72\x3e\xac\x72\x34\x47\x2d\x49\x25\x5d\xe4\x42\x41\xeb\x53\xa6\x3a\x24\xdd\x1c\x68\x01\xaf\xa1\x6a\xca\x18\x4d\x01\x84\xd2\x46\x7a\xb4\xf7\x20\x8c\x16\x8f\xc0\x27\x4b\x72\xf0\xd5\xb9\x0e\xb4\x5d\x15\x75\xd5\xa7\x9a\xb6\xce\x77\x73\x35\xa6\xf6\x25\x51\xa9\xad\xd1\x16\x19\x74\x48\xca\xc9\x2d\xdb\x23\xe5\x12\xd5\xda\xf6\x07\x3a\xeb\x3e\x9b\xad\xb3\x99\x70\x96\xbc\x33\x90\xb5\xca\xd0\xb1\x9c\xed\x96\xa5\x2f\x83\x27\x6e\x0e\xb8\x65\xa7\xae\x30\xe8\x0d\x17\xa8\x9c\x91\xe8\xb7\xec\xb3\x73\x94\xab\x9d\x8d\x18\x04\xfd\x15\xb7\x6c\x73\xcd\xc6\x86\x8f\x94\xb9\x70\xfe\xa4\x3c\x09\x72\x47\x02\x2c\xb8\x31\xf3\x5a\xb7\x80\x5d\x4f\x2f\xcb\x31\xf4\x80\x06\x05\x4d\x11\x05\xe2\xfe\x87\xc1\x4f\x7e\x1f\x74\x97\x5a\x91\xab\xf3\xc6\x5b\xe6\x02\xd4\xae\x27\xed\xec\x9c\x5f\xb9\xfe\xd0\x31\xc8\x87\x03\xff\x81\xd5\x97\xe4\x09\x46\x69\x8c\x63\x10\x28\xa7\x73\xd2\x7c\xe2\x81\x60\xfd\x01\x3a\x6d\x0f\x84\xa1\xae\x46\xd6\x05\xb0\x9a\xb8\xce\xc3\xe2\x0d\x5b\xb1\xe5\x1b\x09\x5b\x5e\x70\xa5\xdc\xc1\xff\xb7\x93\x9b\xd9\xc9\xb9\x83\x1b\xc5\x2e\x00\x6f\x32\xf2\x07\x81\x6f\xae\xbf\x07\xdd\x5c\x5f\xa4\x6e\xae\xff\x0f\xf6\x76\xf3\x3d\xec\xed\xe6\x22\xf6\x76\xf3\x2d\xb6\xae\x46\xd5\xbc\x36\x7c\x87\x66\x3e\x15\x42\xa1\x78\xdc\xb9\xe7\xd3\x34\x4c\xe7\xe1\x17\xcf\x8f\xa7\x21\x83\x1d\x1a\x77\x9c\xc6\xb7\xe7\x1e\x2d\x9d\xce\xc5\xf9\xb0\xcc\x30\x06\x5a\x6e\x99\x16\x5a\x18\x64\x0d\xfc\x9e\x17\xc9\x75\x95\x7d\x27\xcb\x7a\x77\x20\x72\x76\x32\x0c\x87\x5d\xa7\x5f\x8f\xea\x8e\x2c\xec\xc8\x96\x12\x5b\x7e\x30\x94\xd7\xa1\x63\xcd\x17\xe5\x8e\x75\x35\x5a\x66\x08\x07\xe5\xb1\xcd\xe3\xf6\x31\x4d\x98\xfc\xeb\xf3\xa7\x18\xbf\xe1\x18\x6d\x1f\x67\xc8\x7c\xe0\xd3\x80\xb5\xf9\x5a\xdd\xa7\x7b\x7f\xbe\x09\xdb\x8c\x81\x40\x5c\x3c\x06\x48\x03\xcf\x29\xcd\xe3\xb9\x2a\x39\x67\xc2\x15\x1c\x35\xa9\x7c\x45\x85\x64\xdc\x69\xe1\x5d\x40\xe1\xac\x0c\xac\xf9\xf5\xb9\x77\x9e\xde\xd2\xea\x8a\x37\x45\x5d\x25\x66\xba\x96\xa4\x7e\xca\x65\xca\x64\xd6\xd4\x95\xd4\x4f\x49\x1e\x84\xd7\xfd\xf9\xf5\x53\xfd\xcd\x9f\xf8\x28\xcd\x45\x7f\xbf\x68\x0f\x56\xa4\xd6\x2e\x96\xd3\x43\xf4\xc4\x3d\x48\x4e\x1c\xb6\xe9\x3d\xc8\xaf\x45\x8c\xf7\xaf\x5b\xa9\x99\x5b\x38\x37\x83\xf6\xf4\xe4\x2d\xd8\xbb\xfc\xc3\xae\x32\xe3\x0a\xde\x2f\xd8\xbb\xa9\x79\xcb\x95\x0e\x0b\x76\x97\x1b\x8b\x92\x2d\x97\xf7\x30\x71\xdf\x28\x39\xbb\x60\x42\x71\xbb\xcf\x10\xcf\x8f\xcb\x51\x49\x7a\x7e\x5c\xe4\x75\x5c\xde\xa7\x77\x2e\x67\xd1\x14\xc5\x30\xa0\x95\x31\x16\xff\x0e\x00\xaa\x26\xbc\xce\xc8\x07\x00\x00"),
+			uncompressedSize:  1992,
+		},
+		"/flamegraph.html": &_vfsgen_compressedFileInfo{
+			name:              "flamegraph.html",
+			modTime:           mustUnmarshalTextTime("2026-10-17T01:44:09.610028441Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x57\xdd\x6e\xe3\xb8\x15\xbe\xf7\x53\x7c\x55\x77\x16\xd4\x58\x91\x6d\x04\xd9\x74\xec\x38\x8b\xc5\x02\xd3\x5e\xb4\xbd\xe9\x65\xdb\x0b\x46\x3a\xb2\xd8\x48\xa4\x40\xd2\x7f\x93\x18\xe8\xd3\xf4\xc1\xfa\x24\x05\x7f\x24\xcb\x86\x27\xe8\x62\x06\x33\xd1\xe1\xe1\xc7\xef\x7c\xe7\x87\xcc\xdb\x5b\x49\x95\x90\x84\xe4\x6b\xc3\x5b\xfa\xa3\xe6\x5d\x9d\x9c\x4e\x93\x27\x63\x8f\x0d\xc1\x1e\x3b\x5a\x27\x96\x0e\x76\x56\x18\x93\x3c\x4f\x80\xbc\x72\x8e\x77\x1b\xe7\x09\x4d\x85\xc5\xdb\x04\x00\x8c\xd5\xea\x95\x96\xf8\x7d\x55\x55\xab\x91\xe5\x6e\x2f\x4a\x5b\x2f\x31\xcf\x1f\xba\x43\x58\x28\xb6\xda\x28\xbd\x44\xa7\x84\xb4\xa4\x9d\xf1\x74\x8d\xec\xce\x8c\xc8\x95\x92\xf6\xce\x88\x6f\xb4\xc4\x62\xd1\x63\xc4\xbd\x77\xb4\x23\x69\xcd\x12\x52\x49\x72\x2b\xa7\xc9\xd3\xcc\x73\x7f\x9e\x4c\x9e\x4c\xa1\x45\x67\xc7\x51\xfc\x8b\xef\x78\xb0\xfa\x60\x66\x33\x54\x43\xdc\x28\x35\xdf\x1b\xd8\x9a\x82\x11\x81\x89\xaa\xa0\x95\xb2\x60\x1c\x56\x13\x41\x55\xa8\x34\x6f\xc9\x60\x2f\x6c\x0d\xc9\x5b\xca\x02\xd4\x8e\x37\x5b\xca\x60\xa8\xa9\xc0\x65\x89\xa2\x16\x4d\xa9\x49\xa2\x12\xd4\x94\x26\xc3\xbe\x56\x86\x60\x85\xdb\xcc\x35\xa1\x15\x85\x56\x86\x0a\x25\x4b\x93\x42\x48\xab\xdc\xe9\x01\x8c\x1a\x6a\x49\x5a\x50\x93\xe3\x6b\x38\xcf\x6d\x71\x1c\x25\xf8\x8b\xda\x91\xf3\x15\x1a\x1d\xd7\x4e\x82\x0c\x4a\xe3\x85\x1a\xb5\x77\xf6\x16\x42\x82\xcb\x00\x25\x0a\x51\x34\x31\x9c\x1c\xbf\x36\xa2\x78\x15\x72\x03\x1e\xe2\xc0\x37\xa5\x5a\x13\x4e\x17\x36\x0b\xcc\x7b\x1f\x27\xc6\x8b\xb2\x56\xb5\x51\x2d\xbf\x83\x29\x0d\xab\xba\x2c\x1c\x72\x81\x9f\x46\xb8\x17\x5e\xbc\x42\x6d\x6d\x3e\x01\xaa\xad\x2c\xac\x50\x72\xa4\x35\xa3\x26\xf3\xb2\x66\x71\x77\x1a\x93\xbd\xe3\x1a\x5a\xed\xff\x44\x62\x53\x5b\xac\xb1\xf8\x83\x13\x37\xfc\xa9\x85\xb1\x4a\x1f\xb1\xc6\xdf\xff\xb9\x9a\x78\xf3\x00\xdd\x1a\xb6\x35\x3d\x08\xa0\xc9\x6e\xb5\x04\xdb\x1a\xcc\xb0\x98\xcf\xe7\x69\x6e\xd5\x57\x71\xa0\x92\x2d\x52\x4c\x91\xb4\x26\x71\xe5\xe2\x0a\xc6\xff\x37\x9b\xa1\x50\x8d\xd2\x71\xa7\x01\xc7\x9e\xeb\x36\x1a\x6d\xcd\x2d\x44\xa8\x0d\xe3\x14\xa8\x94\x06\xed\x48\x1f\xa3\x22\xaa\x02\xf7\xa5\x90\x5f\xd2\xf2\xdb\x99\x5b\x38\x73\x73\x21\xd6\x58\x63\xbe\x8a\x86\x4a\x69\xe6\x8c\xc2\x1b\x21\xf0\x14\xa0\x1a\x92\x1b\x5b\xaf\x20\xa6\xd3\xf3\x6e\xf8\xbd\xac\xc6\x67\xdc\x2f\x30\x0d\x9e\x45\xcd\xf5\xaf\xaa\xa4\x5f\x2c\x13\x69\x8a\x4f\xf8\xf2\xe5\xb1\x47\x3f\x5d\x4a\x52\xde\xe7\xb5\x69\x58\x8d\x4f\x78\x78\xc8\x30\xcf\x1f\xfd\xbf\x0f\x0f\x98\xc2\x5b\x17\x8f\x69\xd0\xcc\x49\xf6\x37\xab\x85\xdc\xb0\xf4\x42\xab\x21\x3a\x57\x8c\xcc\xaa\xee\xcc\xee\x07\x46\x4d\x9a\x53\xdb\xd9\x63\xbf\x09\x10\x15\xfb\x9d\x55\x5d\xee\xfb\x63\x1c\x49\xf0\x76\xad\xce\x92\xbf\x2a\x98\x8e\xcb\xd8\x55\xbe\x47\xdc\x67\x9e\x0c\x30\x7d\x08\xd7\x81\x39\xe9\xfc\x90\xc1\x3a\x22\xfa\x2f\x96\xe2\xfd\x1d\x5f\x7e\x9a\x9f\x0b\x08\x30\x05\x6f\x08\xeb\xe8\x3f\xc3\x40\x6b\xec\x14\xdb\xdb\x95\xd9\xd8\x5c\x52\x67\x63\xde\xa2\x75\x36\xc3\x9f\xf9\xd1\x95\xb9\xaf\x8c\xb0\x2f\x03\xf1\xa2\x0e\xcd\x8f\x17\xda\x08\x29\x5d\x27\xed\x6b\xd2\xbe\x65\xd1\x69\xda\x09\xb5\x35\x50\x92\xce\x40\x24\x4b\x2a\x33\x54\x5a\xb5\xde\xab\xa1\xca\xba\x59\x23\xac\x89\x2d\x9e\x47\x67\x36\xe8\xdf\xf0\xa3\xda\x5a\x26\x33\x1c\x32\x94\x63\x65\x03\x95\xbc\xdb\x9a\x9a\xbd\x49\x55\xd2\x12\xce\x6b\xe9\x1c\xe3\x40\x96\x21\x70\x7c\x0e\xa2\x64\x21\xbe\x25\xca\xd3\x48\xf1\x3e\xe6\xbf\x70\x5b\xe7\x2d\x3f\x30\x6f\x70\x87\x9d\x7d\x7e\xc8\x5d\xc0\x4c\xe6\xc3\xbc\x7b\x7f\x77\xd2\x0d\x5d\xc0\x44\x86\x62\xcc\x0e\x3d\xf3\x22\x30\xc7\x14\x8b\x11\x20\x70\xc0\x74\x8d\xe2\x92\xe0\x79\xfd\x4c\xf0\x94\xba\xf2\xcb\x30\xcf\x30\x4f\x87\xb4\xb8\x82\xa8\xfb\x01\x12\x18\xfb\x13\xf0\xf9\x3c\x5a\x7a\x04\xe7\x6b\x76\x1b\xac\x51\xde\xe7\x86\x1a\x2a\xac\x2f\x21\xde\x75\x24\x4b\x96\x98\xdd\x26\x49\xa3\xaf\xfb\x9b\x73\x6b\x35\x4b\x8a\x86\x1b\x93\x64\x48\x46\x77\xd6\x2d\x3f\xaf\x75\x12\x35\xbf\xb1\x1e\x68\x26\x59\xe4\x3b\x04\xe6\x68\xf9\x1c\x62\x0d\xb3\xdb\x44\x66\xbf\x34\x0d\x4b\xae\xf8\x94\xdc\x72\xe6\x5d\xcd\xd9\x9e\x93\xbb\x58\xd9\x39\x8c\x9b\x41\x58\xcd\xa5\xa9\x94\x6e\x93\x51\xae\xaa\xcb\x44\x05\x85\xdc\xcc\x8d\x53\xfe\x67\x54\x79\x90\x74\xa4\x26\x96\xbd\xe0\x77\x60\x55\xfe\xb1\xe4\x17\xe3\x28\x90\x68\xb8\x25\x96\x60\x8a\x2a\x3f\xb8\xe9\x9c\xb9\x9f\x8f\xee\xa7\x34\x8e\xe9\x3e\xdf\xa3\x8f\x5c\x49\x97\x08\x51\xbc\x7e\xc8\x5f\x54\x03\xa3\xf5\x1a\x73\xfc\xf8\x63\x7f\x91\xc4\x01\x8b\x67\xcc\xaf\x37\xc1\x3f\x06\x58\xef\xd8\xa9\x8e\xa5\x43\x72\x22\x17\x50\x63\x68\x0c\x7f\x13\x67\x80\x70\xad\xe8\x66\xe5\xea\xd6\x41\x55\xee\x9a\xf4\x6a\xad\x1f\x70\x97\x35\xef\x73\x3d\x24\xd6\xbd\xc0\x3e\x2a\xbc\x0b\x5d\x7a\xd1\x87\x6e\xae\x72\xef\xe7\x2f\x80\x74\x85\xd3\x47\x15\x7a\x4e\xf6\x1d\x16\x17\x8e\xfe\xbd\xc5\x92\x4a\x34\xcd\xf7\x4e\x0c\xf7\x60\x88\x32\x77\x57\x56\xba\xfa\x6e\x48\x56\xd8\x86\x2e\x63\xf2\x57\xc4\x05\xf0\x68\x35\x94\xa8\xc4\x1a\x01\xff\x66\x9d\x49\x7f\xaa\xab\xa8\x7f\x48\x57\x5c\xad\x61\x71\x00\xfa\xe7\x00\x7c\xf5\xb1\xc5\x7c\x8e\xcf\xc3\x64\x1c\x5d\x0f\xd7\xcf\x87\x4f\x69\x7c\xe7\x0d\x58\xee\x2b\x5d\xfd\x1f\x19\x73\xb1\xdc\xca\xd8\x21\xc9\x70\x7f\xc3\x7e\xbc\xd2\xfe\xe1\xb7\x2a\xe3\x1e\x07\xa6\x9f\xe1\x55\xa3\x94\x66\x7d\xe2\x71\x87\x9f\xdc\x6d\xff\x78\xc1\xdc\xdf\xd8\x61\xd7\x13\xee\xaf\x21\xcf\xad\x9b\x7c\xbf\x5c\x63\x4e\x9c\xe4\x7d\x5a\x7c\x02\x56\x93\x1b\x40\xa3\xc7\x0e\x9e\x23\xdd\x9f\xc3\xc3\xc6\x6c\x5f\x4c\x78\x82\xcc\xb3\xb8\xe2\xaa\xcf\xa5\xe0\xbf\xff\xfe\x4f\x82\x25\xae\x51\x7b\xd9\x43\xf3\xf8\xe6\x72\xef\xcc\x74\xf8\xe5\xc0\xbf\xfe\x9f\x27\x6f\x6f\x24\xcb\xd3\x69\xf2\xbf\x01\x00\x4d\x90\x7c\xdc\x02\x0d\x00\x00"),
+			uncompressedSize:  3330,
 		},
 		"/layout.html": &_vfsgen_compressedFileInfo{
 			name:              "layout.html",
-			modTime:           mustUnmarshalTextTime("2015-10-04T03:50:12Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xbc\x59\x7d\x73\xe3\xb6\xd1\xff\xff\x3e\x05\x8e\x97\x8c\xed\x3c\x26\xe9\xb7\x7b\xb3\x25\xe5\xb9\x9e\xd3\xdc\x75\x9a\xf8\x26\xe7\x64\xa6\xcd\x64\x32\x10\x09\x8a\xb0\x41\x80\x05\x40\xcb\x8a\xe3\xef\xde\x05\x40\x90\x20\x25\xd9\x6e\x93\xa9\x35\x63\x91\xc0\x62\xf7\xb7\x2f\xd8\x5d\x40\x77\x77\x39\x29\x28\x27\x28\xfa\xe1\xe2\xe2\x32\xba\xbf\x7f\x36\x79\x7e\x7e\xf1\xfe\xf2\x1f\x9f\xbe\x41\xa5\xae\xd8\xec\xd9\xc4\x7d\x21\x34\x29\x09\xce\xcd\x03\x3c\xce\xb1\x22\xa8\x94\xa4\x98\x46\x77\x77\xc9\x5f\xe0\xed\xc7\x1f\xfe\x7e\x7f\x1f\xb5\xd3\x9a\x6a\x46\x66\x77\x77\x9a\x54\x35\xc3\x1a\xb8\x5f\x9a\x91\x08\x7d\x71\x7f\x3f\x49\xdd\xac\xa3\xac\x88\xc6\x28\x2b\xb1\x54\x44\x4f\xa3\x46\x17\xf1\x9b\x28\x9c\xe2\xb8\x22\xd3\xe8\x86\x92\x65\x2d\xa4\x8e\x50\x26\xb8\x26\x1c\x48\x97\x34\xd7\xe5\x34\x27\x37\x34\x23\xb1\x7d\xd9\x47\x94\x53\x4d\x31\x8b\x55\x86\x19\x99\x1e\x7a\x46\x8c\xf2\x6b\x24\x09\x9b\x46\x14\x96\x47\x48\xaf\x6a\xe0\x49\x2b\xbc\x20\x69\xcd\x17\x51\xab\x48\xaa\x34\xd6\x34\x4b\x0b\x7c\x63\xe8\x12\x3b\x95\x86\x3c\x5a\xba\x94\x13\x9d\x73\x9c\xcc\x85\xd0\x4a\x4b\x5c\x67\x39\x4f\x32\x51\xa5\xdd\x40\x7a\x9c\x1c\x27\x87\x69\xa6\x54\x3f\x96\x54\x14\xa8\x94\x8a\x1c\x14\xa5\x57\x8c\xa8\x92\x10\xbd\x0e\x33\x98\xeb\x64\x82\x8c\x2b\x95\x64\x4c\x34\x79\xc1\xb0\x24\x56\x20\xbe\xc2\xb7\x29\xa3\xf3\x40\x4c\xac\xf1\x9c\x91\xf4\x30\x79\x95\x1c\x8c\x47\x3b\x08\x6b\x4a\xed\x94\x5a\xd7\xa7\x69\x5a\x80\x79\x55\xb2\x10\x62\xc1\x08\xae\xa9\xb2\x52\x60\xc5\xd7\x05\xae\x28\x5b\x4d\x2f\x6a\xc2\xff\xef\x33\xe6\x6a\xc7\x22\xdd\xe9\x91\xee\x38\xb3\xee\x68\x72\xab\xcd\x8a\x9d\x27\x69\x55\xe1\x5b\x63\xbc\x35\x4b\x1a\x1c\x31\x5e\x12\x25\x2a\x92\x9e\x80\x31\x0f\xac\x31\xc3\xe1\xb1\x32\x96\xbd\x7b\xb6\x91\x8b\xee\xdc\x33\x42\xb5\x50\x10\x17\x82\x9f\x1a\x1c\xe0\xe2\x1b\x72\xe6\xa7\x80\x47\x5c\x12\xba\x28\xf5\x29\x3a\x3c\x38\xf8\xb2\x9d\xb8\x77\x5f\x73\x91\xaf\x02\x36\x38\xcf\x29\x5f\xc4\x5a\xd4\xa7\xe8\xe5\x41\x7d\xdb\x71\x99\xe3\xec\x7a\x21\x45\xc3\xf3\x38\x13\x4c\xc8\x53\xf4\xa2\x38\x32\x9f\x8e\xc2\x0f\x1f\xdb\xbf\x6e\xd8\xea\xe3\x4c\x7b\x8a\x76\x8c\x71\x91\x35\xee\x3e\x52\xf0\x15\x2b\x22\x69\x71\xf6\xcc\x53\xa7\x5f\xa1\xef\xb0\x5c\x50\x0e\xc0\xb4\x16\x15\x9a\xaf\x80\x83\xd0\x44\x22\xa7\x03\xfa\x2a\xed\x14\xb3\x84\xb1\x23\x3c\x45\xaf\x7a\xb8\xad\x6e\x49\x7e\x80\xee\x36\x21\x9f\xcf\xe7\x67\x3d\xd1\xe1\x66\xa2\x2c\x9b\xbf\x9e\xbf\x0e\xe8\x8e\xb6\xd1\xe1\xd7\x38\xa4\x3b\xde\x46\xf7\x16\xfe\x02\xba\x93\x6d\x74\x6f\x8e\xde\x1c\x05\x74\x2f\xb7\xd1\xbd\x7e\xf5\xfa\x55\x40\xf7\x6a\x1b\xdd\x49\x71\x52\x04\x74\xaf\xb7\xd1\x1d\xbf\x3d\x0e\xf1\xbd\xd9\x46\x77\x84\x8f\x70\x40\xf7\x76\x1b\xdd\x21\x7c\x42\x3b\x6f\xf1\x46\x96\x1d\x90\x03\x62\x08\x9f\xf9\x18\xf8\x1e\xf2\xd3\x02\x9b\x80\x86\x05\xd2\x85\x96\xea\x5c\x9f\x70\x7c\x03\xc3\x31\xe5\x37\x04\x92\x6a\x1f\xbe\x1b\x98\x8f\xa2\x71\x2e\x64\x4e\x60\x9c\x0b\x4e\xba\x60\xd9\x26\xd6\xee\xeb\x47\x64\xfb\x77\xf8\x9a\x31\x3a\xc3\x3d\x98\x8d\xdb\xe4\xfe\x69\x5c\x4e\x4b\x01\x13\xfb\x8f\xd3\x15\x22\x6b\xd4\xba\xcc\x3c\xcf\x9f\x2e\x30\xc1\x99\x49\x18\x33\xfc\xb0\xbc\x8e\xec\x29\xe0\x7a\xe2\xcd\x08\xe7\x0c\x5c\xf5\xe4\xe4\xd2\x2a\xf1\x82\x89\x85\xe8\x59\xd9\x8a\x78\x8a\x70\xa3\x45\xc7\xc9\x27\xba\x93\x30\x77\xb9\x44\x01\x09\x6d\x6d\x2c\x96\x6d\x5e\x24\xd5\x28\x1a\x12\xa8\x8f\xb1\x53\xa8\x97\x68\x73\x99\xa2\xbf\x91\x53\x74\x1c\x0a\x78\x20\xfb\xda\x4c\x1a\x9f\x04\xc4\x05\x13\x18\x44\x32\x52\xe8\xb3\x71\xde\x6d\xe1\x24\xc7\xeb\x78\xda\x2c\xb8\x21\xe3\xe3\xb9\x12\xac\xd1\x24\x08\x72\x97\x11\x0f\xce\x46\xa6\x0a\xd2\xbf\x8d\xf7\xcf\x44\x23\x5d\x12\x54\xd0\x5b\x92\xfb\x04\x2b\x0a\x37\xe6\xb3\xae\x24\x41\xce\xf5\xf6\x7d\xf5\x58\x6d\x78\x69\x3e\xbd\x15\xa0\x62\xc6\x98\xd1\x05\xc0\xcd\xa0\xb7\x21\x72\x14\x9e\xad\xb4\x60\xfb\xd8\x25\x39\xc9\x84\xc4\x4e\x4d\x60\x4f\x24\x6c\x49\x32\x58\x3a\x49\x83\xa2\x38\x51\x99\xa4\xb5\x46\x4a\x66\xb6\x9f\x10\x39\x49\xae\xfe\xd5\x10\xb9\xb2\x15\xd7\x3d\xc6\x47\xc9\x21\x7c\xae\xa0\xaa\xc2\x62\xbb\x60\xe3\xea\xa7\x76\x40\x57\xe3\x06\xe8\x51\xce\x7f\x5a\x9f\xf3\x47\x25\xe5\xc7\xa0\xc3\x49\x72\x02\x0f\x8f\xf1\x0a\x5b\xe0\xb6\x89\xbc\xa2\xb8\x6c\x30\x5f\xc0\xea\x58\xd3\x8a\x18\xdf\x84\xcf\xff\x05\xcb\x6b\x49\xd5\x75\x5a\x34\x8a\xd8\x7f\x4f\x51\x72\x03\x97\xdf\x88\x14\x19\xa3\xf5\x5c\x60\x99\x8f\xde\xfe\x09\x6f\xef\xfd\xdb\x46\xfe\x93\xd4\x1f\x02\x26\xa6\x39\x6a\x45\x42\x2a\x40\x19\xc3\x4a\x4d\xa3\x36\x2b\x8c\xb2\x5f\xfb\x6a\xb7\x92\xe9\x9f\xa0\x07\x16\xd0\xa1\x1b\xea\xb6\xa6\xb4\x5d\x1c\x30\xcb\x69\xc7\xcc\x34\xfb\x18\x8c\x25\xbb\xd9\xe1\x7c\xcb\xd6\x40\x1a\xd0\x18\x74\x0d\x6c\x73\xde\xb6\xfa\xee\x25\x1a\x2d\xd3\x62\x01\x5d\xae\x49\xba\x0c\xd7\x8a\xe4\x11\xca\xb1\xc6\xed\xb0\x11\xee\xc6\xfd\x30\xe4\x44\x73\x44\x79\xe1\x56\x47\x08\x4b\x8a\x63\x72\x5b\x63\xd8\x7a\xf9\x34\x2a\x30\x33\xb4\x76\xd4\xe0\x06\x05\x3b\x51\x03\x68\xc6\x45\xb0\xc8\x83\x51\x32\x16\x9c\xad\xa2\xd9\xa5\x83\xd3\x9b\x04\xec\x0e\x74\x0f\x2c\x35\xa7\x94\xd8\xb2\xff\x5f\x91\x4e\x52\x67\xca\xc1\x18\xde\x74\x10\xf4\xdc\xea\x86\xb1\xd8\xa4\x73\x60\x47\xab\x05\xa2\x60\x2a\x53\xa9\xa2\x76\x17\xb6\x51\x69\x86\x7e\x5d\x96\x54\x13\x7b\xec\x02\xd1\x38\x70\x79\x0a\x3e\x1f\x45\x80\xe1\xe3\x3d\xd1\x45\x8b\x73\x98\x8f\xb6\xee\xdd\x62\xb0\xd5\x63\x18\x23\x0d\x0b\x22\x02\xf5\x05\x1a\xa8\x86\xe6\x79\x1e\xc7\xe8\x52\xe2\x8c\x28\x94\x4b\x51\xe7\x62\xc9\x51\x45\x78\x83\xe2\x78\x64\x48\x46\x3d\x4b\x4f\x38\xf2\x7d\x68\xb0\x17\xd1\x98\xb8\x0d\xbe\x51\x24\x76\xac\xda\x5d\xe3\xc3\x79\x63\x04\x82\x99\x3d\xd7\x02\xa3\x02\xc7\x90\xd9\x20\x22\xe1\x84\xad\x51\x5f\xb6\x8d\x85\xe9\xcc\x2b\x35\xf0\x7f\x06\x0b\x74\xe7\xfc\x81\x23\xd6\x0d\xd7\x01\x37\xe6\xf0\xf8\xec\xf3\xda\x2a\x6b\x9d\x59\xa7\x7c\x8e\x55\x69\xf3\x0c\x9c\xc7\xcd\x7d\x00\xec\x84\x52\x2c\x95\x2d\xaf\xfd\xdc\xec\xdc\x3f\x1a\x20\x13\xc8\xcc\x8f\xf1\xd5\x56\xa5\x11\x53\xcc\x18\x72\x13\xfb\x88\xdc\x66\xac\x31\x0d\x05\xc2\x8b\x85\x24\xb0\xd7\xa0\xbc\x43\xc7\x0b\x99\xee\x1d\x90\x39\x93\x6c\x95\x36\x49\x1b\x36\x1a\x74\x74\xe3\x38\x18\xaf\xec\x00\x9a\x93\xb6\x82\xa3\xf6\x42\xe4\x22\x4b\x84\x5c\xa4\x4a\x34\x32\x23\x0b\x28\x5f\xa5\xad\x41\xc1\x7b\x8a\xeb\xda\x58\x03\xf4\x69\xf3\xcf\xaf\xd0\x1d\xf2\xeb\x0d\xe6\x1d\xfb\x1d\x6a\xe2\xf5\xba\xc7\xcf\x45\xa6\xc6\xc8\xc6\x2e\x5e\x57\xfc\x49\xfa\x50\x5d\x36\xf3\x3f\x51\x01\xc7\x70\x5d\x85\x6f\xa9\xfe\xd0\xcc\xff\x53\x25\x86\x8e\x73\x29\xc5\x6c\xec\xd4\x74\xe6\x7d\xae\xe8\x77\x74\x90\x75\x26\xa9\x69\xdb\x9d\x8b\x1f\xae\x4f\xe1\x95\xd7\x77\x30\x65\x6f\xbc\x9e\x05\xec\xdc\x73\xdb\xd1\x79\x75\xed\xdb\xd3\x2b\x60\xed\x67\x6d\x1b\x58\x41\x77\x0b\x1b\xe5\x9d\xb3\x33\xa2\x10\xed\x1c\x89\x9a\xf0\xd8\xb9\x01\xd5\x52\x5c\x91\x4c\xa3\x0c\x12\x81\x89\xf5\xf9\x6a\xdd\x79\xe3\x10\x8c\x66\x9f\xfb\x11\x63\xdb\x64\x92\xd6\x1b\x2d\xe3\xc0\x7b\xc5\xc2\x4e\x04\xa1\xdd\xa2\xe1\x99\x29\x65\xbb\x7b\x7d\xef\x8a\xd2\x14\xfd\x15\x0a\x76\xd8\x48\x53\xee\xef\xee\xd8\x2a\xe9\x08\xbf\xd8\x8d\x7c\xef\x5b\x47\x7b\x49\x49\x73\xb2\xbb\x97\x14\xb0\xf6\x23\x70\xec\xef\x45\xd0\x0d\x74\x1c\x25\xe5\x5a\xa1\x29\xfa\x39\xf0\xf9\x8e\x37\x0a\x98\xe0\x06\x56\x83\x69\xd0\xb7\x02\x7d\xb8\xbc\xfc\x84\x2a\x9a\xe7\x8c\x2c\x21\xd9\x19\xe9\x06\x0b\x18\x65\x14\xa3\x7f\x64\xc7\xa6\x66\x95\xcd\x39\xd1\x6c\x6d\xc8\x58\x14\xce\x35\xd9\x35\x5e\x90\xe7\x3b\xfb\x21\xe4\x8f\xe0\x28\x70\xe0\x9c\x20\xe8\xf1\x72\x54\x48\x51\x3d\x8e\xec\x01\x3c\xdb\xf0\xfd\x7f\x85\x15\x58\x36\x4d\xb4\x24\x24\xad\x57\xba\x34\x4d\xd8\x12\xf6\x1c\x98\xe3\x93\x7d\x45\x40\xca\x68\x66\x9b\x11\x9b\x11\xe1\xc0\x26\x9e\xa3\x77\xee\xfe\x6d\x84\xfb\x03\xd8\xff\x14\x41\xf7\x98\x5d\x3b\x6b\x42\x3f\x2e\xf8\x62\xf6\x5e\xd4\x2b\x84\x15\xfa\xdb\xe7\x8b\xef\xcd\x79\xc4\x0e\x22\xdf\x9e\x09\x48\xc7\xe6\x62\x17\x1c\x63\x0d\xe3\x29\x21\x86\x73\xa4\x4a\xeb\x1d\x8d\x0c\x2a\xb4\x02\x1d\xc0\x1c\x94\xf0\x5c\x6d\x94\x7d\x19\x48\xfd\x89\xc8\xb9\x80\xed\x7c\x0e\x75\x14\xfd\x44\xc9\xb2\x17\x0d\x27\x04\xd4\x57\x85\xf6\x40\x67\xea\x2d\xc8\x56\x22\xa3\x76\x8f\x58\x89\x66\x22\x6b\xa4\x84\x33\x19\x32\xc5\x30\x79\x4c\xea\x27\x29\x0a\x0a\x3d\xdc\xba\x40\x46\x20\x38\x41\x03\xa4\x08\xe9\x74\xbd\x6a\x94\x46\x8c\x5e\xdb\x08\xc4\x26\x48\xcd\x6a\xf9\x80\x61\x8d\x4f\xf8\xca\x82\x41\xbb\x16\x9e\x39\x52\x02\x5e\x09\x1b\x1c\x4e\x1b\x8c\xa8\x3d\x63\x54\x8e\xa5\x14\x4b\xc3\x16\x56\x80\x01\xad\x35\x89\xb1\xa5\x42\xe6\x5a\x3d\x36\xfa\x6e\x94\x73\xc1\x07\xde\xf3\xd5\xd0\xeb\x52\x43\xc0\x5a\x3d\x4c\x8c\x2a\xc2\x4c\x5e\x31\xcc\x5b\x2f\x56\x0d\xd3\xb4\x06\x0b\xb8\x62\xeb\xbd\xb9\x51\xd2\xc7\xaa\x75\xbc\xf5\xb7\xb3\x88\x8d\x76\xcc\x05\x40\x90\xa8\xcb\x68\x1c\x9a\x44\x0e\xb3\x90\xb8\x32\x63\x06\x53\xbb\x3d\xc0\x96\xcb\xc6\xe8\x12\x8f\xeb\xf2\x7c\xa7\x03\xf6\x4b\x90\x50\x20\x45\x71\xc8\xad\x06\x28\x98\x56\x37\x92\xbb\xc6\xc4\x0c\xda\x4c\xe3\x73\x86\xcb\x3a\x60\x6d\xbc\x82\x77\x0c\x93\xa0\x32\xe0\x87\xfd\x4b\x78\xc8\xae\x96\xe4\x86\x8a\x46\xb1\x15\xca\xa9\x82\x0a\xb1\x22\x79\x32\x48\x60\x66\xbb\x7f\xf0\x49\xec\x97\xb3\xc1\x1c\xa4\x7b\x07\x66\x8a\x38\x34\xb3\xfd\xa4\x4f\xb0\x1d\xdc\x5d\x1a\xa6\x5a\xb7\x9a\xc3\xb2\xef\xb0\x2e\x93\x82\x09\x21\x77\x77\xed\xb3\x04\xb7\x89\x0a\x12\xf3\x57\xe8\x90\xbc\xdd\x43\x5f\x3a\x5d\x12\x46\xf8\x42\x97\x61\x76\x75\x19\x9b\x4a\x08\x56\xe8\xcf\xdd\x8d\xc3\xd7\xe8\xb3\x36\x1d\xa5\xdd\x27\x18\xa4\x2f\x91\x63\x08\xf8\xaa\x39\x38\x0f\x8c\xc3\x93\x80\x05\x2d\x76\x29\x9a\x3a\xf8\xe8\xf7\xdf\x91\x7d\xf1\x6a\x0d\x21\xa3\xd6\xe4\xbd\x4e\x7c\xef\x2c\x98\xbf\x1f\x41\x7b\xcf\xc8\xc0\x7c\xce\x1b\x4b\x00\x60\x42\x1f\xea\x61\x01\x32\x47\x58\x3a\xea\x56\x5f\x34\x9b\x0e\xf5\x1f\x21\xda\xe6\x9c\x75\x34\xe7\x04\x6c\x54\x99\x5f\xd4\x68\xd1\x85\x08\xa8\x6b\x02\xc3\x04\x85\x4b\xeb\x98\x41\x31\xce\x57\x21\xaa\x2f\x12\x82\xb3\xb2\x47\xb6\xdf\x39\x77\xb7\xe1\x66\x14\x5a\xd7\x31\x2c\xd0\x84\x18\x43\xd2\xf1\x84\x85\xf2\x63\x20\x69\x1f\x76\xd8\xaa\x8f\xe1\x81\xb3\x92\xd1\xd2\x87\xcd\xdf\xdd\x4b\xb9\xe7\x61\xa4\x04\x81\x4a\xc3\x45\xbd\xbd\xeb\x46\x95\x10\xa4\xe1\x64\x2b\x2f\x58\x10\x18\xb5\x8b\xf0\x76\xdb\x58\x44\x7b\xdd\x74\xa8\xf6\xa8\x5f\x30\x8d\xc2\x45\xa3\x37\x37\x21\x8e\x1e\x8a\x9d\x82\xc6\x42\x57\x6c\xd7\x7a\xff\xe7\x5e\x67\x88\x99\xbd\x5f\xc2\x6e\x63\xa8\xf3\xba\x2d\x14\xd1\x1f\xcd\xe5\xdd\x0d\x66\xbb\x01\xd6\x7d\x73\xb1\x78\x70\xd0\x2d\xb9\xdf\xf3\xcc\x86\xf7\x2a\xee\x3a\x65\x92\xba\x5f\x5b\xef\xee\xa0\xcc\x41\xe7\xf8\xac\xff\x7d\xd6\x65\xba\x6f\x6c\xa2\x8d\x6c\x53\x69\xcf\xa5\xd4\x0e\xc7\x3e\x01\xf7\xa7\xd2\xee\x98\x0c\xe7\xf2\x8f\xe7\xc1\x91\xbc\xbb\xd8\x68\x5b\x38\x7b\xba\x05\xbd\xdf\x41\xa0\x74\xfd\x2f\xb4\xa8\xb2\x6a\xcf\x73\xe6\x71\x63\x77\x6a\x26\x62\x73\xb9\x59\x07\xed\xe9\xdd\x9d\x4d\x0a\x89\xfd\xc5\xf7\x3e\x8c\x95\x09\xc3\x73\xc2\xa0\xe7\x93\x06\x44\x05\x58\x75\x07\xca\x1e\xd7\xa2\x19\x60\x35\xbf\x10\x5b\xc2\x90\xa5\xb3\x46\xc7\xc8\x34\xbe\xe6\x5c\x6b\xef\xb7\x81\x15\x94\x1c\x0d\x40\x45\x51\x44\x03\x6c\xed\x45\x0c\xf2\xf4\xe6\x84\xba\x84\xc9\x97\x46\x92\x83\xf9\x13\x66\x0d\xc0\xb4\x82\x5b\x39\x93\xd4\xd3\x6f\xe9\x78\x65\xe5\x7f\x06\x97\x69\x60\xc4\x77\x2e\x50\x21\x23\x99\x72\xc5\xda\x32\xa4\x7a\x9b\x06\xa6\x9b\x6b\x73\xd8\x17\x2c\xbc\x1d\xf2\x90\x1c\x9f\x50\xdf\xb6\xa0\xf5\x6b\x91\x59\x0f\x91\x81\xa1\xe0\x06\xf7\x1b\x08\xdb\xa5\xd1\xf0\xde\xcb\x5b\x75\x78\x77\x33\x34\xea\xd3\x45\x64\x56\xb9\x91\x88\xa7\x5d\x9d\xf9\x48\x9c\x39\x03\x0d\x11\x85\x36\x2e\x9d\x5d\xdb\x21\x8f\xf4\xdf\x01\x00\x00\xff\xff\x3e\x94\x1a\x08\xac\x20\x00\x00"),
-			uncompressedSize:  8364,
+			modTime:           mustUnmarshalTextTime("2026-10-17T01:45:23.82465713Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x7b\x73\xdc\xb6\x11\xff\x5f\x9f\x62\x4d\x3b\xa3\x53\x2a\x92\x92\x25\xbf\xce\x77\x97\xba\x76\x12\xbb\xd3\x44\x9e\x58\xc9\x4c\x9b\xc9\x64\x96\xe4\xf2\x08\x09\x04\x58\x00\x3c\xe9\x72\xb9\xef\xde\x01\x48\x90\xbc\x87\x2c\xb5\xc9\xd4\x9a\xf1\xe1\xb1\xd8\xfd\xed\x03\x8b\x05\xb8\x5a\x65\x94\x33\x41\x10\xfc\x70\x71\x71\x19\xac\xd7\x07\x93\x47\xef\x2e\xde\x5e\xfe\xf3\xe3\xd7\x50\x98\x92\xcf\x0e\x26\xcd\x0f\xc0\xa4\x20\xcc\x6c\x03\x60\x92\xa0\x26\x28\x14\xe5\xd3\x60\xb5\x8a\xfe\x86\x9a\x7e\xfc\xe1\x1f\xeb\x75\xd0\x4e\x1b\x66\x38\xcd\x56\x2b\x43\x65\xc5\xd1\x10\x04\x97\x76\x24\x80\x27\xeb\xf5\x24\x6e\x66\x1b\xca\x92\x0c\x42\x5a\xa0\xd2\x64\xa6\x41\x6d\xf2\xf0\xa5\x67\xe2\xa6\x04\x96\x34\x0d\x16\x8c\x6e\x2a\xa9\x4c\x00\xa9\x14\x86\x84\x99\x06\x37\x2c\x33\xc5\x34\xa3\x05\x4b\x29\x74\x9d\x63\x60\x82\x19\x86\x3c\xd4\x29\x72\x9a\x9e\x7a\x46\x9c\x89\x6b\x50\xc4\xa7\x01\x4b\xa5\x08\xc0\x2c\x2b\x9a\x06\xac\xc4\x39\xc5\x95\x98\x07\xad\x22\xb1\x36\x68\x58\x1a\xe7\xb8\xb0\x74\x91\x9b\x8a\x87\x3c\x5a\xba\x58\x90\xc9\x04\x46\x89\x94\x46\x1b\x85\x55\x9a\x89\x28\x95\x65\xdc\x0d\xc4\x67\xd1\x59\x74\x1a\xa7\x5a\xf7\x63\x51\xc9\x44\x94\x6a\x1d\x34\x50\xb4\x59\x72\xd2\x05\x91\xd9\x85\x39\x98\xeb\x64\xa6\x99\xb8\xd2\x51\xca\x65\x9d\xe5\x1c\x15\x39\x81\x78\x85\xb7\x31\x67\xc9\x40\x4c\x68\x30\xe1\x14\x9f\x46\xcf\xa3\x93\xed\xd1\x0e\xc2\x8e\x52\x87\x85\x31\xd5\x38\x8e\x73\x29\x8c\x8e\xe6\x52\xce\x39\x61\xc5\xb4\x93\x92\x6a\xfd\x55\x8e\x25\xe3\xcb\xe9\x45\x45\xe2\x2f\x9f\x50\xe8\x43\x87\xf4\xb0\x47\x7a\xd8\x98\xf5\xd0\xd0\xad\xb1\x8a\x1f\x3e\x48\xab\x12\x6f\xad\xf1\x76\x2c\x69\x71\x84\x78\x43\x5a\x96\x14\x9f\x47\x67\xd1\x89\xe5\xb9\x31\xbc\xad\x8c\x83\xd2\x08\x75\x91\x0b\xab\xa6\x0d\x50\x49\xcd\x0c\x93\x62\x6c\x31\xa3\x61\x0b\x7a\xed\xa7\x4a\x26\xc2\x82\xd8\xbc\x30\x63\x38\x3d\x39\xf9\xa2\x9d\x58\x37\x3f\x89\xcc\x96\x03\x36\x98\x65\x4c\xcc\x43\x23\xab\x31\x3c\x3b\xa9\x6e\x3b\x2e\x09\xa6\xd7\x73\x25\x6b\x91\x85\xa9\xe4\x52\x8d\xe1\x71\xfe\xd4\xfe\x75\x14\x7e\xf8\xcc\xfd\xeb\x86\x9d\x3e\x8d\x69\xc7\x70\x68\x8d\x0b\xce\xb8\xc7\xa0\x51\xe8\x50\x93\x62\xf9\xeb\x03\x4f\x1d\x7f\x09\xdf\xa1\x9a\x33\x01\x89\x34\x46\x96\x90\x2c\x21\x97\xd2\x90\x82\x46\x07\xf8\x32\xf6\xb4\xa5\x23\x0c\x1b\xc2\x31\x3c\xef\xe1\xb6\xba\x45\xd9\x09\xac\xf6\x21\x4f\x92\xe4\x75\x4f\x74\xba\x9f\x28\x4d\x93\x17\xc9\x8b\x01\xdd\xd3\xbb\xe8\xf0\x05\x0e\xe9\xce\xee\xa2\x7b\xf5\xea\xd5\xab\x01\xdd\xf9\x5d\x74\x2f\x9f\xbe\x7c\x3a\xa0\x7b\x76\x17\xdd\x8b\xe7\x2f\x9e\x0f\xe8\x9e\xdf\x45\x77\x9e\x9f\xe7\x03\xba\x17\x77\xd1\x9d\xbd\x3a\x1b\xe2\x7b\x79\x17\xdd\x53\x7c\x8a\x03\xba\x57\x77\xd1\x9d\xa6\xa7\xe9\x80\xee\xf4\x0e\x6f\xa4\xe9\x09\x9d\x90\x25\x3c\xf0\x31\xf0\x3d\x2e\xd8\x1c\x6d\x40\x43\x82\x0a\x1c\x4b\xdd\xb9\x3e\x12\xb8\x48\x50\x85\x4c\x2c\x48\x69\xea\xc3\x77\x0f\xf3\xad\x68\x4c\xa4\xca\x48\x8d\x41\x48\xe1\x77\xc8\xdd\x62\x5d\xb6\xba\x47\xb6\xef\x0b\x5c\xcc\x38\x9b\x61\x0f\x66\xef\x36\x59\x3f\x8c\xcb\xb8\x90\x0b\x52\xc7\xf7\xd3\xe5\x32\xad\xf5\xae\xcc\x2c\xcb\x1e\x2e\x30\xc2\xd4\x26\x8c\x19\x1e\x3f\x8c\xec\x21\xe0\x7a\xe2\xfd\x08\x13\x8e\xe9\xf5\xeb\xcf\xf8\x6d\x9f\xd5\x1e\x73\x39\x97\x3d\x2b\x77\x22\x8e\x01\x6b\x23\x3b\x4e\x3e\xd1\x9d\x0f\x73\x57\x93\x28\xc6\xf0\x6c\x67\x2c\x54\x6d\x5e\xa4\x72\x2b\x1a\x22\x96\x4a\x6b\x66\x1b\x07\x9d\x44\x97\xcb\x34\xfb\x8d\xc6\x70\x36\x14\xf0\x99\xec\xeb\x32\x69\x78\x3e\x20\xce\xb9\x44\x33\x06\x4e\xb9\xe9\xc6\x7c\xde\x6d\xe1\x44\x67\xbb\x78\xda\x2c\xb8\xda\x95\x89\x89\x96\xbc\x36\xbd\x4c\x9f\x11\x4f\x5e\x6f\x99\x6a\x90\xfe\x5d\xbc\x7f\x22\x03\xa6\x20\xc8\xd9\x2d\x65\x3e\xc1\xca\xbc\x19\xf3\x59\x57\x51\x17\xfc\xbd\x7d\x07\xc9\x76\xbf\xfb\x9e\xe5\xcf\xf2\x67\x1d\x85\x3d\x31\x43\xe4\x6c\x2e\xc6\x90\x92\x30\xa4\x36\x3d\xeb\xb5\x1b\x6c\x1f\xb7\x24\xa3\x54\x2a\xb7\x21\xc7\x50\x8b\x8c\x14\x67\x83\x7d\x6b\x7f\x26\xf1\xe0\x50\x9c\xe8\x54\xb1\xca\x80\x56\xa9\xad\x61\x52\x99\x51\x74\xf5\xef\x9a\xd4\xd2\x9d\xb8\x4d\x33\x7c\x1a\x9d\x46\xa7\xd1\x95\x0e\x66\x93\xb8\x59\xb0\x77\xf5\x43\x2b\xa0\xab\xed\x02\xe8\x5e\xce\x7f\x5a\x9d\xf3\x47\x25\x65\x67\xf1\x59\x74\x1e\x9d\xc7\xd9\xd9\x7d\xa8\x87\x25\x70\x5b\x44\x5e\x31\x2c\x6a\x14\xf3\x38\x3b\x0b\x0d\x2b\xc9\xfa\x66\xd8\xfe\x1f\x58\x5e\x2b\xa6\xaf\xe3\xbc\xd6\xe4\xfe\x7b\x88\x92\x7b\xb8\xfc\x46\x4a\xa6\x9c\x55\x89\x44\x95\x6d\xf5\xfe\x45\x4a\xbe\xf5\xbd\xbd\xfc\x27\xb1\xbf\x04\x4c\x6c\x71\xd4\x8a\x14\xb8\x80\x94\xa3\xd6\xd3\xa0\xcd\x0a\x5b\xd9\xaf\xed\xba\xad\x64\xeb\xa7\x00\x94\xe4\xe4\xa8\xdb\x33\xa5\xad\xe2\x00\x26\x19\xeb\x98\xd9\x62\x1f\x99\x20\xd5\xcd\x6e\xce\xb7\x6c\x2d\xa4\x0d\x1a\x8b\xae\x36\x46\x8a\xb6\xd4\x6f\x3a\xc1\xd6\x32\x23\xe7\x73\x4e\x36\xe9\x72\xac\x34\x65\x01\x64\x68\xb0\x1d\x9e\x06\x7e\xdc\x0f\xa3\x9a\xdb\x2b\xca\xe3\x66\x75\x00\xa8\x18\x86\x74\x5b\xa1\xc8\x28\x9b\x06\x39\x72\x4b\xeb\x46\x2d\x6e\x25\x79\x27\x6a\x03\x9a\x8d\xc3\x0a\x85\x07\xa3\x55\x28\x05\x5f\x06\xb3\x4b\x27\x17\x7a\x93\x4c\x62\x5d\xa1\xf8\xcc\x52\x7b\x4b\x09\x1d\xfb\xff\x17\xe9\x24\x6e\x4c\xb9\x31\x86\x6d\x35\x3f\x0c\xb6\xce\xd6\x55\xcd\x79\x68\xd3\x79\x30\x9b\xb0\x72\x0e\x2c\x9b\x06\xf6\xa4\x0a\xda\x5d\xd8\x46\xa5\x1d\xfa\xf5\xa6\x60\x86\xdc\xb5\x6b\x36\x89\xb1\x97\x31\x89\x33\xb6\x18\x74\x6d\x84\xb0\xac\x33\xae\x17\xe5\x1d\x06\xad\x7f\xbb\xbe\xc3\xe0\x4e\x8f\xcd\x18\xa9\xb9\x5f\x6a\x03\xb8\x5d\x25\x70\x11\xcc\xda\x73\xa5\xfd\x9b\x3c\x0a\x43\xb8\x54\x98\x92\x86\x4c\xc9\x2a\x93\x37\x02\x4a\x12\x35\x84\xe1\x90\xa1\xbb\xed\x78\x96\x9e\x70\xcb\xf7\x43\x83\x3d\x0e\xb6\x89\xdb\xe0\xdb\x8a\xc4\x8e\x55\xbb\x6b\x7c\x38\xef\x8d\xc0\xd9\x84\x79\xae\x39\x42\x8e\x21\x2a\xc2\xd0\xde\xb0\x0d\xf4\xc7\xb6\x75\x2e\x9b\x79\xa5\x36\xfc\x9f\xa2\x22\xd3\x39\x7f\xc3\x11\xbb\x86\xeb\x80\x5b\x73\xf8\x5d\xed\xda\x3b\xab\x9c\x75\x66\x9d\xf2\x19\xea\xc2\x65\x9d\x00\xdc\x7b\xc0\x34\xd0\x85\xbc\xd1\xee\x78\xed\xe7\x66\xef\x7c\xd3\x02\x99\xc4\x9c\xdd\xc7\xd7\x38\x95\xb6\x98\x22\xe7\xd0\x4c\x1c\x03\xdd\xa6\xbc\xb6\x05\x05\xe0\x7c\xae\x68\x8e\x86\x32\x90\x82\x74\x30\x7b\xc3\x79\x6b\x92\x87\x4a\xcb\xa8\x22\x91\x91\x48\xd9\x8e\x4c\xab\x88\x26\x65\xdf\x27\xac\x56\xd8\x54\x13\x0d\x0a\xa8\x50\xdb\x41\x25\xeb\x79\x11\xcc\xde\x0d\xb8\x3c\x54\x72\xce\xb1\xa4\x2d\x91\x37\xae\x20\x71\x62\x58\x49\x20\x73\x50\x64\x2b\x0a\x2f\x95\x69\xd0\x15\x09\x73\x0c\xa8\x01\xc1\xb1\x80\xb9\xc2\xaa\x08\x66\xdf\xb8\xce\xb7\xb6\x73\x27\x84\x49\x5c\xf3\xad\xc1\x86\x6e\x63\x6c\xb2\xbb\xb2\x43\x6d\x9f\x19\xf4\x38\x8e\xe7\x32\x93\x69\x24\xd5\x3c\xd6\xb2\x56\x29\x39\x10\xee\xa8\x1f\xf4\x63\xac\x2a\x1b\x0a\x01\xf8\xe4\xfb\x6b\xc2\x51\x5c\xef\x6e\x29\xd8\x0e\xfa\x44\xca\xeb\xdd\x70\x7f\x27\x53\xbd\x8d\x6c\x3b\xbe\x77\x15\x7f\x90\x3e\xcc\x14\x75\xf2\x27\x2a\xd0\x30\xdc\x55\xe1\x5b\x66\xde\xd7\xc9\x7f\xab\xc4\xa6\xe3\x9a\x7c\x6a\xb3\x5a\x6c\xaf\x25\x7d\xa2\xec\xd3\xd9\x20\xe5\x4e\x62\x7b\x67\x39\x38\xd8\x3e\x7c\x77\x0f\xe7\xe1\x7b\xdf\x77\xc8\x84\x7b\xee\x6b\x79\x38\x76\x4d\xbb\x2d\x67\xbd\xba\xae\xd7\xf1\xf8\xbc\x04\x80\x49\xe5\x67\x5d\x0d\x5c\xd6\x86\xb2\x60\xf6\xa6\xb1\x33\x30\x0d\x28\x40\x56\x24\xc2\x26\x8e\xa0\x52\xf2\x8a\x52\x03\xa9\x22\xb7\xd1\x93\xe5\xae\xf3\x06\x1e\x73\x1e\x0c\x66\x9f\xfa\x11\xbb\x19\xa2\x49\x5c\xed\xb5\x4c\xa3\x8a\x57\x6c\x58\x86\x01\x8c\xf2\x5a\xa4\xf6\x1c\x1f\x1d\xf5\x85\x3b\xc4\x31\x7c\x83\x19\x0d\x6f\x11\x4c\xf8\x87\x4b\xbe\x8c\x3a\xc2\x27\xa3\xc0\x17\xfe\x55\x70\x14\x15\x2c\xa3\xd1\x51\x94\x63\x46\x1f\xc4\xe8\xa8\x7f\x14\x82\x05\x2a\x28\x98\x30\x1a\xa6\xf0\x73\x37\x0a\x70\xe8\x8d\x52\x29\xb9\x60\x19\xd9\x1d\xff\xad\x84\xf7\x97\x97\x1f\xa1\x64\x59\xc6\xe9\x06\x15\x01\x13\x0e\xcb\x04\xb7\x63\xf4\x8f\xec\xd8\xd8\x9a\xd6\x25\x9d\x60\xb6\x33\x64\x2d\x0a\x15\xa6\xd7\x38\xa7\x47\x87\xc7\x43\xc8\x1f\x0c\xa4\x28\x20\x21\xa8\x35\x65\x90\x2b\x59\xde\x8f\xec\x33\x78\xee\xc2\xf7\xd7\x12\xb5\x21\x15\x47\x46\x11\xc5\xd5\xd2\x14\x52\x04\xb3\x1b\x66\x0a\x26\xe0\xa3\xeb\x02\x56\x15\x67\xa9\x2b\x4e\xb5\x83\x6c\xa4\x7c\x04\x6f\x9a\x37\xc9\x2d\xdc\xef\x99\x30\x63\x78\xcb\x59\x7a\xdd\x58\x53\x1b\x25\xc5\x7c\xf6\x56\x56\x4b\x9b\x6b\xff\xfe\xe9\xe2\x7b\x7b\x19\x73\x83\xe0\x6b\x53\x09\x74\x6b\x5f\xb5\x01\x9b\x04\xed\x29\x01\x45\x06\xba\x70\xde\x31\x60\x51\xc1\x52\xd6\x0a\x72\xc5\x48\x64\x7a\xaf\xec\xcb\x81\xd4\x9f\x48\x25\x52\x13\xbc\x43\x83\xf0\x13\xa3\x9b\x5e\xb4\xc1\x04\xfa\x23\xb1\xbd\xcd\xda\x62\x03\x50\x6b\x99\x32\xb7\x47\x9c\x44\xab\x46\x5a\x2b\x65\x8f\x0f\x5b\x09\x44\xf7\x49\xfd\xa8\x64\xce\x38\xed\x11\xc8\xc9\x68\xab\x01\x68\xa2\x4e\xd7\xab\x5a\x1b\xe0\xec\xda\x45\x20\xda\x7d\x6a\x57\xab\xcf\x18\x56\x0a\x40\xb1\x74\x60\x60\xe4\xe0\xd9\xfb\x34\x65\xf6\x90\x33\x28\xe6\x9c\xf4\x91\x35\xaa\x40\xa5\xe4\x8d\x65\x2b\x05\x30\xd3\x58\x93\xac\x2d\x35\xd8\x6f\x0a\xa1\xd5\x77\xaf\x9c\x8b\x76\x2f\xb4\xe0\x7d\x29\xe0\x75\xa9\x70\x4e\x4e\x0f\x1b\xa3\x9a\xb8\xcd\x2b\x96\x79\xeb\xc5\xb2\xe6\x86\x55\xbc\x3b\xe3\x5b\x6f\xee\x95\xf4\xa1\x6c\x1d\xef\xfc\xed\x16\x34\xd1\x8e\x42\x9a\x82\x14\x74\x19\x4d\x68\x83\x22\x25\x48\x96\x90\x5a\x33\xd8\xc2\xc5\x9b\xbc\xe5\xb2\x37\xba\xe4\xfd\xba\x3c\x3a\xec\x80\xfd\x32\x48\x28\x71\x0c\x82\x6e\x8d\xf5\x30\x28\x32\xb5\x12\xb6\x54\x21\x37\xe8\x32\x8d\xcf\x19\xb6\xad\x01\x95\xc2\x65\x53\xe0\x14\xa8\x41\x48\x03\x09\x91\x18\xb2\xab\x14\x2d\x98\xac\x35\x5f\x42\xc6\x74\xc5\x71\x49\x59\x9f\xe9\x6c\x02\xb3\xdb\xfd\xbd\x4f\x62\xbf\xbc\xde\x98\xe3\xa8\x1b\x30\x53\x10\x35\xe7\xfd\xa4\x4f\xb0\x1d\xdc\x11\x1b\xa6\xda\x66\xb5\x80\x29\x7c\x87\xa6\x88\x72\x2e\xa5\x1a\x8d\x5c\x5b\xa1\xc8\x64\x39\x3a\x82\x2f\xe1\x94\x5e\x1d\xc1\x17\x4e\x2f\x1d\x71\x12\x73\x53\x0c\xb3\xab\xc3\xff\x0d\x53\xda\x00\x33\xd4\x3c\xb7\x7c\x05\x9f\x8c\x2d\xa7\xdd\x3e\x41\x10\x74\x03\x0d\x43\x10\x75\x99\x90\xb2\xd6\x12\xbd\x7e\x00\x2c\x1f\x31\x98\x36\xf0\xe1\xf7\xdf\xc1\x75\xbc\x5a\x9b\x90\xa1\x35\x79\xaf\x93\x38\xea\x35\xee\x9f\xbc\x3c\xb4\xb7\x9c\x36\xcc\xd7\x78\xe3\xa6\x20\x17\xfa\x4c\x43\x5e\x73\xbe\x85\xa5\xa3\x6e\xf5\x85\xd9\x74\x53\xff\x2d\x44\x77\x39\x67\x17\xcd\x3b\x32\xa4\x4a\xfb\x39\x91\xe5\x5d\x88\x00\x73\x81\x61\x83\xc2\x01\x05\xe4\x8a\x30\x1b\x9c\x75\x00\x4f\x22\xc2\xb4\x18\x75\x92\x8e\x3b\xe7\x8e\x6a\x61\x47\x8f\x81\xb6\x61\xb1\x7c\x44\xd6\x90\x5b\x4e\x6f\xa1\xfc\x38\x90\x74\x0c\x46\x2d\xfb\x18\xde\x70\xd6\x10\xc5\xfd\xe6\xf7\x2f\x6b\x6d\x7b\x33\x52\x06\x81\xca\x5e\x1f\xec\xb1\x5f\x54\xd5\xba\x18\xb1\x0d\x8e\xad\xbc\xc1\x82\xf5\xc1\x6e\x84\xb7\xdb\xc6\x72\x19\x1d\x75\xd3\x43\xb5\xb7\xea\x05\x5b\x28\x5c\xd4\x66\x7f\x11\x62\xff\x9e\x8c\x4c\xc1\xf4\x51\x64\x3f\x95\x8d\xac\x9b\xf4\xcf\xbd\xce\x35\xe7\x47\xbf\x0c\xab\x0d\xbf\xaa\xd1\xb9\x6b\x77\x2d\x4d\xe6\x83\x7d\xb9\x5c\x20\x1f\x0d\xb0\x1e\xdb\x8f\x6a\x27\x27\xdd\x92\xf5\x91\x67\xb6\xf9\xa8\xd4\xbc\x25\x4d\x62\x0b\x66\x76\xb0\x5a\x91\xc8\xd6\xeb\x83\x83\xfe\xe3\x74\x93\xe9\xbe\x76\x89\xd6\x7e\xa4\x6e\x2f\xe5\xcc\x0d\x87\x3e\x01\xf7\x57\xf2\xee\x8d\x60\xb5\x8a\x3e\xbc\x1b\xbc\x47\xf8\x5a\xb7\x2d\x29\x1d\x97\x4b\xba\x35\x6f\x14\x61\x57\xff\x4e\x72\xa9\xca\xf6\x32\x6b\x9b\x7b\xab\x53\x3b\x11\xda\x97\xdd\xaa\x9b\xb6\x25\xb0\x4b\x0a\x91\xfb\xdc\xdd\x16\xbf\xed\x52\x8e\x09\x71\xc8\xa5\xb2\x85\x6d\x59\x92\x30\x1d\x28\x77\x89\x0b\x66\xab\x55\x64\x3f\x8f\x3b\xc2\x21\xcb\xc6\x1a\xbe\x3f\xb1\x85\xaf\xbd\xd4\xbb\xc7\xfd\x54\x96\x15\x27\x43\xd3\x40\xe6\x79\xc7\xd0\x61\x6b\x5f\xa1\xc0\xd3\xdb\xeb\xf9\x8d\x9e\x06\xcf\xac\xa4\x06\xe6\x4f\xc8\x6b\x5a\xaf\x9d\xe0\x56\xce\x24\xf6\xf4\x1e\xc2\x56\xc5\xab\xca\xb6\x9d\x28\xff\x71\xdc\x1a\xf1\x8d\x0b\x33\x88\xe1\xad\x3d\xae\x78\x7b\x0c\xe9\xde\xa6\x03\xd3\x25\x46\x84\x46\x4a\x3e\x7c\x1a\xf3\x90\x1a\x3e\x43\x7d\xdb\x03\xad\x5f\x0b\x76\x7d\x46\x39\xd6\xdc\x0c\x1e\x77\xc0\x7e\x56\xe9\xbf\xef\xb7\xaf\x24\xde\xaa\x9b\x0f\x57\x9b\x46\x7d\xb8\x88\xd4\x29\xb7\x25\x62\xf3\xb5\xa6\x8b\xb0\x76\xb8\xad\x5d\x1f\xfb\x48\x9c\x35\x06\xda\x44\x34\xb4\x71\xd1\xd8\xb5\x1d\x5a\xad\x48\x64\xeb\xf5\xc1\x7f\x06\x00\x12\xfd\x0b\xbd\xa9\x21\x00\x00"),
+			uncompressedSize:  8617,
 		},
 		"/root.html": &_vfsgen_compressedFileInfo{
 			name:              "root.html",
-			modTime:           mustUnmarshalTextTime("2015-10-04T03:50:12Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x53\xbd\x6e\xdb\x30\x10\xde\xf5\x14\x07\x76\x0b\x40\x2b\xad\x91\x0e\x8e\x2c\xa0\x53\xa7\x6e\x41\xd7\x80\x16\x4f\xd2\xa1\x34\x49\x90\xe7\xfc\xd4\xf0\xbb\xf7\x48\xcb\x69\x8d\x64\xe8\x60\x58\x3a\x7d\x7f\xfc\x0e\x3c\x1e\x2d\x8e\xe4\x11\xd4\x03\xb1\x43\x75\x3a\x99\x18\xad\xc9\xf3\xf1\x88\xde\x9e\x4e\x4d\xf3\x17\xf1\xc3\x90\x57\x65\xd4\x65\x7e\x75\xd8\x37\xab\x7c\xd8\xc1\xb1\x01\xd8\x9b\x34\x91\xd7\x0e\x47\xde\xc0\x67\xdc\xdf\xcb\x6c\x0c\x9e\x75\xa6\xdf\x28\x93\xaf\xf1\xe5\xbe\x39\x35\xab\x31\x04\xc6\x54\x29\x8c\x2f\xac\x8d\xa3\xc9\x6f\x60\x40\x2f\xe3\x8a\x48\x68\xb5\x0b\x53\xa8\x98\x68\xac\x25\x3f\x69\x0e\x71\x03\x5f\x56\x77\x45\x78\xc1\xe4\x48\xde\x2f\x52\x1f\xc3\x3e\xed\x68\xd2\x3b\xf6\xff\x04\xdc\x80\x39\x70\x28\xe1\x9e\xc9\xf2\x2c\xe0\x25\xeb\x95\xc2\xdd\x3b\x05\xaa\x1a\x4e\x4a\xd0\x33\xd2\x34\xcb\x21\x6f\xef\xa1\xbd\x01\x1b\xc0\x07\x06\x1c\x47\x1c\x18\x78\x46\x38\x7f\x87\x30\xd6\xb7\xdd\x81\x39\x78\xb8\x69\xaf\xfb\x58\xdf\x96\x3e\xc4\x36\x64\x62\x0a\x92\x2b\xa1\x33\x4c\x4f\x58\xa6\x35\xc4\xfa\xdc\x58\xd7\x2e\x55\x37\x1d\xed\x27\x18\x9c\xc9\x79\xab\xde\x4a\x92\x99\x4e\x98\x63\xf0\x59\xc8\x4b\x8f\x7a\xe7\xc2\xf0\x4b\x41\x4e\xc3\x56\x09\x5f\x84\x87\xb6\xc0\x1f\x85\xb7\x8a\x7e\x52\x7d\xd7\x0a\xb3\x6f\xba\x79\x0d\x55\x7f\xab\x3e\x58\x87\xea\xbf\xc5\xe8\x68\x30\x25\x22\x70\x32\x83\x54\x04\xf9\x35\x33\xee\xe5\x34\x09\xbe\x87\x55\xd7\xce\xeb\xfe\x5d\xb6\xcb\x72\xfe\x3f\x9e\xb0\x1e\x17\xd6\x55\xc2\xa6\xb3\xf4\x04\x64\xb7\x6a\xd9\x85\xea\xa5\xa1\xce\xc0\x9c\x70\x14\x7a\x49\x85\x59\x5d\xcc\xcb\xb2\xe4\x77\xb6\xa8\x4f\x6e\xaa\x7f\xd6\xf8\x09\x53\x25\x0b\x9d\x2e\xf8\xd1\xc0\x68\xb4\x49\x68\xf4\x30\x9b\xc4\xf0\xe6\x22\xfe\x3d\xfc\x24\x7c\x86\x87\x6a\x51\x5c\x5b\x23\x47\x6d\x25\x50\x5f\x6e\xc5\xf9\x7a\xfc\x09\x00\x00\xff\xff\x24\x24\x3d\xdd\x40\x03\x00\x00"),
+			modTime:           mustUnmarshalTextTime("2016-05-10T01:28:43Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xb1\x6e\xdc\x30\x0c\x86\x77\x3d\x05\xa1\x6e\x01\x7c\x4e\x72\x48\x07\x47\x67\xa0\x53\xa7\x6e\x41\xd7\x40\xb6\x28\x99\xa8\x2c\x09\x12\x73\x49\x6a\xf8\xdd\x0b\x2b\x97\x6b\x0f\x29\x8a\x4e\xb6\xe9\x8f\xe4\x87\x9f\xcb\x62\xd0\x52\x40\x90\x0f\xc4\x1e\xe5\xba\xea\x94\x8c\x2e\xd3\xb2\x60\x30\xeb\x2a\xc4\x6f\xe2\x9b\xa6\x20\xb7\x92\x2a\xfc\xea\xb1\x17\xbb\xf2\x34\xc0\x22\x00\x66\x9d\x1d\x85\xc6\xa3\xe5\x0e\x6e\x70\xbe\x17\x00\x36\x06\x6e\x0a\xfd\xc4\x0e\x6e\x3e\xa7\x97\x7b\xb1\x8a\x9d\x8d\x91\x31\xd7\x16\xc6\x17\x6e\xb4\x27\x17\x3a\x18\x31\x30\xe6\x4a\x64\x34\x8d\x8f\x2e\x56\x26\x69\x63\x28\xb8\x86\x63\xea\xe0\x76\x77\x87\xf3\x99\x29\x89\x42\xc0\xfc\x0f\xec\xd3\x40\xae\x19\x38\xfc\x21\xd8\x81\x7e\xe2\xb8\xc9\x3d\x93\xe1\xa9\x83\xdb\x93\xeb\xc5\x84\xbb\x0f\x13\xa8\xce\xf0\x14\xb0\x99\x90\xdc\xc4\x1d\x5c\xdf\x43\x7b\x05\x26\x42\x88\x0c\x68\x2d\x8e\x0c\x3c\x21\xbc\xfd\x87\x68\xeb\xd7\xf0\xc4\x1c\x03\x5c\xb5\x97\x79\xec\xaf\xb7\x3c\x00\x52\x2c\xc4\x14\x43\x07\x19\xbd\x66\x3a\xe2\x56\xad\x12\xfb\x8d\x58\x85\x6a\x4f\x51\x0b\x45\xb3\x83\xd1\xeb\x52\x0e\xf2\x1c\x12\xcd\xae\xc9\x58\x52\x0c\x85\x8e\x78\xca\xb1\x19\x7c\x1c\x7f\x48\x28\x79\x3c\xc8\xb6\xb0\x66\x1a\xdb\x0d\x7f\xcc\x68\x76\x29\x38\xd9\xab\x96\x66\xd7\x0b\x35\xed\xa1\xce\x3f\xc8\xbf\x9c\x43\xf6\x5f\x52\xf2\x34\xea\x4d\x11\x38\xeb\x91\x82\x83\xf2\x5a\x18\x67\xb0\x31\xc3\xd7\xb8\x53\xed\xb4\xef\x3f\xb8\xbd\x1f\xe7\xff\xf5\x32\x9a\xc7\x53\xd7\x85\xa1\x50\x86\x8e\x40\xe6\x20\x4f\xb7\x90\xbd\x00\x50\x1a\xa6\x8c\xf6\x20\xdb\xcd\x0a\x8b\x7c\x5f\xbe\x9d\x7b\xe0\xf0\x96\x40\x7d\xf3\xae\x3e\x8c\x0e\x0e\x73\x6d\x06\x50\xf4\xce\x5b\x0d\x56\x37\x3a\xa3\x6e\xc6\x49\x67\x86\xf3\x16\xd5\x52\x0f\xdf\x09\x9f\xe1\xa1\xae\xd8\xb6\xb6\xba\x17\xaa\x35\x74\xec\x85\x58\x16\x0c\x66\x5d\xc5\xaf\x01\x00\x24\x24\x3d\xdd\x40\x03\x00\x00"),
 			uncompressedSize:  832,
 		},
 		"/trace.html": &_vfsgen_compressedFileInfo{
 			name:              "trace.html",
-			modTime:           mustUnmarshalTextTime("2016-04-26T09:17:53Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x3c\xfd\x77\xe3\x36\x72\xbf\xfb\xaf\x40\xb8\xe9\x89\x4a\x24\xda\xde\xcd\xb5\x3d\xd9\x56\x5f\x6e\x3f\x9a\xbd\x4b\xb2\x79\xf1\x66\xaf\xad\xb3\x2f\x0f\x12\x21\x89\x6b\x8a\xe4\x11\x90\x65\x65\x4f\xff\x7b\x67\x06\x1f\x04\x28\xca\x96\x7d\x49\xda\xd7\xeb\xbe\x44\x96\x40\x60\x30\x18\xcc\x37\x06\xfc\xf8\x31\x15\xb3\xac\x10\x2c\x7a\x9b\xa9\x5c\x44\xdb\xed\xc7\x8f\xd9\x8c\x25\x6f\x6b\x3e\x15\xc9\xeb\x17\xc9\x77\xbc\x16\x85\xda\x6e\x65\xc5\x0b\xf6\xf1\x63\xf3\xe0\x12\x1a\xb6\x5b\x36\x84\x46\x51\xa4\xf0\x4d\xe1\x93\xa0\x0b\x7d\xa1\x3e\xbc\xaa\x52\x2e\x17\xa6\xeb\xd1\x51\x33\xed\x37\x3c\x2b\x22\x6c\x3a\x97\xd3\x3a\xab\x14\x93\xf5\xf4\x22\x02\x28\x7f\xe4\x52\xfc\xf0\xfd\xd7\x30\xb3\xe2\x2a\x9b\x1e\x3f\xe7\x73\x91\x1e\xa7\xcf\x86\x2a\xab\x8e\xb3\x22\x15\xb7\xc9\x07\x19\x8d\xcf\x8f\xf5\xb8\xf1\xd1\x79\x9e\x15\xd7\xac\x16\xf9\x45\x24\xd5\x26\x17\x72\x21\x84\x8a\xd8\xa2\x16\xb3\xfb\x01\x8a\x5b\xbe\xac\x72\x31\xd4\x23\x93\xa9\x04\xd0\x88\x13\xfe\x1c\x1f\x31\xf6\x64\x5a\x56\x9b\xe1\x07\x59\x16\xa3\x45\x79\x23\x6a\xf6\x11\x1a\x19\x9b\xae\x6a\x59\xd6\x23\x56\x95\x59\xa1\x44\x7d\x06\x8d\xdb\x23\x40\x49\x0f\x3b\x3a\x5f\x9c\x8e\xdf\xee\x23\x0b\xf4\x25\x5a\x17\xa5\xea\xa0\x37\x81\x3f\x27\xaa\x13\xb4\x8b\x68\x56\x16\x6a\x28\xb3\x9f\xc5\x88\x9d\x3e\xad\x6e\xcf\x18\xa0\x01\xeb\xe0\xf9\x90\xe7\xd9\xbc\x18\xb1\x65\x96\xa6\xb9\x38\x8b\xc6\x34\x96\xb1\xd8\xfc\xd5\x50\xb2\xf4\x22\xa2\x45\x54\xa2\x5e\x72\xa4\xd5\x70\x9a\x67\x95\xeb\x0d\xfd\x78\x47\xa7\x88\xa5\x5c\x71\xea\x3a\x29\x79\x9d\x0e\x95\xb8\x55\x21\x3d\xe1\xfb\x77\xb6\xfb\x76\xdb\x45\xf1\x56\x8f\xb1\xfb\x71\x7e\xcc\xed\xfc\x40\x35\x40\xd3\xfe\xfa\x5b\x37\xee\xb8\x01\x06\x6d\x1f\x5b\x6c\xde\x8f\xe8\x9f\x2e\xdf\x7c\x6b\x68\x1e\x8d\x5f\xde\x56\x65\xad\x18\x97\x0c\x9b\x71\xfe\x70\xe2\xfe\x51\x1b\x19\xcb\xb4\xe7\xc7\xb0\x9b\xb0\xa7\x9f\x0c\x87\xec\x2d\xc0\xfe\xb2\x16\x9c\xc5\x05\x20\xf4\x2a\x07\xde\xee\xb3\x19\xcf\xf3\x09\x9f\x5e\xb3\x59\x59\xb3\xe7\x80\xd7\xe7\xdf\x71\xa9\x04\x2b\x67\x34\x97\x16\x10\xc9\x86\xc3\x31\xf0\xbf\x12\xc0\x6f\x1c\x9e\x46\xaf\x97\x88\x91\xc6\x0b\x16\x91\x4d\x15\xb4\xbd\x88\x98\xb7\x62\x5c\x4a\x64\x45\x94\x45\x3f\x48\xc1\xa6\xaa\xce\x3f\x9f\x32\x98\x69\x5a\x2e\x97\xbc\x48\xe1\x87\x2a\x19\x8e\x61\x6a\x21\xbc\x19\xd9\x44\xe4\xe5\x7a\x04\xe3\xde\xf1\x7c\x05\xe3\xe3\xaa\x06\x6e\x9d\xb1\xe8\xea\x9f\xe4\xfb\xc8\xf2\xde\xa5\x82\xd6\x79\xdf\x17\x45\xb5\xa9\x80\xef\x70\xf2\xe3\x0f\xfc\x86\xeb\x56\x62\x98\x78\xb6\x2a\xa6\x2a\x2b\x8b\xb8\x6f\x24\xe1\x86\x03\x26\x79\x06\xbc\xcb\x2e\x58\x21\xd6\xec\xbf\x44\x5d\x3e\xb7\x9b\x11\xb3\xb4\x9c\xae\x96\xf0\x34\x99\x0b\xf5\x32\x17\xf8\xf5\x8f\x9b\xd7\x69\xec\x6d\x60\x9f\xf5\xcf\x8e\xb4\x58\x11\xa0\x04\xc0\x47\x40\xe4\x74\x13\x0d\x98\x9b\x90\x51\xcb\xcb\x1b\x9c\xc9\x4e\x1e\x8c\xe0\x33\x90\x44\x84\x1a\x8c\x12\xad\x01\x8c\xf1\x1c\xe4\x27\x8e\x88\x50\x5a\x48\x61\x50\x26\x52\x22\xa3\x45\x3c\x89\xfa\x67\x66\xc4\xd6\x7c\xdb\x5a\x2c\x8f\x8f\xd9\x9b\x82\xf1\x62\x13\xae\x95\x89\xba\x86\x6d\x41\x2a\x2f\x79\x9d\xe5\x1b\xb6\x5e\x88\x82\x11\x93\xb0\x4c\x92\xbc\x03\x35\xb3\x9c\x4f\x72\xd1\x67\x6b\x61\x81\x39\xfe\x01\x04\x56\x12\x36\x83\x36\x12\xb4\x55\x91\x22\x58\xdc\x07\x50\x0e\x3c\x69\x93\x88\xe6\xf3\x17\x2b\x76\xe8\x92\x0a\xa9\xea\x72\x13\xf7\x4d\xf3\xa7\x71\xf4\xc4\x23\x7c\x02\xdd\xa6\xd7\xbb\x9b\xba\xd3\x55\xcb\x5e\x3f\x59\x64\xa9\x88\x1d\x65\xda\x9d\x88\x5d\x01\x68\x99\xe7\xbc\x92\x22\x8e\xe4\xa2\x5c\x47\x77\x76\x67\x89\x5d\x1e\x0c\x9c\x01\xaf\xc8\xb8\x9f\x48\x91\x8b\xa9\x8a\xef\xdc\x81\x6f\xcb\x86\x6e\x48\x5c\x21\x52\xd8\x42\x94\x40\x24\x9e\x53\x63\x2c\x9e\x88\x29\x5f\x81\xe0\x60\x33\xb5\x64\x0a\xe0\xcf\x70\x10\x36\x59\x20\xfd\xc4\xb1\xb3\x1b\xfc\xfc\xd1\x7c\xdd\xa8\x51\x62\x6e\x84\xdc\x82\xfa\x10\x26\x77\x64\xf3\xc0\xb6\xb7\x4e\xf8\x7b\x27\x92\xaa\x26\xc6\x7f\x21\x66\x7c\x95\x77\x90\xb2\x1b\x9f\x07\x8a\x90\x53\xe7\x9d\x12\xf4\x63\xf1\x63\xf1\x16\x28\x0c\x96\xc0\xd2\x7c\x0a\xa6\x0c\xec\xbe\xa6\x3c\x00\xcd\x6a\xa1\x75\xd5\x80\x95\x05\x08\x8c\x5c\x00\x27\x40\x67\xb6\xce\xd4\x82\xcd\x6a\x40\x2c\x95\x9f\x74\x8b\x22\x7e\xe2\xba\x1a\x47\xe0\xe8\x3c\xcd\x6e\xc6\xf4\x49\x26\xe2\x09\x81\x1e\x76\x98\xe0\x08\xd0\xe4\x52\x82\x8e\xa3\x1e\x2a\x5b\x0a\x58\x86\x40\xaf\x22\x04\x41\x36\xff\x7b\x21\x49\xf9\x51\xab\x19\x08\x3c\x5e\xd6\x22\x7d\x91\xdd\xb8\x41\xa6\x03\x0e\x2b\xf8\x52\x74\xb5\x03\xa6\x20\x1b\x22\xfd\x09\x4c\x96\x37\x5b\xf0\xe7\xa8\x99\x1d\xc9\x05\xd2\xf1\x8d\x28\x56\x0e\xe3\xb4\x2e\xab\xb4\x5c\x17\xf0\x5b\xf0\x7a\x96\xdd\x6a\xd4\x56\x79\xbb\xc3\x70\x49\xc3\x60\x42\xd0\xe5\xfa\x3b\x68\x25\x3e\x04\xf5\x23\x10\x87\xc9\xa6\xe9\xab\x67\x30\xfe\x46\x9a\x49\xb0\x50\x9b\xd1\x24\x2f\xa7\xd7\x67\x55\x29\x33\x64\x83\x91\xf6\x9e\xce\x40\xb5\xcd\xb3\x62\x38\x29\x95\x2a\x97\xa3\xdf\x83\x3f\x62\x3c\x09\x70\xc3\xcc\x64\xc0\x7b\x12\x36\x97\xe3\x30\x87\x37\x92\x84\x39\xdc\x16\xc0\xe6\xa2\x46\x0a\xe4\xd9\xf8\xc8\x8e\x47\xdb\xae\xf8\x84\x9c\xbc\x8b\x68\x78\x6a\x4c\x3b\x27\x3e\xbc\x20\x6d\x32\x9c\x2e\xb2\x3c\x05\x57\xc9\xba\x1b\x4f\x4c\x27\x55\xce\xe7\x38\xb9\x2a\xcb\x1c\x1c\x3b\xd3\x0a\x0b\x99\x92\x6c\x5e\x44\x75\x36\x5f\x80\x31\x55\x68\x4b\x35\x2c\x60\x63\xa0\x9a\x81\xa7\xad\x25\x30\x26\xe8\x05\xf4\x01\xa2\xf1\x25\x76\x79\x6e\x1e\x6b\x87\x01\x91\x3d\x0c\x57\x54\x94\xbf\x14\xae\x08\xeb\x1e\x5c\xbf\xc2\x2e\x8f\xc5\x75\x96\xe5\x20\xf6\xbf\x00\x41\x8f\x3b\x30\x05\x57\x30\x05\xe1\x66\x9c\x99\x69\xc6\xaf\xe8\x6f\x83\xe4\x7e\x2c\x43\x84\x2c\xba\xd3\xbc\x94\x20\x3c\xcf\xf1\x8f\xbf\xd4\xf3\xe3\x55\x7e\x87\x14\xe9\x69\xff\x4f\xc8\xd2\xae\x18\x91\xb7\xec\x49\x5a\x64\xbd\xdb\x11\xb3\xe4\x0e\x49\x9d\x15\xd5\xca\x77\xf4\x1c\x6c\xbd\x4b\x68\x48\x97\x43\xa4\x1c\xa0\xf1\x38\x86\x40\xd8\xb0\xeb\xd7\x62\x33\xba\x41\xff\x93\x55\x3c\xab\xc1\x6b\x4a\x19\xae\x49\xa2\xfa\x87\x79\xc0\x62\x40\x8c\x98\x6b\xdf\xd5\x32\x22\xc1\x5c\x94\x39\xac\xed\xa2\xe7\x00\x24\x49\xd2\xfb\x0d\x58\xc6\xd0\xe1\x26\x13\xeb\x6f\xca\x54\x68\x96\x98\xac\x60\x8b\x74\x3c\x32\x51\xc5\x25\x78\xec\x97\xe0\xb4\xa8\xb7\x60\x38\x1c\xe5\xe0\x01\x83\xff\x87\xa9\xb6\xb9\xa0\x40\x30\xe0\xf8\xe3\x06\x3d\x39\xf8\x82\x46\xe6\xfc\x58\x03\xda\x03\xf3\x65\x91\x1e\x06\x11\xcc\xe2\x21\xf0\x5e\xac\xea\x90\x71\xf6\x02\x4c\x4d\xcf\x7b\x00\x7e\x8d\xfc\x7e\x3f\x34\x12\x8b\x06\x54\x43\x5f\x92\x0a\x3f\xbc\xd0\xf1\x36\x63\x09\xbf\x05\x5d\x56\x71\xb5\x18\xb8\x5f\x68\x91\x8d\xcf\x01\x8c\x91\x8f\xc0\x81\x2e\x84\xb6\xff\xe8\xd4\x5e\x43\x3c\x3c\x01\x46\xb9\x36\x4d\x0b\x5e\x89\x21\xa8\x1c\x60\x1a\x70\xa1\x47\x0c\x9c\x02\x59\xbd\x4c\xe7\x42\xea\xe8\xdc\x82\x25\xaf\xd3\x80\xc5\xc8\x7a\xc6\x97\xe0\xab\x8f\x98\xe4\x85\x1c\x4a\x18\x3c\x3b\x6b\x1e\x9a\xb0\xfb\x04\x44\xd3\x02\xb1\xce\x82\x16\xfe\x87\x42\x7a\xda\x40\x7a\x62\x21\x3d\x35\x98\x69\x50\xe0\x92\x14\x12\xc5\x6f\xa4\xbf\x62\xb0\x18\x03\x02\x83\x67\xf0\x61\xfc\x9f\xe1\x52\x0e\xef\xe9\xc7\x8e\x3f\x63\xaf\x5f\xb2\x3f\xb0\xcf\x8e\xf5\x90\xb5\x98\x5c\x67\xea\x90\x61\x97\x7c\x06\xda\x8d\x44\xf5\xf9\xa2\x2e\xc1\x6e\x5b\x18\xe5\x21\xc3\xdf\x80\x67\xc9\xdd\x90\x65\xf9\xf3\x21\x83\x5e\x81\x2b\x38\x2b\x6f\xf5\x30\xa2\x8e\x75\xbd\x58\xd2\xf8\x5a\x86\x44\x0b\x81\x9a\x66\xf4\x14\xb7\x05\x5c\xc5\x54\x2d\xcc\xf7\x59\x5e\x72\x35\xca\xc5\x4c\x9d\xed\x80\x79\x42\x1e\x88\x06\x60\xd5\x32\xcb\x0a\xda\x4a\xad\x9e\xe9\x91\xd1\xc9\x08\x63\xc4\x4e\x92\x67\x62\xe9\x40\x79\xee\xd8\xc0\xfd\x6a\xcc\xca\x23\x59\x01\x3c\x71\x6b\x16\x18\x9f\xc8\x32\x5f\x29\xc3\xe5\x0e\xcb\x86\xf1\x7f\x1e\x92\xae\x43\x96\x3c\xe9\xc2\x8b\x25\x81\xc9\x1a\x83\x9a\xd3\x09\xbc\x10\xa0\xb7\xde\x8a\xa7\x29\xc9\xcb\xb3\xea\x96\x3d\x3d\xb1\x38\x91\x45\x84\x8e\xa5\x5a\x78\x98\xaf\x35\xe1\xd9\x17\x7a\x76\x46\x32\x3a\x34\xdb\xc1\x4e\x93\x2f\x9e\xfe\xeb\xef\xff\xe5\xf4\x8b\x67\x06\x06\xee\xdb\x88\x3d\x79\xf6\xcc\x34\xac\x17\x99\x12\x43\x40\x68\x2a\x70\x51\xeb\x9a\x57\x3b\x99\xb3\x47\xa6\x20\x50\xdd\x43\xa0\x06\xbe\xfe\xbb\x4c\xbe\x80\x1f\xdb\xed\x99\x7b\x88\xbe\xc9\x5b\x23\x6c\xcf\x17\xa8\x8c\xa9\xe7\x65\xbb\xd9\x1f\x43\x6c\x05\xfd\x20\xf6\x4a\x4c\xd8\x02\xa6\xa9\x9f\x50\x7b\xec\x05\xa2\x62\x89\x61\x0d\xe6\xe4\x74\x58\xa3\x2d\x6b\x9c\x15\xf8\x64\x55\x40\xe4\xd3\x47\x2b\x57\x65\xb7\x22\x97\xba\x81\x44\xab\x16\x6a\x55\x43\x28\x94\x29\x1d\x79\xda\x65\xc1\xb0\x58\x2c\x7f\xd0\x03\x6d\xc8\x85\x18\xe1\x0e\x5c\x02\xeb\x00\x52\x15\xaf\xa5\x78\x85\xcc\x1e\x7f\x1a\xf7\x26\x65\xba\xe9\xf5\x31\x77\x19\xf7\x1c\x83\xf5\xfa\x2e\x6a\xd2\x33\x35\xe3\x3f\x63\x06\xbe\x09\xa6\xdc\x52\x8a\xd5\xf2\x15\xc8\xfb\x4b\x0f\x3b\x5c\x11\x34\x4f\xd0\x25\x80\x47\x26\x70\x4b\x31\xb7\x45\x71\x76\xa9\x30\x8c\x03\x97\x6f\xc3\xe6\xbc\x9e\xf0\xb9\xcb\x6a\x48\xca\x2b\x0d\x20\x16\x9d\x27\x2c\xb2\xba\xee\xb5\x12\xcb\x9f\x4e\xbf\xf8\xe2\x59\xc4\x86\x63\x86\x5f\xc2\xc5\x37\x28\xc4\x00\xa0\x21\x80\x59\x03\x2d\xfc\x75\xa1\xf0\x61\xb2\xe4\x6a\xba\x88\x8f\xe3\x1f\xd3\xcf\xfb\x9f\x1e\xf7\xaf\x4e\xde\x0f\x40\x2e\xfa\xed\x55\xbd\x86\xa5\x02\x86\xb8\xf2\x49\x59\x2a\x18\xc9\x2b\x66\x9c\x18\xa9\x69\x0f\x44\xbc\xea\xf4\x71\xde\x03\x5d\xcd\x77\x7f\xcf\xa5\x50\xd6\xd9\x06\x76\xcb\x26\x60\xd4\xd6\x3c\xbf\x46\x72\xd5\xe5\x6a\xbe\x20\xda\x10\x4b\xe2\x4e\xcf\x40\x66\x65\xe8\x16\x03\x7b\x4c\xf3\x15\x0a\x9e\x05\x09\xb2\xa9\x38\x84\xd9\x20\xea\xb0\xef\x86\xbc\xf3\x0c\x42\x6f\x72\xf1\x21\x76\x65\xaf\x15\x6a\x27\x98\x45\xf0\xe9\x02\x3b\x62\x36\xf3\xc6\xcc\x1f\xab\x1a\x7c\xac\xb2\xf6\x92\x4a\x52\xf4\x5b\xac\xb5\x8b\x77\xac\x81\x0f\x2c\x1c\x2f\xe9\x90\xe0\x34\x31\xae\xc2\x4b\x06\x64\x10\xa9\x03\x66\xb5\x9f\x0d\xc8\x66\x31\xb5\x25\x15\xe5\xb0\x2f\x09\x22\xfb\xe4\xc2\x20\xee\x77\xb5\x1b\xd9\xa4\x84\xb6\xee\x9b\x86\x61\xd7\x73\x61\x31\x6a\xba\x76\x60\xaf\xc7\xb4\xd7\xb0\x93\x2e\x70\x1b\x07\x8e\x60\x21\xde\x4c\x3e\x7c\x5b\xbe\x00\x4e\xd0\x3f\xa5\x47\xea\x72\xf2\x41\x4c\x41\x7a\x71\xb3\x60\x0f\x32\xd5\x93\xe8\xc1\x6a\x89\x25\x2f\x54\xf6\x71\x23\x2c\x3c\x5f\x4c\x08\xd8\x80\x81\xd7\xa3\xd3\x17\x08\x83\xc6\x1a\xf5\x81\x89\xbd\x14\x67\x8d\x93\x3e\x0c\x24\x27\x37\xa5\xae\x16\xda\x0a\x9d\x17\x39\x05\x63\x27\x13\xf6\x16\xa3\x3b\xf8\x6f\x25\xc5\x0c\x22\x12\x9b\xc6\x7a\x45\xb9\xac\x5a\x70\x65\x30\xa3\xb9\x08\x2e\x70\x03\x9f\x4e\xc1\xb7\x2e\x6b\x69\x41\x66\x05\xe8\x1e\xb9\x9a\x0c\xf5\xca\x24\x26\xae\x15\xa8\x6d\xb0\x59\x24\xb4\x88\x38\x80\x69\x33\x4a\x48\xa7\xb8\x0c\x35\x51\xa1\xa9\x07\x4a\x74\x7b\x16\x72\x4b\xe9\xb1\xca\x35\x6c\x88\xbf\xf7\x7a\xd4\xd5\x75\x62\xd6\x1e\x1f\xff\x98\x1c\xcf\x07\xbd\x9f\x7a\xfd\xf7\xb8\xdd\x3b\x19\x2a\x23\xf3\x7a\x5c\x7b\x27\x75\xac\x60\xf9\xe1\xd5\xea\xe7\x9f\x37\x48\x2a\x69\x08\x54\x02\x1e\xd0\x04\xb6\x97\xd7\xd3\xc5\xae\x5c\xc6\x4e\x94\x2b\x31\xcd\x66\x78\x9c\x92\x6f\x06\x5a\xdd\x81\x9f\xa0\x37\x5c\xf1\x39\xc8\x22\x7e\xc3\xc0\xb6\x25\xc2\x42\x27\xfd\x70\xef\xb9\x82\x0d\x70\x4a\xb4\x44\x31\x05\xcd\xd4\x22\x69\x07\xc2\x4e\xf8\xf4\xb3\x86\x58\x00\x86\x96\xb1\xc0\x2d\x85\xdd\x5a\x66\x3a\x02\x44\x9e\x7c\xf6\x14\x70\xe0\x35\x84\x36\xa0\x93\xcd\xf2\xc0\x71\x86\x5f\x85\xd1\xb9\x72\xc0\x64\xc9\xd6\x82\x7d\x58\x49\xd5\x40\x94\x79\x36\x25\xca\x00\x04\x50\x3f\x10\x98\x43\xb7\xa5\x40\x3d\x42\xb1\x98\x64\x4b\xe0\x3c\x16\x83\x95\x06\x90\xeb\x72\x95\xa7\xcc\xe7\xb9\x92\xd5\x3c\x93\xa2\x01\x08\x7e\x85\xb8\x9d\x8a\x0a\x31\x33\x0c\xc4\xcc\x52\x60\x3f\xf5\x97\x84\x66\x8d\x4f\x06\x30\xab\x55\xa0\x34\xf8\x7b\x81\x67\x68\x20\x74\xc0\x84\x40\xdb\x29\x1a\x15\x62\x56\x52\x6e\xfa\xfc\x0b\xec\x33\x0a\x8d\xd9\x00\xfc\xea\x34\x9f\xcd\x2b\x34\x00\xcb\x95\x23\x07\xc8\x0f\xc4\x23\x32\xf1\x58\xd6\x4e\x71\x01\x16\x26\xcf\x2d\x87\x35\xad\x8e\x6b\x7d\x1d\x16\xa4\xc3\x0f\x56\x87\x84\xcd\xf3\x85\xc0\x84\x3e\xb2\x06\x25\xf3\x71\x3d\x6b\xd1\x03\xfa\xe6\x65\x79\x4d\xab\x52\x28\xdd\x5c\x33\x54\xa8\xf0\x35\x0e\x21\x40\x84\x90\x78\x4d\x7b\x95\xee\xbe\x05\x34\x12\x75\xe6\xb5\x6d\x8f\xc2\x69\xbe\x13\x35\x3a\xea\x98\xae\x21\x91\x32\x14\x05\xd6\x73\xd9\x26\xd9\x23\xc5\x93\xb0\xbf\x80\x2c\x95\xba\x9d\x9b\xe3\x8d\x3c\xdf\xc5\x5a\x02\x1b\xdf\x80\x5f\x94\xa2\xa7\x00\x72\xa6\xd5\x16\xaa\x25\x0b\x7b\x40\x5b\x4c\x5c\xb6\xe6\x28\x52\x56\x28\xa9\x6b\x08\xd1\x1f\xe7\xd3\x03\x37\x19\xd9\xae\xad\xb9\x88\x46\x35\x5f\xa3\x4f\xd8\x3f\x6b\x0d\x98\xe1\x94\x3a\xbd\x8f\xb3\xc7\x57\x35\xb8\x10\x21\xc9\x50\x4e\x2e\x45\x81\x1e\xfa\x0d\x78\xad\x64\x56\x07\x41\x0f\x60\x53\x10\x15\x8c\x7d\x31\xbc\x59\xb5\x9e\x82\xe6\x11\x12\x73\x19\x14\x4d\x0c\x9a\x85\x7c\x09\x9c\xb0\xc6\x0c\x88\xed\x80\xec\x40\x12\x88\x52\x3c\x55\x03\xe0\xf9\x39\x20\x8f\xcd\x39\x28\xf5\x24\x00\x8b\x84\x19\xb1\x37\xa4\xd4\x13\xfc\x11\xd7\xfd\x01\x82\xc5\x75\x82\xec\x89\x3c\x95\x7b\x69\xb5\xdd\x21\x84\x91\x18\x12\x04\x29\x12\x3d\x2a\x36\x6a\xe9\xac\xc5\x23\x2f\x44\x05\x42\x83\x3c\x0c\x6c\xb1\x5e\x08\x24\x31\x1e\x48\x22\x07\x50\x1a\x67\x1f\xe7\x30\xe4\x3e\x10\xdb\x55\x15\x02\xc4\xa3\x34\x83\xc1\xa0\x11\x97\xac\x71\x6e\x00\x38\x28\x00\x60\x20\x7f\x15\x6d\x7f\xc1\x4a\x7d\x2e\x8a\x39\x98\xc4\x31\x3b\xd9\x45\xdc\xd3\x33\x24\x9b\x38\x11\x98\x78\xab\xd4\x7d\xf0\x46\x37\x04\x2e\x86\x47\xb7\x86\x86\xdb\x50\x99\xc4\x41\xd7\x7d\x06\xeb\x37\xf2\x17\xc9\x22\xda\xd4\x2b\xf2\x03\x3a\x90\x5a\x8b\x6a\xbd\x83\x7d\x2d\x48\x1e\x10\x1c\x76\x33\x31\x4f\x8e\x1c\xcb\x6a\xd6\xb4\x7b\x0b\x3b\xa4\xcb\x39\x52\x36\xd9\xe8\x5c\x1f\x44\x19\x39\xf2\xb5\x69\xc1\x10\xb0\xa0\x45\x71\xf6\xd7\x15\x04\x0b\xc6\x8b\x6a\x43\x66\x7f\x16\x9b\x51\x24\x6e\x41\xee\x5d\x9f\xa8\xd5\xe7\x15\x20\x65\xca\x35\x46\xed\xe1\xdf\x82\xcd\x1e\x45\xdf\x8b\xbf\x82\x83\xa6\xda\x03\x5f\xcf\x1a\x12\xa4\xa5\x90\x8d\x89\x26\xa2\xf1\x49\x79\x63\x85\xce\xf8\x0b\xc8\xdb\xc6\xa6\x0e\xf6\xec\x9f\xcc\x80\xcf\x14\x18\x2f\x3c\x40\x94\xcc\x9e\xdf\xa2\xf8\x0c\xb5\x71\xf2\xc5\x00\x00\xde\xe9\x0e\xdc\xe5\x09\xbc\x83\xd8\x05\xcf\x8b\xbc\x14\xa9\x6f\xd9\x20\xa4\xcf\x4c\x16\xc2\xb3\xba\xd8\x18\x47\xa3\xe6\xe8\x0c\xec\x85\xd7\xd3\x0a\x09\xd8\x8b\xa7\xbe\x91\x80\xe9\xbe\xc9\x24\x9d\x41\xeb\xad\xc3\x03\xd5\x60\xd3\x07\xc1\x71\x75\x20\xea\x80\x9f\x27\x41\x07\xf8\x3b\x8d\x34\x85\x86\x69\xeb\x2d\xef\x9a\x5d\xf8\x4b\x84\x20\xef\xcc\x7b\x7a\xd3\x7a\x7a\xfa\xde\x5b\xef\x0d\x74\x86\x15\x5e\xb0\x5e\xd4\x63\x7f\xfb\x1b\xbb\xb9\xba\x31\xeb\x1e\x9e\xba\x07\x7b\x56\xef\x33\xeb\xff\x2c\x11\x00\x29\x2c\xd1\xa8\xc0\x08\xf0\xd4\xba\x43\x10\xc3\x66\xb9\xc3\x53\xea\xd8\x9c\x90\x1d\x59\xea\xa0\x4b\x6d\xbc\xaf\x53\xf0\xc4\xdd\xca\x1b\x75\xfe\x9b\x45\x78\x3e\x81\xb5\x63\x94\xcd\x1a\x3d\xaf\x9d\x5c\xd4\x1d\x2e\xc8\x42\x39\x9f\xa2\x70\x91\x94\x92\xa5\x01\xef\x2e\xe4\x7d\x0f\x2b\x63\xde\xaf\xae\x21\x8e\xb8\x08\x83\x8e\x5d\x33\x81\x26\xda\x43\x0e\xfc\x78\x29\xee\x1c\x40\x26\xbf\x2b\x60\x6d\x89\x70\x18\x8b\xb6\x76\x77\x37\x14\xfd\x0b\x16\x87\x20\x11\x40\x63\xd4\xfa\x4c\xc4\x84\xa2\x74\x4c\xc1\x6c\xf6\x5d\x77\x32\x39\x3e\xb6\xa4\xe4\x23\xf8\xf6\x74\xd4\x96\x29\xf4\xc2\x9c\x49\x10\xd3\x1c\xcf\xcf\xad\x47\xc6\x81\x27\x61\xc3\x50\x75\x34\x19\x00\x63\xf8\x08\xd9\x00\x2a\x00\x13\x4b\x30\x85\x8d\x3d\xf8\xeb\x2a\x9b\x5e\xc3\x26\xd0\x54\x6d\x24\x70\x82\xb5\x00\x1b\x15\x4b\x61\x4a\x8d\x76\x82\x48\x75\x8b\x39\xc9\x2f\xe9\x17\x2d\xca\xaf\x52\xd8\x5f\xa3\xa0\xcb\x1d\x9a\xa3\xef\xb0\xec\x64\x6b\x33\x36\x41\xde\x93\x5f\x75\x1c\xf8\x60\xf6\x06\xcb\x1a\xa8\x54\x22\x1a\x74\x20\xe4\xe5\x74\x82\x87\x98\x1a\xa4\x33\x55\x53\x25\x92\xa1\xd5\x59\xda\x83\x38\x57\x66\xe2\x13\x04\xdc\x08\x1c\x75\xe4\xf8\xdc\x18\x0a\x64\xea\xe0\x78\xd6\xec\xac\xbc\x8b\x5a\x76\xfe\x58\x74\x64\x66\x3a\xe9\x7a\x16\x98\x04\x92\xcf\x8b\x0e\x4a\x22\x95\xe2\x08\x3f\xb5\xef\x08\x4d\xba\xb7\x1d\x7f\x40\x8a\xc8\xf4\xb4\x29\xbd\xaf\x30\xc3\x1e\xef\xf0\xb7\x2e\x62\x59\xc0\xf2\x73\x8c\x2d\x91\x64\xda\xef\xf0\x99\x08\xd7\x79\x4c\xd4\xd1\x44\x49\x0e\xd9\xdc\xb0\x0e\xa0\xbd\xc9\x41\x45\xcc\x7e\xaa\xa2\x1a\xe8\x3b\xb1\xbc\x67\xc6\xf0\x34\xff\x91\x33\xea\x8c\x5c\x50\xc4\x14\xd0\xc8\x71\x95\x71\x55\xe4\x6a\x82\x34\x3a\x88\x24\xe6\xe4\xf4\x4e\xcc\x1a\x7b\xa2\x15\x0c\xe5\x3a\x4a\xac\xe0\x09\xf6\x24\xb9\x9b\xcb\x1a\x28\x2f\xf4\x69\x82\x56\xe4\x3e\xae\x81\x04\x7b\xe7\x23\x09\x9d\x4c\x83\x34\xab\x65\x1e\xb7\x58\x33\x7c\xd8\xef\xd2\x05\xde\x01\xbe\x4e\x76\x37\x4a\xdb\x1d\x6c\x44\x74\xb2\x11\x35\x21\x98\x3e\xc7\xd9\x95\x03\x1c\x1f\xe1\xc3\xa8\xdf\x74\x56\x65\xb5\xb7\x2f\x3c\x8b\xfa\x3b\x29\x2a\x6f\x5b\xfc\x85\xea\xed\xe8\xb5\x2b\xd9\xfc\xad\xff\xca\x2a\x55\xb3\xdb\x06\xca\xd0\x50\x52\xd7\x0e\x76\x9a\x87\xa9\x67\x1e\x76\x98\xc3\xc3\xe2\x20\x95\xd8\xc5\x21\x07\x69\xe6\x60\x37\x02\xfd\xec\x2d\x32\xb4\x71\x78\xa6\x23\x29\xe7\xa4\xc8\xa6\x9b\x30\xcc\x91\x80\x58\x50\x1f\x9f\xb8\x32\x01\x61\x0a\x05\x9c\x1b\x0e\xa6\xaf\x5d\x30\x40\x45\x04\x5d\xe5\x31\x40\x60\x5e\xcf\x85\xf2\x92\x27\xf7\x6d\x18\xb8\x21\xab\xaa\xb3\xaa\x0e\xbc\x0d\x81\x8f\x9f\x97\xb0\x71\xe0\xfa\x9c\x3e\xf3\xfd\x0c\xe3\xf4\xe8\xca\x44\xa5\x71\x4e\x76\x3d\xb9\xaf\xba\x4c\xe9\x80\xcd\x6b\x3e\x69\xe3\xcb\x50\xe5\xea\x70\x50\x2f\x12\x47\xb5\xe2\xd4\xbf\x57\xd9\xef\x09\x42\x3e\x8d\xd1\x85\xe8\x27\xe0\x8b\x81\x28\x3e\x60\xef\xf7\x19\x05\xcb\x12\x6d\x63\xf7\xa6\x82\x59\x41\x35\x02\x8a\xab\xe5\x00\xb3\xef\xed\xa2\xc7\xfb\xe6\x3b\x60\xd1\x1a\xee\x9e\x01\xa1\xde\x21\x3c\x12\x3a\xd8\xbf\x63\x86\x87\xe9\x1e\x90\x26\x3e\x17\xff\xd1\xd2\x32\xba\xf5\x3f\x77\x14\x8a\x3d\xab\x6b\x7c\xce\x6d\x8b\x74\x2d\x0a\xfb\x7a\x9d\xc4\xad\x16\x93\x15\xec\xa7\x2d\x23\xb6\xdd\x49\x48\xa6\xd3\x72\x05\x06\x07\x0d\xcd\x14\xac\xce\x5c\x48\xf2\x25\x97\x2b\x09\x8d\x59\x0d\x9f\x62\x59\xa9\x4d\x03\x31\x53\x58\x66\x0e\xbe\x8f\x12\xf9\xc6\xd3\xee\x49\xab\x70\xb2\x9f\xd0\xc0\x38\x30\x10\x58\x0a\x4f\x39\x68\x42\xc4\xa5\x16\xcc\x41\x84\x49\x59\x50\xd6\x03\x11\xaa\xb8\x8e\xbc\x48\x2b\xd0\xed\x0c\x0d\xdb\xe7\x75\x03\xe3\x85\x3e\xec\xbd\x7a\x7f\x76\x6f\x24\xe3\x73\x14\xc9\xf0\x27\xd0\x94\xec\xb8\x54\x9e\x08\x77\x38\xfa\xde\xb4\x49\xb5\x92\x8b\xd8\x67\xa8\xad\x1f\x62\xfb\x3d\x4d\x88\x0d\x11\xc9\x49\x87\xa6\x70\xba\xc1\x5b\x1e\xd5\x2a\xbc\xd5\xc7\x8d\x2e\x53\xed\x3d\x47\x92\xa0\x8c\xd2\xd6\xfb\x49\x6b\x3c\x03\xca\x8a\x01\x1d\x0c\xa8\x01\xa3\x1a\x81\xd6\xba\x75\x97\x70\xc5\x94\x18\xcf\x6e\x48\x77\xf4\x5c\xa5\x44\x6f\x27\x3b\x48\x07\xf9\x98\x1c\x24\xf8\xba\x1e\x43\xc6\x41\x37\x00\x93\x60\xde\x2a\xee\x79\xe5\x1a\xf6\x50\x1a\x03\xe5\x79\x0d\xac\x97\x0e\xe9\x61\x6f\x60\x40\xc6\x1a\xd3\x3d\x90\xa8\x62\x03\x0f\x60\x41\xf4\x7c\xca\x5e\xd1\xa8\xf7\xc9\x0c\xe8\xf3\x75\x20\xab\xdd\xe3\xb9\x52\x35\x18\x70\xba\x44\x31\x60\x1d\x80\xac\xc0\x7b\x50\x60\x07\xb4\x4a\x38\x78\x5e\x1c\x81\x9e\x29\xe9\xce\x01\xa9\x8d\xe0\xd0\x3b\xfa\x5c\x2f\xf6\xea\xe4\x7d\xff\xce\xf8\x93\xa6\x6e\xd5\xd9\x6f\xdb\xec\x12\x9e\x6b\x07\x82\xae\x37\xc9\x63\x9b\xa9\x29\x79\x48\x9f\xb9\xe2\x25\x77\x21\x20\xfc\x67\xaa\x1b\xe8\x73\x4f\x0f\xa9\x60\x33\xf7\x0d\xd7\xc5\x33\xf1\x47\xd2\x7c\x62\x19\xff\x73\x7f\xc0\xa8\x2a\x70\x74\x32\x20\xbd\x07\x7f\x4c\xb5\xe3\xc9\x76\x0f\x0c\x62\x43\x67\x81\x59\x9c\x02\x3f\x1b\x0b\x81\xee\x75\x20\x03\x74\xe8\xdd\xb0\x3d\x3a\xd7\x7b\x10\x2b\xc1\x03\x29\x57\xea\x50\xb8\x3a\xcd\x7f\x00\xe0\xb0\x0a\xbf\x0d\xb5\x73\x0c\x63\x6b\xe0\x84\x72\x9d\x80\xb9\xa0\x70\x32\xc1\xa2\x45\xdc\x1f\xb2\x3e\xab\x3a\x3f\xdb\x33\xee\xf8\x58\x17\xde\xe3\xd5\x95\x44\x9f\xf5\x65\xb3\x8d\xb1\x5a\x26\x09\x32\x20\xb5\x31\x60\x4f\x43\xa9\x6a\xfe\x6d\x03\x1d\xed\x33\x91\x56\x3c\x81\xbe\xa9\x2c\xdb\x54\xb1\x91\xa3\x1e\x15\xff\x81\x04\xf7\xf4\x0d\xba\x9e\x67\xfa\xab\xa4\x9c\xcd\x20\x80\x8c\xaf\x86\xa7\xb0\xd3\xc4\xe8\xbe\xa7\x72\x33\xd7\xe0\x8c\x57\xdc\x61\x45\xc0\xb5\xc3\x14\x7a\x04\x5d\x23\x2b\xb8\xc4\x8d\x20\xb8\x7b\xb9\x32\x21\x02\xf8\x92\x0a\x7a\x07\x1c\xc2\x98\xb6\xaf\x73\x04\x95\x1b\xc5\x11\xee\xf5\x0c\x7c\x45\x80\x1e\x99\xe1\x51\x67\x7f\x02\x07\x0b\x0c\x17\xd4\x9c\x75\x5a\x45\x8c\xaa\xaa\xef\xeb\x5d\x46\x4d\xd6\x16\x9c\xb3\xd3\x2f\x90\xd9\x8c\x95\xc7\x47\x67\x9e\x9d\xf1\x9a\x13\x88\x03\x60\x87\xf1\xe0\x14\x1d\xcd\xcf\x59\x94\x24\x49\xe4\xac\x86\x1f\xed\x7f\x4a\xea\x4b\x9a\x5a\xa5\x90\xa4\x1a\x56\x58\xb2\x18\x05\x0c\xf0\x0d\xbf\xd6\xbd\xf0\xa4\x86\x02\x74\x37\xd6\x9c\x70\x33\xe2\xf1\x21\xde\x5a\x4a\x02\xc3\xfc\x41\x52\x3a\xbd\xe8\xf9\x87\xcc\x42\x2c\xd1\xd5\xa0\x23\x3f\xce\xd6\x18\x1f\x62\x01\x42\x45\xb7\xef\x28\x33\x25\xb8\xcc\x1a\x67\xc2\xa4\xe9\xb5\xff\xd2\x1c\x28\x4e\x30\xa1\x8a\x5c\xe2\xfc\x18\x44\xd1\x60\x34\xa0\x23\x12\xfb\x04\x83\x15\x87\x2b\x68\x5f\xef\x84\xfa\xf2\xdd\xbf\x03\x51\xa7\xaa\xaf\x3d\x69\xcc\xcd\x52\x09\x91\x1d\x0a\x7e\xb3\x39\xee\xc6\x53\x59\x2c\x39\xc5\xaa\xd2\x56\xb1\x52\xd4\xef\xc2\x15\x6f\xb6\x80\x24\x28\x5b\x1d\x45\xee\x8c\x3e\xd3\xd5\x55\x60\xa0\xeb\xb5\x2f\x83\xa9\x4b\xdf\x2f\xd8\xeb\x45\xb1\xf9\xd8\xdc\xa0\x22\x6f\xa6\xf3\x56\x16\x6e\xb8\x86\x7d\xe1\xd7\x4a\x59\x8f\x1d\x69\xe1\x24\x35\x4b\x7b\xbe\x12\xc0\xa1\xba\x66\xf5\xc2\xf2\x8c\xb1\x68\x9e\xe5\x6b\xa4\x93\x00\x7a\xb2\x40\x61\xa3\xd6\xa3\x20\x35\x7e\xe8\xd8\x56\x74\x77\xa9\x68\x32\x81\xc1\x01\xf4\x9e\x39\x56\xaa\x35\xc5\xdd\x1a\x5a\xc3\xed\x80\xb6\x13\xe8\xb6\xb1\xdd\xa3\x8c\x3b\xec\x7e\x4b\x33\x6f\xfb\x9d\x74\xd3\xce\xc4\xa1\x84\x3b\x80\x58\xbf\x2a\x89\xc8\xb7\xd2\x7a\x4c\x63\x9e\x64\x45\x21\xea\xaf\xde\x7e\xf3\x35\x44\x7e\xae\xa7\x17\xcb\xd7\xc2\xd4\x2d\xe8\x98\x88\x92\x15\x31\x15\xf9\x91\xa5\xd7\xda\xa2\x6f\x2e\x8d\x41\x70\x51\x56\x7a\x9c\x0f\x2b\xc8\x01\x96\x85\xf3\x5f\x28\xee\x45\x81\x85\xd8\x24\x17\x49\xc0\xba\xa4\xe4\x03\xfb\x11\x32\x3d\xfa\x55\x3a\xf8\xeb\x7b\x87\x44\x28\x67\x57\xda\x23\xa3\xe5\xbd\x37\xe9\x8f\x06\xf9\x9d\x04\x9e\xd1\xc2\xdd\x21\xea\x2e\x5b\xf4\x83\xe3\xf4\x96\x20\xfe\x8a\x73\xb5\x4e\x14\xc0\xc5\xa7\x50\xea\x13\x1d\x37\xb0\xdf\xfd\x6e\xb7\xec\xb5\x61\xfd\x7b\x72\xb7\x92\xd3\x89\x28\x9d\x1c\x94\xb5\xd6\x73\x12\x14\xf8\x91\xdd\x0c\x6c\xa1\x6a\xff\x0b\x07\x12\x9d\xed\x11\xeb\xf5\x6c\xa0\x6b\x8e\xc3\x41\xd5\xbe\xa9\x53\x51\xb7\x4a\x27\x74\xa1\xa5\x7d\x62\x69\x82\x30\xda\xe6\x13\xc8\x49\x31\x3a\x1d\xd8\x51\x87\xd0\x5b\x76\xcf\xf5\xd3\xb3\xf6\xb3\x16\x1e\xbb\x07\x3a\xce\xee\x9e\x76\x9e\x59\xed\x01\xf2\x49\x57\xfb\xd9\x2e\xea\xad\x1e\x5d\x21\x27\x1b\x9e\xde\x19\x10\x74\xa1\xe7\xff\xdd\x7a\x85\xa9\xb8\x27\x13\x73\xe5\x04\xe6\xfc\x09\x37\xba\x95\x3f\x20\xca\x07\x57\x58\xe2\xb0\xbc\x0f\x81\xd8\x65\xda\x8d\x4e\xbc\x0d\x8b\x7b\x04\x9e\x60\x37\xee\x1f\x72\x5f\x82\x43\x1b\xc3\x05\xce\xe8\xa4\x75\xbe\x7a\xa3\x0f\xb3\x33\x57\xb9\x65\x48\xb5\xa9\x04\x58\x50\x4e\xae\x8a\xd4\x67\xb3\x3a\x51\x40\x27\xb7\xe6\xf1\xa4\xe3\x71\xbf\x8b\x88\x08\xd2\xc0\x6a\xc2\x70\x88\xc3\x11\xd6\xa4\xa3\x3d\x00\xe2\xa3\xeb\x98\xbe\x05\x15\x34\x4c\x12\xd0\x18\x5c\xbb\xc9\x9e\x47\x9d\x5b\xde\xd0\xf8\xb3\xae\xed\xbf\x73\xaa\xf1\x23\xa7\x3a\x84\xc9\x4e\x3a\x98\xec\xc0\x03\x1f\xcb\x7b\x9a\xdb\xef\xe4\x3c\x73\xd1\xe9\xc1\x7c\x07\xa0\xff\xd1\xb9\xce\xa3\x6e\xc8\x73\xde\x83\x5f\x80\xe3\xfc\x69\xc6\x8f\x9a\xe6\x37\xe2\x36\x7b\x73\x6d\x1f\xab\xd9\x3b\x70\x0f\xe6\x35\x0b\xf8\x1f\x98\xd7\x2c\x09\x42\x46\xb3\xad\xbf\x00\x97\xb9\x09\xc6\x0f\x9f\xe0\x37\xe2\x2f\x1d\x31\xf1\xbc\x5a\xc0\x17\xa5\xeb\xc4\x9d\x1b\xd4\xb0\xd9\xd7\x26\xb0\x6a\xdc\xf1\x87\x71\x1b\x4d\xf3\x4b\xb3\x9a\xc6\x9d\x78\x49\x67\x8b\x42\x56\xdb\x7d\xfc\x10\x2e\xd1\xd1\x82\x2a\xbf\xc6\x2a\xd6\xe7\x5c\xa2\x36\x3f\xb7\x50\xc3\xf6\xc7\x73\x4a\xd7\x24\xe3\xc7\x4c\xf2\x6b\x73\x8b\xd0\x0e\x32\x86\x3d\x0a\x33\x1e\xa6\xc6\x83\x9e\x63\x2a\x78\xe7\xd6\xb0\x7d\x81\x47\x87\x3b\xe6\x6a\x12\xdc\x30\x7b\x31\x78\x77\x90\x79\xb2\x3b\xc4\xdd\xfd\xdd\x1d\x63\x1f\xed\x0e\xd2\xf7\x7b\x77\x47\x34\xd9\xee\x9d\x77\x6e\x98\xf7\x22\xd1\x7b\x74\xde\x62\x8e\x88\xde\x73\x74\xc7\x4d\x5f\x7b\xb1\xda\xec\x80\xb9\x7f\x38\xa4\x53\xb1\x53\x7d\xdb\xb2\x69\x35\xc9\x62\xfb\x80\xae\x3b\x56\x75\x09\x01\xab\x78\x07\x70\x06\xec\x09\x6c\xc7\xa4\x94\x14\x24\x61\x4b\xfb\xa6\xa3\xbd\x3a\x49\x57\x77\x67\xd9\xad\x48\x87\x0a\xb1\x1c\xba\x3b\x7d\x66\xc4\xa4\xd4\xb1\x48\x30\x80\xba\x42\x04\xe0\x5f\xed\xb4\x77\x20\x75\xe9\x44\xbb\x6b\xea\x18\x6b\x0d\x00\x87\x93\x5a\xf0\xeb\x11\xa3\x3f\x43\x9e\x9b\xee\xde\x75\x47\x24\xde\x9f\x56\x52\x65\x33\x7c\x7f\x4a\xcd\xd3\xac\x1c\x1a\xde\xd1\x65\x87\xeb\xcc\x54\xc0\x81\xf6\x59\x0b\x08\xa5\x5d\x99\xb0\xa1\x03\x43\x82\xea\xb7\x4b\x75\xdd\x5f\xa7\x1b\xda\x78\xf8\x52\x35\xdf\x86\x1f\xdc\x8c\x4d\xdb\xad\x6c\xdd\xf3\x37\x68\x44\x74\x01\x9c\x30\x2b\xcd\x15\xcc\x73\xad\x39\x5a\xd7\xc0\xf5\x7b\x8f\x36\x0c\x0b\x0e\x6e\x84\x7d\x93\x81\xff\xa2\x01\x02\x12\x51\x98\xa6\x11\x8c\xec\xe5\x72\xbb\x7d\x91\xae\xff\xbb\x88\xe8\x88\x8f\x5a\xc6\xee\xeb\xf9\x31\x01\xd3\x17\xf7\x09\x85\x7b\x91\x79\x18\x16\xef\x42\x5e\x72\xc8\x98\x76\xe6\x21\xb5\xd3\xf4\xab\x23\xf7\x5d\xc3\xf6\x0e\x31\xd3\x66\x70\xf2\x7f\x75\xa1\x63\xef\xe1\x23\xd3\x1d\xb1\x3f\xf1\x1b\x7e\xa9\x2f\xdb\x4e\x91\x4f\x30\x69\x4b\xaf\xef\x00\xd6\xa2\xb7\xa3\xb8\xd3\xd9\xe3\x16\xab\xa5\x61\xfd\x7f\x36\x5d\x1c\x69\xce\x75\x35\x8b\xd2\x24\x6f\x45\x7a\xa4\xb5\xc1\x7d\x97\x7a\x31\x19\xea\x38\x96\x30\x1f\x69\x4a\x80\x2e\xa2\x83\xea\xb8\xdb\xb0\x66\x29\xa5\xbd\x75\xce\x45\x1f\x17\x64\x69\x50\xf4\x8c\x3d\x2e\x58\xc0\x63\xed\xb7\x5c\xa5\xee\x81\x3e\xc0\x6b\x52\x2c\x6d\x53\xd1\xea\x1d\x9e\xd2\xb9\x9c\x41\x30\x6b\x9b\xa7\xda\x93\xdf\xb4\x9f\x1f\x82\xc3\xee\xa0\x43\x50\xf1\x39\xa8\x8d\x46\xe5\x3f\x3b\x04\x85\x70\x40\x7b\x7a\x9d\x9e\xf2\x5f\xcd\x44\x56\x42\x57\x52\x82\x41\xc1\x9b\x0b\xc4\x5b\xb8\xe9\xe0\x12\x6d\x4a\x90\x00\x62\x95\x55\x4e\x0c\xef\xa8\x1c\xbc\xa9\xc9\xbc\x87\x29\xcf\x82\x56\x2d\x22\x98\x3b\x6c\xde\xf5\x84\x25\xca\xcd\xdb\x2a\x23\xfb\x46\xbf\xe6\x15\x97\x78\x63\xc0\xbd\x6d\x51\xd5\x25\x9e\x08\xe8\x17\x97\x78\xef\x8b\xc2\x91\xee\xa7\x1e\x81\xba\x1b\x7b\xbb\x77\x04\x02\x69\x1e\x06\xc7\x61\xb5\x03\x4a\xbf\x23\xb3\x8d\x29\x2d\xe5\xcb\xa2\x28\x75\xf1\xa9\xb4\xb3\x69\x8b\x63\x09\xa1\xcd\x8f\x35\x6d\xa9\x28\xf0\x0a\x82\xfe\x8d\xce\x5d\x25\x52\xf7\xc6\xc7\x8f\x1f\x6b\x14\x29\x66\xf2\xbe\x1e\xe8\x7d\x53\xf6\xb7\xcd\x21\x95\x46\xed\xb5\xdd\x46\xef\x09\xe2\x54\x8f\xcf\xd5\x02\xd7\xfa\x67\xb1\xc1\x15\xc2\x8f\x73\x95\x42\x03\x20\xc1\x12\x7a\x11\x21\x35\xa7\x63\xf8\xa8\xc7\x1e\x54\xbd\xfa\xdd\x5f\xd0\x0f\x57\x11\x12\x89\xb4\x5a\x86\x2a\x0d\x5f\xe0\xd2\x70\x97\x11\x8c\xbb\x79\xab\x2d\x3d\xff\xcf\x62\xff\xcb\x58\xec\xb1\x6c\xf4\x68\xb6\xf1\xed\x5b\xc0\x31\xf6\x1d\x52\xbe\xb6\x23\xfe\xd0\xc4\x08\xdf\x19\x80\x4d\xc6\x87\x5a\xd5\x39\xed\x8e\x51\xb9\xf4\x32\xd4\xe8\x4e\x42\x9a\x81\xfa\x5d\x1a\x17\xd1\xd3\x3f\xfc\xc1\xba\x07\x0a\xdf\xf2\xe4\xde\x96\xea\x0b\xcd\x39\xde\xfc\xc6\x51\x18\x7a\x20\x38\xe4\xd6\x95\xc5\x81\x2e\x01\x5e\x44\xdf\xd2\xeb\xa0\xf0\x93\xc8\xf8\xb0\xc1\x14\x75\x8c\xf1\x93\xc5\x4b\xd9\x7f\x24\x04\x5b\x60\x68\x20\x7d\xde\x94\xc2\xff\x3d\x40\x57\xcb\x68\x0c\x1f\xab\x9c\xa3\xbf\xc9\x3a\x91\x6c\xb8\x03\x5b\x1d\x1d\xcf\x15\xbe\x33\xc3\x75\x42\x36\x78\xa9\x6f\x96\x69\xcd\x61\xee\xdc\x53\x59\x5a\x2d\x90\x2b\xdc\x31\x34\xed\xdd\x0f\xaf\xbb\xb7\x23\x1d\x1f\xab\x65\xf5\x6f\xb3\xb2\xbc\x40\xa4\x89\x41\x83\xc7\xa7\x27\xbf\x3f\xd9\x6d\x7d\x76\x72\xd2\xd1\xfa\xb4\xdd\xec\xb3\x3a\x72\xa7\x69\xb3\x4b\x71\x1c\x1f\xfa\x79\x74\x6e\x47\x01\x9d\xf1\xd8\xb8\x65\xf7\x21\xb1\xbb\x5e\x51\x5d\xae\xa9\x14\x10\xef\xdf\x62\xd5\x1f\xde\xf6\x16\x69\x86\x67\x71\x40\x0d\xa6\x0b\x75\x8f\x70\x64\xa5\x4b\xd3\x87\x74\x56\x87\x45\x8c\xc9\xe1\x3e\x9e\xef\x35\x98\x90\x29\xa2\x43\xb2\x9e\xae\x31\x00\x2c\x92\x89\xd4\x0f\x7a\xcd\x59\x19\xc3\x43\x31\xc2\xf0\x53\x73\xce\x6f\xdd\x17\x2a\x6c\x0b\xcf\x6f\xf1\x25\x52\xe6\xbc\x08\xc7\x24\x20\x7b\x8d\xb3\xb3\xe7\xb0\xd7\xf4\xb3\x51\xfd\x8e\xf7\x62\xf5\xc7\x7f\x07\x00\x00\xff\xff\x94\x79\x14\x00\x36\x5b\x00\x00"),
-			uncompressedSize:  23350,
+			modTime:           mustUnmarshalTextTime("2026-10-17T01:46:56.739381019Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfd\x73\x1b\xb9\xb1\xe0\xef\xfa\x2b\x7a\xc7\xbe\xa7\x61\x4c\x0e\x25\x6b\x73\x77\x91\x44\xbe\xda\x58\xf6\x8b\xf3\xf6\xc3\xb5\xf6\x6e\xee\xce\x71\xa5\xc0\x99\x26\x09\x6b\x38\x98\x00\xa0\x28\xae\xc2\xff\xfd\xaa\x1b\xc0\x7c\x71\xa8\x0f\x27\x9b\xab\xba\xbc\xd5\x96\x4c\xce\x00\x8d\x46\x7f\xa1\xbb\xd1\x80\xee\xee\x32\x9c\xcb\x02\x21\xfa\x20\x6d\x8e\xd1\x6e\x77\x77\x27\xe7\x90\x7c\xd0\x22\xc5\xe4\xed\x55\xf2\x4e\x68\x2c\xec\x6e\x67\x4a\x51\xc0\xdd\x5d\xfd\xe2\x7d\x29\x8a\xdd\x0e\x46\x70\x77\x87\x45\xb6\xdb\x81\xa5\x37\xad\x26\xfc\x81\xdb\x88\xb2\xcc\x84\x59\xfa\xa6\x47\x47\xf5\xb0\xdf\x09\x59\x44\xee\x91\xc5\x55\x99\x0b\x8b\x10\xbd\xc9\xc5\x0a\xff\x43\x8b\x72\xc9\xaf\x2e\x4d\xaa\x65\x69\xc1\xe8\x74\x12\xdd\xdd\x25\xbf\x17\x06\x7f\xfa\xf1\xdb\xdd\xce\x58\x61\x65\x3a\x7e\x25\x16\x98\x8d\xb3\xb3\x91\x95\xe5\x58\x16\x19\xde\x26\x9f\x4d\x34\xbd\x1c\xbb\x7e\xd3\xa3\xcb\x5c\x16\xd7\xa0\x31\x9f\x44\xc6\x6e\x73\x34\x4b\x44\x1b\xc1\x52\xe3\xfc\x61\x80\x78\x2b\x56\x65\x8e\x23\xd7\x33\x49\x8d\x89\xa6\x47\x47\x97\xfc\x75\x7a\x04\xf0\x2c\x55\xe5\x76\xf4\xd9\xa8\xe2\x7c\xa9\x6e\x50\xc3\xdd\x11\x00\x40\xba\xd6\x46\xe9\x73\x28\x95\x2c\x2c\xea\x8b\x23\x80\xdd\xd1\xe5\xd8\x77\x3b\xba\x5c\x9e\x4e\x3f\x1c\xa2\xd8\x11\x00\xb3\xa1\x50\xb6\x87\x15\x0c\xfe\x92\x19\xc2\xd0\x26\xd1\x5c\x15\x76\x64\xe4\x2f\x78\x0e\xa7\x2f\xcb\xdb\x0b\xb8\x41\x6d\x65\x2a\xf2\x91\xc8\xe5\xa2\x38\x87\x95\xcc\xb2\x1c\x2f\x22\xc2\x97\x7e\x62\xff\xaf\x83\x22\xb3\x49\xc4\x93\x28\x51\xaf\x04\xd1\x6a\x94\xe6\xb2\xac\x5a\x03\x5c\x8a\x9e\x46\x11\x64\xc2\x0a\x6e\x3a\x53\x42\x67\x23\x8b\xb7\x96\xe9\xf9\x2e\x34\xd9\xed\x1a\x54\x6e\x3e\x9d\x56\x5f\x2e\xc7\x22\x8c\x73\x39\x26\x74\xc2\xb7\xbf\xf5\xe3\x48\x84\xf6\xe8\x35\xb1\xa2\xc7\x87\x11\xfa\xe3\xfb\x1f\xbe\xf7\xb4\x8d\xa6\xaf\x6f\x4b\xa5\x2d\x08\x03\xf4\x98\xc6\x3f\x30\xb0\xa8\x71\x7f\xa5\x56\xa5\xd0\x4e\x48\x22\xb0\xa4\x2b\x44\x33\x7e\x08\x76\x29\x8d\x17\xff\x8d\xb4\x4b\x10\x85\xb2\x4b\xd4\xa0\x0a\x04\x23\x33\x84\xd9\x96\xff\x8d\xa6\x1e\x4c\x63\xce\xcc\xe7\xe4\x95\x28\xde\xc9\x62\xb7\xdb\xc7\x80\xc8\x5e\xca\x62\xc4\xe0\x03\x31\x9f\x55\x28\x94\xb2\x28\x30\x73\x83\x1b\x20\x64\x0a\x24\x19\xcc\x30\x47\x8b\x19\x88\xb5\x55\x2b\x92\x69\x91\xe7\xdb\x68\xea\x46\x7b\xc7\x9d\x76\xbb\x9f\x8a\x52\x16\x77\x77\x98\x1b\xdc\xed\xde\xf1\x47\xd2\xe4\x16\x76\x4e\x61\x3b\xb8\x5e\x31\xf4\x43\xe8\xba\xb1\x0f\x61\xec\xde\x36\x68\x16\x4d\x1d\xb8\x83\xc3\x0e\xaa\xa7\x4d\xe4\x9d\xe8\xa6\xb9\x30\x66\x12\xe5\x62\x86\x39\xf0\xef\x91\x2c\xe6\x2a\x9a\xba\x66\x9e\xb1\x7d\xd3\x60\x71\x48\xde\x08\x99\xdf\x0b\x2d\x13\xc5\x02\x75\x85\xbd\x00\x02\x08\x6a\xde\x64\xfa\x9c\x81\x44\x53\x07\xac\x67\x4c\xff\xe8\xa8\x39\xb7\xcb\xf1\xf2\x94\xec\xc8\x57\xa3\x11\x7c\xc0\x5b\xfb\x8d\x46\x01\x71\xa1\x8a\xd1\x9b\x5c\x98\xe5\x00\xe6\x22\xcf\x67\x22\xbd\x86\xb9\xd2\xf0\x4a\x95\xdb\x17\xef\x84\xb1\x48\x63\x93\xdc\x06\x9e\x8f\x46\xd3\x96\xed\x7c\xbb\x22\xe9\x76\x32\x1e\x41\x26\x53\x0b\xd1\xdb\xab\x08\x1a\xda\x43\x7a\x1a\x05\x8b\x0f\xd1\x4f\x06\x21\xb5\x3a\x7f\x91\x82\xd2\x90\xaa\xd5\x4a\x14\xd9\x8b\x14\xac\x02\xea\x03\x76\x89\x8d\x11\x61\x86\xb9\xda\x9c\x47\x10\xfd\x2c\xf2\x35\x46\x10\x97\x5a\x16\x76\x0e\xd1\xc7\xff\x66\x3e\x45\x81\xb0\xef\xad\x96\xc5\x62\xd0\x34\xdf\x76\x5b\xe2\x24\xa2\xc1\xc7\x9f\xc5\x8d\x70\xc6\x99\x8d\x4c\x3c\x5f\x17\xa9\x95\xaa\x88\x07\xde\x7a\xde\x08\x0d\x69\x2e\xb1\xb0\x30\x81\x02\x37\xf0\x7f\x50\xab\x57\x41\xb1\x63\xc8\x54\xba\x5e\x61\x61\x93\x05\xda\xd7\x39\xd2\xc7\xdf\x6f\xdf\x66\x71\xc3\x18\x0c\x60\x70\x71\xc4\xc0\x1c\xa0\x44\x15\x71\xa4\x51\x64\xdb\x68\x08\xd5\x80\xc0\x4f\x5e\xdf\xd0\x48\x61\xf0\x56\x0f\x31\xb7\xa8\x09\x6a\xab\x17\x76\x3a\x00\x88\x1c\xb5\x8d\x23\x26\x14\x93\x80\x88\x27\x49\x37\x15\x54\x16\x29\x89\x06\x17\xbe\xc7\xce\x7f\xda\x05\x2c\xc7\x63\xf8\xa1\x00\x51\x6c\xdb\x73\x05\xd4\x5a\x69\xa6\xf2\x4a\x68\x99\x6f\x61\xb3\xc4\x02\x58\x48\x40\x1a\x5e\x23\xc4\x8d\x90\xb9\x98\xe5\x38\x80\x0d\x06\x60\x95\xfc\x58\x05\x6b\x23\x8b\x05\x33\xd2\x58\x51\x64\x42\x67\x40\x7c\x10\x1a\x45\xd2\x25\x11\x8f\xd7\x9c\x2c\xee\xd1\x25\x43\x63\xb5\xda\xc6\x41\x33\x9f\xc7\x51\xbd\x0a\x46\x83\x24\xcd\x65\x7a\xbd\xcf\xd4\xbd\xa6\x6c\xaa\xa3\x41\xb2\x94\x19\xc6\x83\x8b\x03\x8d\x08\x53\x02\xaa\xf2\x5c\x94\x06\xe3\xc8\x2c\xd5\x26\xba\xb7\x39\x24\x61\x7a\xd1\x20\x99\xab\x74\x6d\xe2\x41\x62\x30\xc7\xd4\xc6\xf7\x72\xe0\x7b\x55\xd3\x8d\x88\x8b\x98\x61\xc6\x1a\x48\xc4\xab\x96\x3e\x88\x67\x98\x8a\xb5\x41\xa6\x29\xad\x74\x20\xad\xc1\x7c\x4e\x1c\xa1\x47\x01\xc8\x20\xa9\xc4\xb9\xea\xfc\xea\x8b\xe5\xba\x02\xe1\x84\x9b\x20\x77\xa0\x3e\x45\xc8\x2b\xb2\x35\xc0\x76\x59\xd7\xe0\x3d\x00\x26\xa5\x66\xc1\xbf\xc2\xb9\x58\xe7\x3d\xa4\xec\xc7\xe7\x89\x2a\x54\xb9\x06\xbd\x1a\xf4\xe7\xe2\xcf\xc5\x87\x25\xc2\x4f\x3f\x7e\x1b\x68\x9e\xaa\xc2\x0a\x59\x38\xca\x63\x61\xa5\x46\x67\x1d\x87\xa0\x8a\x7c\x0b\x66\x49\x0b\xa3\xb4\x6e\x75\x9e\x6b\x89\x45\x66\xbe\xea\x57\x45\xfa\x4d\xf3\xaa\x9d\xc7\x47\xd8\xaf\xf1\x18\x7e\x2f\x8b\x4c\x16\x0b\x53\xcb\x8a\x24\x65\xce\xfc\x4a\xcc\x32\x62\x92\x83\xb6\x8e\xd1\xa5\x29\x4d\xa0\xf6\x47\xbd\x11\xdd\xed\xe0\x05\x44\xa2\x94\xe3\x9b\xd3\x31\x37\x34\xe3\x1e\xa7\x31\xba\xa8\xa0\xd1\x82\x04\x93\x9a\xd6\xb7\x4b\x5d\xd3\xd9\x53\x99\xd5\xfc\x1c\x22\x78\x01\xb7\x4b\x9d\x68\x34\xa5\x2a\x0c\xd2\x52\x14\xc8\xe1\xd5\x82\xe4\xa4\x76\x41\xee\x13\x91\xc3\x02\xf2\x3c\x11\x9f\xc5\x6d\x7c\xb7\xd6\xf9\x79\x3d\xd9\x17\x10\x8d\x4b\x59\x44\x43\xa6\xed\x79\x67\x85\x8f\xae\x5e\x7f\xfb\xfa\xc3\xeb\x28\x78\x28\xd1\xbb\x9f\x3e\x44\x7e\xf9\xdc\x05\xd3\x03\x90\x64\xaa\xc0\x16\x55\x21\x57\xa9\x20\xdc\x12\x8d\xb9\x12\x59\x3c\x80\x66\x7b\xa2\x4e\x4c\xbf\xba\xfa\x4f\x13\x6d\x39\x2f\x5f\x36\x57\x39\x8f\xbf\x4a\x55\x31\x97\x7a\x15\x47\x57\xde\xdd\x39\xe4\xea\xff\x7b\x34\xa8\x61\x02\x68\xb4\x6b\x5d\x04\x48\xbb\xfb\xa8\x17\x88\x16\xc8\x74\x3f\x49\x36\xb2\xc8\xd4\x26\xa9\x28\x43\x6e\xd9\x61\x61\x63\x0e\x99\xe8\x41\xb2\xf5\x69\x4b\x26\x6f\xa6\xfc\x9b\x9d\xf3\x67\x0c\x6a\xd4\x27\xaf\xc1\xdd\x72\x2d\xac\x5c\x61\x2e\x0b\xa4\xb8\xad\x0d\x82\xa3\xaa\x1f\x91\xc2\x2e\x00\x06\xec\x3b\xa6\x2a\x57\x1a\xb3\x2b\x79\x53\x75\xf2\x0d\xa8\x5b\x21\x56\xd8\xf7\xdc\xa4\x5a\xe5\x39\x66\x7f\xc9\x84\x6d\x8c\xd6\xfa\xe7\xa8\x1e\x9d\x8c\x0b\xde\xda\xef\xb0\x58\x57\x18\x67\x5a\x95\x99\xda\x90\xc3\x88\x42\xcf\xe5\xad\x43\x6d\x9d\x77\x1b\x8c\x56\xdc\x4d\x2b\xf2\x7c\xdd\x67\xa1\xa5\x18\xb1\xa7\x9a\x63\x36\xdb\xd6\x6d\xdd\x08\x3e\xa2\xcb\xa4\x29\x73\xb1\x3d\x9f\xe5\x2a\xbd\xbe\x28\x95\x91\xc4\xb5\x73\x17\x9f\x5e\xac\x84\x5e\xc8\x62\x34\x53\xd6\xaa\xd5\xf9\x6f\xcb\xdb\x10\xd9\x5d\xe6\xd2\x0f\x56\x6a\x34\x58\x50\x73\x55\x54\x78\x13\x49\xa0\xc2\x6d\x89\x22\x43\x4d\x14\xc8\xe5\xf4\x28\xf4\x9f\x5e\x0a\xb0\x62\xc6\x61\xf4\x24\x1a\x9d\xfa\xa0\x4a\xb0\x28\x4d\x78\xed\x1d\xa5\x4b\x99\x67\x1a\x8b\x86\x77\xcf\x8d\xac\x5a\x2c\x68\x70\xab\x54\x6e\x65\xe9\x9f\x96\xb9\x48\xd9\x43\x9b\x44\x5a\x2e\x96\xb6\x72\xa6\x09\x16\x88\x3c\x87\x00\xcf\xf9\x96\xce\xb5\x26\x8f\x39\x9a\xbe\xa7\x26\xaf\xfc\x6b\x8a\x10\x1c\xb2\x8f\xc3\x95\xdc\x8a\x7f\x14\xae\x04\xeb\x01\x5c\xff\x40\x4d\xbe\x14\xd7\xb9\xcc\x2d\xea\xbf\x13\x49\x22\xe8\xb8\x07\x53\x61\x30\x03\x55\x80\x00\x3f\xcc\xf4\x0d\xff\x5b\x23\x79\x18\xcb\x36\x42\x01\xdd\x34\x57\x06\xa3\xe9\x2b\xfa\xa7\x39\xd5\xcb\xf1\x3a\xbf\x47\x8b\xdc\xb0\xff\x5f\xe8\xd2\xbe\x1a\x35\x43\xc8\x60\x7c\xe8\xd9\xf4\x1c\x02\xb9\xdb\xa4\x96\x45\xb9\x6e\xba\x15\x15\x6c\xc7\x25\x72\x25\x56\x23\xa2\x9c\x56\xf9\x97\x09\x04\xc1\x06\x01\xd7\xb8\x3d\xbf\xa1\x68\x0d\x4a\x21\x35\xbb\x25\x34\x27\x03\x48\xa9\x29\x8a\x50\x44\x59\xe6\x5b\xf6\x5b\x82\x20\xb2\x90\x2d\x55\x9e\xa1\x9e\x1c\x57\x00\x92\x24\x39\xfe\x27\x88\x8c\xa7\xc3\x8d\xc4\xcd\x77\x2a\x43\x27\x12\xb3\xb5\xb5\xca\x65\xab\x66\xb6\x78\xaf\xb4\x7d\x6f\x85\xb6\x1f\xe4\x0a\x2b\xca\xcd\x6c\x01\x33\x5b\x8c\x32\xb7\x28\x47\x53\x6a\x06\xbf\xdf\x82\xa1\xa6\x40\x8b\xcc\xe5\xd8\x01\x3a\x00\xf3\x75\x91\x3d\x0e\x22\x16\xd9\x63\xe0\x5d\xad\x75\x5b\x70\x0e\x02\xcc\x7c\xcb\x07\x00\x7e\x4b\x6b\xc7\xc3\xd0\x58\x2d\x6a\x50\x35\x7d\x59\x2b\x9a\xce\xac\xcb\x68\x02\x24\xe2\x56\x1a\x28\x85\x5d\x0e\xab\x6f\xb4\x22\x7b\xf7\x64\x2e\xf3\xfc\x1c\x0a\x55\xa0\x73\x4f\x28\x04\xbc\xc6\x73\x98\xe5\x22\xbd\xf6\x8f\x96\xa2\xc4\x91\xc6\x22\x43\xf2\x25\xce\x21\xd5\xd2\x94\xaf\xb3\x05\x1a\x6a\xb0\xab\xc0\x92\xb4\x07\xb0\x94\xbb\x9c\x8b\x95\xcc\xb7\xe7\x60\x44\x61\x46\x06\xb5\x9c\x5f\xd4\x2f\x7d\x62\xf3\xa4\xbc\xad\x80\x04\x67\xc1\x2d\xa4\x4f\x85\xf4\xb2\x86\xf4\x2c\x40\x7a\xe9\x31\x73\xa0\xac\x16\x85\x21\xf5\x63\x67\xb5\x30\x94\x96\x8e\x4f\xca\xdb\xe1\xd9\x49\x79\xeb\xfd\x9f\xd1\xca\x8c\x1e\x68\x07\xe3\xdf\xc0\xdb\xd7\xf0\x3b\xf8\xcd\xd8\x75\xd9\xe0\xec\x5a\xda\xc7\x74\x7b\x2f\xe6\x42\x4b\x56\xd5\x57\x4b\xad\x56\x58\xc1\x50\x8f\xe9\xfe\x43\x89\x5a\x54\x5d\x56\xea\x97\xc7\x74\x7a\x23\x35\xce\xd5\xad\xeb\x46\x74\x7e\x16\x5c\x2f\x48\x6a\x5f\xcb\x53\x7b\x89\xb4\xf4\x9c\xbf\x24\xb6\xc0\x46\x66\x76\xe9\x3f\xcf\x73\x25\xec\x79\x8e\x73\x5b\xb3\xab\xe5\xe1\x81\xc6\xd4\x26\xa9\x96\x9c\x96\x84\xbb\x96\x34\x3d\x3b\x3b\x3b\x6b\xca\xd7\xc8\x81\x86\x06\xcf\xba\xe0\x48\x98\xba\xe0\x98\xdd\x1b\x87\x22\xcc\x54\x9e\xdd\x8b\x0b\x79\xb5\x98\x75\x31\xc9\x7e\xf7\xdb\xb3\xaf\xe7\x7d\xc8\x9c\x3d\x80\x4c\x0b\x9e\xd3\x9b\x06\xb4\x36\x65\x9f\xd1\x52\xe1\x9b\x86\x95\x0a\x64\x41\x32\x39\x72\xde\x1f\xbf\xf2\xcb\x14\x91\xf5\x1c\x4e\x92\x33\x5c\x55\xa0\x1a\x1e\xea\x10\x9e\xed\xad\xb4\x5f\xa8\x1d\x00\xd5\x4a\x09\x62\x66\x54\xbe\xb6\x78\xd1\xc6\xb2\xb6\x05\xbf\x8c\xd8\xfc\x93\x96\x9e\xf4\xe1\x05\x49\xb5\x5c\x92\x17\x3c\xcd\xe5\x94\x56\xc6\xee\xb4\x1b\xf3\x2d\x45\x46\xf1\xf4\x39\x9c\x95\xb7\xf0\xd2\xeb\x3e\x25\xa0\x50\xe8\x73\x98\x29\xbb\xbc\xd8\x67\xf4\xd7\x6e\x74\xa0\x68\x1b\x47\x5e\x42\xe1\x34\xf9\xfa\xe5\xff\xfc\xed\xff\x38\xfd\xda\x0b\x16\x8b\x72\x53\xd2\x36\x4b\x69\x71\x64\x4a\x91\x22\x4d\x6a\xa3\x45\xb9\xb7\x5d\xf3\x85\x39\x4c\x5a\x01\x5d\x98\xf5\xb3\x34\x57\xc2\x8a\xdd\xae\x0e\xd3\xc9\x5d\xfb\xe0\x05\xe7\xd5\x92\xd6\x27\x6e\xf9\xbe\xfb\xb8\xd9\x87\x25\x10\x26\x94\x22\x4b\x7c\xde\x03\x75\x34\x48\xf8\x79\xdc\xc8\x64\xe1\x0a\x52\x55\xd0\x46\x90\xcb\x8b\x38\x67\x23\x96\x05\xe0\x0a\xd6\x85\xb4\x66\x40\x0b\x7f\x29\x6f\x31\x37\xee\x01\x5b\x1b\x17\x81\x1a\x90\x96\xb2\x15\x50\x25\x11\x00\x57\x31\xae\x7e\xa2\x76\x75\xc0\x4a\x18\x11\x07\xde\xcb\x5f\x10\x26\x50\x0a\x6d\xf0\x0d\xe9\x7f\xfc\x3c\x3e\x9e\xa9\x6c\x7b\x3c\x48\x52\x63\xe2\xe3\x4a\xc0\x8e\x07\xde\x7c\x86\x58\xb7\xee\xff\x1b\xf0\xf0\x7d\x7c\x59\x4d\xa5\x58\xaf\xde\x68\xb5\x7a\xdd\xc0\x8e\x66\x54\xac\x57\x33\xf2\x92\xb4\x5a\xf9\xcc\x4f\x46\xc9\x71\xfa\x58\x2a\x4b\x79\x20\xda\xf4\x80\x85\xd0\x33\xb1\xa8\xd2\xa2\xc6\xd2\xd2\x34\x04\x4c\x16\x09\x44\x41\x6f\xdf\x5a\x5c\xfd\xe5\xf4\xeb\xaf\xcf\x22\x18\x4d\x81\x3e\xb4\x27\x5f\xa3\x10\x1b\xdb\x48\xa6\xf8\x39\xf0\xc4\xdf\x16\x96\x5e\x26\x2b\x61\xd3\x65\x3c\x8e\xff\x9c\xbd\x18\x3c\x1f\x0f\x3e\x9e\x7c\x1a\xc2\xe9\x89\x9f\x76\x3d\xab\xb7\x85\x24\x0c\x69\xe6\x33\xa5\xac\xb1\x5a\x94\xe0\xfd\x3a\xce\x14\x51\x42\xe2\xf8\x63\xaf\xdb\xf7\xe9\x78\x90\xf8\xcf\x4d\x9e\x1b\xb4\x21\xfe\xf8\x59\x1a\x39\xcb\x11\x36\x22\xbf\x26\x01\xd0\x6a\xbd\x58\x32\x99\x08\x20\x73\x7a\x2e\x8b\xcc\xb4\x23\x85\x58\x16\x69\xbe\x26\xc5\x0b\x20\x33\x49\x19\x63\x4b\x7b\x59\x66\x10\xc8\xbb\x90\x37\x58\x70\xd4\xf3\xf6\x2a\x81\xb7\x16\x56\x42\x5f\x1b\x40\x91\x2e\xa9\x21\x6d\xad\xdd\xf8\xf1\x63\xab\xd7\x08\x4a\x07\x78\x73\x91\x1b\x1c\x24\x6d\xea\xee\xe3\x1d\x3b\xe0\xc3\x00\xa7\xa6\xf8\xf3\x84\x86\x89\x69\x16\x8d\x6c\xa2\x1c\x02\xef\xb9\xd5\xed\x38\x0f\xc3\xcf\x12\xda\xa4\x2b\x2c\x6d\x58\xbf\xbd\x82\xaf\x26\x1e\xf1\x66\xd3\x6e\xe2\xa5\x4e\xbd\x80\x83\x9b\x84\xf9\x4c\x02\x46\x75\xd3\x1e\xec\x5d\x9f\xee\x1c\xf6\xf2\x8d\x15\xe3\xd2\x5c\x15\xf8\xc3\xec\xf3\xf7\xea\x4a\x59\xe3\xbe\x9a\x06\xa9\xd5\xec\x33\xa6\x16\x62\x62\x96\x9a\x83\xb4\xc7\x86\x9c\x7a\xc3\x7c\x64\xc7\xdc\x0c\x88\x11\x01\x5e\x53\x4d\x18\xd8\x10\x66\x6b\x9f\xff\x24\x18\xdc\xd7\x9b\x0f\xda\x19\xc8\x68\xd4\x38\x19\x80\x46\xf6\xfb\x33\x6e\x1a\xa0\xad\xc9\x9f\x33\xa9\xd2\x68\x12\xf8\x40\xc1\xb9\x34\xb0\x36\x38\x5f\xe7\x10\xf2\xe0\x6f\xe8\x97\xd5\x28\xac\xc7\x8c\x00\x38\xb8\xc2\x80\x48\x53\x34\x46\x69\x13\x40\xca\xc2\x2a\x30\xeb\xd9\xc8\xcd\xcc\xd0\xce\x97\x85\x5c\x5a\xd4\xac\xb4\x84\xf8\x35\x6e\xbb\x82\xd2\xa6\x53\xac\x6a\x1e\x92\x25\x2a\x1c\xf5\x26\x70\xb7\xbb\x68\x4b\x8b\x6a\x88\xca\xf5\x10\x6e\x9a\xbc\x77\xbd\x3e\x5e\x27\x7e\xee\xf1\xf8\xcf\xc9\x78\x31\x3c\xfe\xcb\xf1\xe0\x13\x4c\xe0\xa6\xc3\xb4\x4a\xe7\x5d\xbf\x2e\x27\x5d\xf8\x14\xe4\xe1\xcd\xfa\x97\x5f\xb6\x44\x2a\xe3\x09\xa4\x60\x4e\x8f\x46\x06\x85\x4e\x97\xfb\x7a\x19\x07\x38\xa6\xc4\x54\xce\xc9\x41\xca\xb7\x43\x96\x04\xf2\x13\x1c\xc3\xad\x58\x98\x01\x7f\xa2\x58\xbf\xa3\xc2\xe8\x76\x0d\x88\xf7\xc2\x42\xa6\x02\x40\xa2\x2f\x5b\xa6\x0e\x49\x7b\x10\xae\x94\xcf\xbd\xab\x89\x35\x1e\xbb\x69\x2c\x89\xa5\x90\xcb\x95\x74\x41\x31\xd9\x85\xb3\x97\x90\x2e\x85\x16\x29\x45\x94\x7e\x7a\xa5\xb0\x16\x75\x41\xde\x13\xe5\xc6\x87\x60\x14\x6c\x10\x3e\xaf\x8d\xad\x21\x9a\x5c\xa6\x4c\x99\xb3\x97\x20\x8b\x54\x18\x04\xa3\x56\x48\x76\x84\xc3\x53\x03\x2b\xa5\x11\xe2\xcd\x52\xa6\x4b\xd8\xa8\x75\x9e\x41\x53\xe6\x14\x68\x21\x0d\xd6\x00\x45\x01\x78\x9b\x62\x49\x98\x79\x01\x02\xcf\x17\x4a\x8a\xf3\x87\x84\x47\x8d\x4f\x86\x70\xf6\x32\x18\x50\xee\xfc\x23\x52\xe1\x86\xbc\xc1\x7c\x0b\x19\x9a\x94\xa2\x3c\x16\x56\xb2\x3a\x6c\x39\x78\xd9\x26\xa5\xf1\x0c\xa0\x8f\x95\xe5\x0b\xa9\x96\x1a\xa0\x5a\x57\xe4\xd0\x68\xd6\xb9\xf5\xb6\xdd\xfb\x07\x7e\x88\x09\x14\xeb\x3c\x0f\x12\x16\x06\x6e\xa4\xf0\x9b\x36\xac\x29\xbd\x8f\x37\x87\x3c\xbd\x57\x4b\xa4\x1d\xc1\xa5\xb0\x2c\x53\x3c\x9f\x0d\x1e\x6b\x84\x5c\xa9\x6b\x9a\x8a\xb0\xb4\x87\x25\xdc\x9a\xd0\x36\xf8\x0e\x87\x36\x40\x82\x10\x26\x74\xaf\xd1\x3d\x34\x81\x3e\xe3\x5b\x29\x54\x35\xcc\x3b\xd4\x14\xbb\x50\x06\x8b\xf4\x27\x50\x54\x15\x75\x02\xce\x1c\xb3\xe1\x49\xe0\x4f\x08\x99\x72\xcf\x85\xdf\x1f\xcd\xf3\x36\x38\x6e\x0f\x4b\x71\x83\x20\x33\xf2\x14\x28\x72\xa0\xde\x24\x4e\x15\xec\x21\xeb\x18\x4b\xd9\x46\x90\x4a\x05\xa5\xe4\x81\xda\x10\x9b\xfd\x9a\xf4\x20\x26\x6b\x98\xec\x59\x2e\xa6\x91\x16\x1b\xf2\x09\x07\x17\x9d\x0e\x73\x1a\xd2\xed\x0f\xd2\xe8\xf1\x47\xfd\x69\xd8\x21\x19\xe9\xc9\x7b\x2c\xc8\x43\xbf\xc1\x73\xda\xb4\x34\x38\x6c\xb5\x30\x4b\x52\x15\x4a\x07\x50\xc4\xb7\xee\xbc\xb5\x4b\x8d\x86\xd2\x3b\x1c\x4d\x0c\xfd\xd3\xf1\x18\xbe\x81\x5c\x6d\x50\xd7\x0d\x48\x1c\x58\x03\x49\x8b\x53\x3b\x84\xa5\x5c\x50\x75\x0b\x65\x07\xd0\x54\xd2\xec\xfe\x27\xc2\x9c\xc3\x0f\x6c\xd4\x13\xfa\x12\xeb\xc1\x90\xe8\x43\xf3\x84\xb9\xc4\x3c\x33\x07\x69\xb5\xdb\x23\x84\xd7\x18\x52\xdb\xb5\xc1\xc4\x71\x26\xf6\x66\xe9\xe2\xa8\xcd\x82\x2b\x2c\x91\x37\xe1\x28\xd5\xb9\x59\x22\x91\x98\x2a\x1a\x48\x02\x48\x88\x0f\x4a\x0e\x90\xf4\x61\x06\xeb\xb2\x0d\x90\xf6\xe2\x3d\x06\xc3\x5a\x5d\x64\xed\xdc\x28\x0d\x4b\x99\x65\xd8\x9a\x45\xd7\x5f\xf0\x10\x92\x1c\x8b\x85\x5d\xc2\x14\x4e\xf6\x11\x6f\xd8\x19\x36\xdb\x34\xd0\xb1\xa9\x8c\x7a\x13\xbc\xb7\x0d\x5e\x82\xbc\x2b\x73\x71\xb4\x4f\xc3\xdd\x51\xbb\x43\xab\xe9\xa1\x05\xeb\x9f\xe4\x2f\xf2\x8a\x18\xb2\xd1\x24\x0f\xe4\x40\x3a\xff\x91\x61\x33\x5b\x02\xc8\x86\x37\xe9\xb8\x99\xf8\x37\xa1\xc1\x37\xbc\xc0\xa4\x36\xf0\x56\x1a\x70\xe5\x85\x19\xcc\xb6\x2e\xfd\x09\x73\x95\x93\x5c\xfb\x27\x14\x02\xba\xed\x59\x01\x7f\x5d\x2b\xaa\x94\x62\x2f\xaa\x0b\x19\xfe\x13\xb7\xe7\x11\xde\x96\x98\x56\x6d\xa2\x4e\x9b\x37\x4a\x83\xaf\x11\x3c\xef\xbc\x82\xef\xc5\x0a\xcf\xa3\x1f\xf1\xaf\x6b\x34\xb6\xdb\xf1\xed\xbc\x4a\xc8\x43\xa6\xd0\xd4\x4b\x34\xd3\x5d\xcc\xd4\x4d\x50\x3a\xef\x2f\x90\x6c\xfb\x35\x75\x78\x80\x7f\x46\xe6\x58\xd8\x7c\x4b\x16\x21\x37\x10\x0a\x40\xc8\xa2\x8c\xdc\xe2\xd4\x54\x03\x59\x2c\xee\x75\x07\xee\xf3\x04\x7e\x16\xb9\xa4\x2d\xb4\x46\xd6\x38\xc8\x29\xa9\xae\x29\x73\x69\xdf\x74\x57\x5d\x7a\x18\x47\xe7\xf5\xde\xbb\x9c\xc7\x8d\x96\x41\x49\xbe\x9a\xc0\xcb\xe6\x22\x31\x1e\xc3\x77\xd2\x70\x11\x8b\x63\x1d\xed\xb2\xb7\x98\x3e\xac\xeb\x36\xac\x6a\xcd\x91\xf0\x6b\x28\xe8\x23\xfc\x9d\x8b\xa3\xfe\x85\x29\x68\x14\x4d\xef\x1a\x26\xcd\x29\x7e\x3c\xf9\x14\x5a\xd1\xdb\x9b\xce\xdb\xd3\xea\xad\x9c\xc7\x37\x1f\x4f\x3e\xc1\x57\x93\x09\x1c\x47\xc7\xf0\xb7\xbf\xc1\xcd\xc7\x1b\x3f\xef\xd1\x69\xf5\xe2\xc0\xec\x9b\xc2\xfa\xff\x96\x08\xe3\x31\xd0\x8e\x71\x09\x39\x8a\x2c\xb8\x43\x56\x0b\x99\x57\x78\x1a\x17\x9b\xb3\xd6\x9c\xfb\x6e\x44\x99\x1b\xef\x7d\x9d\x0e\xa1\x9e\x79\xed\x85\xfd\xd3\x22\xbc\xa3\x3d\xc7\x48\xce\x6b\x3b\xef\x9c\xdc\x6b\xdc\xd6\x41\x16\xe9\x79\x4a\xca\xc5\x5a\x4a\xf3\x24\xef\xae\x2d\xfb\x0d\xac\xfc\xf2\xfe\xf1\xfa\x13\x4c\x26\xed\xa0\x63\x7f\x99\xa0\x25\xba\x81\x1c\x50\xcd\xc3\xbd\x1d\x78\xc9\x6f\x4e\xa7\x9f\xb9\x1e\x97\x03\xdc\xdd\xed\xad\x07\x7f\xa2\xea\x32\x22\xc2\xda\xa0\x76\xdb\x44\x48\xc1\x04\x02\xef\xdc\x40\xd8\x90\x70\x8d\x7c\x8e\x0f\x28\xab\x37\x24\xdf\x9e\x22\x12\xca\x1d\xc1\x9f\xaa\x8c\x4b\x86\x69\x5e\x95\xc9\x32\x51\x0d\x96\x42\x93\xe9\xa8\xcc\x8e\xf1\x0b\x1f\x23\xdb\x82\x0a\xd2\xe2\xca\x40\x5a\xaf\x07\x7f\x5d\xcb\xf4\x3a\xdf\xd2\xd2\x8b\x7b\x48\x50\xee\x61\x83\x79\x0e\xb1\x41\x74\xfb\xc9\x7b\x41\xa4\xbd\xa5\x9c\xe4\x37\xfc\x8d\x27\xf5\xb8\xba\x0e\x2a\x0f\xf1\x43\x51\xff\x4e\xdd\xda\x2e\x64\x6c\x5a\x79\x4f\xf1\xb1\x67\x0f\x8c\xb2\x37\x54\x17\xc5\xc5\x25\xd1\xb0\x07\xa1\xa0\x0c\xe3\x71\xfb\x25\xa5\x06\x79\x9b\xd9\x97\x99\x49\xaa\x4c\x5f\x85\xbd\xc9\xaa\xf6\xc8\x63\xc0\xf4\x3b\x36\x40\xbd\x02\xb8\x20\x16\x2c\xd4\xad\x1d\x6b\xcf\x59\x73\x1f\xb5\xc2\xf8\x31\xf6\x64\x66\x7a\xe9\x1a\x88\x47\x56\xd1\xc9\x20\x4c\x7a\x28\x49\x54\x8a\x23\xfa\xed\x7c\xc7\x68\x90\xb8\xd6\x17\x47\x07\x93\x2c\x41\xa4\x03\x22\xbe\x65\x48\xe9\xfd\x81\x32\xec\x35\x77\x02\x01\x5c\x15\xdc\x52\x14\x59\x8e\xda\x95\x6b\x91\xb9\x69\x0b\x11\xcd\x73\x4c\x13\xf5\x44\x49\x1e\xc3\xdc\x76\x69\x44\x97\xc9\x81\xa0\x2c\x6b\x87\xa9\x4a\x66\x60\x50\x79\x71\x0f\x8c\xd8\x2e\x70\xf8\xc2\x11\xd9\x8e\x0c\x5a\x55\x90\x2d\x1a\x55\x52\xe5\x5d\x15\xb3\x9e\x91\x5c\x3d\x8a\x24\x7e\x33\xf9\x5e\xcc\x3c\xdb\x28\x38\x25\x99\xe1\xa1\x0a\x45\x25\x80\x2d\x9e\x24\xbe\xdd\x01\x29\xab\xa1\x5c\xb9\xdd\x04\x86\xd3\xc2\xb5\xa5\xc1\x8d\xfd\x91\x84\x32\x2b\xa4\xcd\x76\x95\xc7\x1d\xd1\x6c\xbf\xac\x73\xd7\xbd\x90\xa8\x48\xd5\x98\x38\xe8\x43\x63\x63\x23\xe2\x9d\x8d\xa8\x0e\xc1\xdc\x3e\x4e\x67\x30\xdf\x3f\xa2\x97\xd1\xa0\x6e\x6c\x55\x79\xb0\xad\x55\x65\x34\xe8\x18\xf3\x16\x5b\x9a\x13\x75\xec\x38\xee\x96\xc2\x36\x59\xff\x87\x60\x54\x3d\xb7\x3d\x94\x91\xa7\x24\x6c\x0e\x2e\x0f\x69\x63\x79\x48\x8e\x0e\x63\xf1\x28\x93\xf8\xb4\x8a\xbb\x06\x6d\xea\x81\xba\xf6\x79\x70\x71\x60\x8d\xa3\xad\x70\xc3\x39\x27\xcb\x6b\xba\x0f\xc3\x2a\x12\xb0\x08\xba\xed\x93\xaa\x72\x02\x7d\xed\x44\xe5\x86\x6f\x70\xaf\x86\x82\x7c\xb0\xde\x8a\x21\x04\x2b\xf4\x02\x6d\x23\x79\xf2\x10\xc3\xae\x71\xbb\x2e\xe3\x3e\xaa\xc8\x79\x8c\x14\x69\xbf\x52\x19\x52\x72\xfb\xf4\xac\x7e\x57\x39\x3d\xc4\xd9\xef\x95\x75\x38\x27\x47\x6d\x8f\xa1\xc9\x75\x8f\x03\x6b\xdc\x10\x16\x5a\xcc\xba\xf8\x02\x99\x5c\xa2\x43\x98\xe4\x12\xab\x19\x26\xff\x20\x63\xdf\xf1\x60\x82\xa1\x7f\x1e\x93\x0b\x31\x48\x6e\x44\x1e\x0f\x06\x4f\xe0\xfd\xa1\x45\x21\x88\x44\xa0\x6b\x30\x2e\x3f\x94\x58\x90\x31\xce\x84\x5d\xaf\x86\xa0\x66\x9f\x6b\x9a\x3e\x6e\xbc\x46\xab\x43\x93\x76\x70\x0f\x74\x68\xdb\x1d\xc6\x23\xe1\x5a\x87\x7b\x46\x78\x9a\xed\xc1\xa4\x14\x0b\xfc\x5f\x1d\x2b\xe3\x9e\xfe\xef\x3d\x83\xe2\x73\xde\x0d\x9f\x73\xd7\x21\x5d\x87\xc2\x15\xbd\x82\xba\x69\x9c\xad\x65\x9e\x85\x73\x08\xa1\x39\x2b\x49\x9a\xaa\x75\x61\x79\xa1\x49\x97\x74\xe0\xc6\xb0\x2f\xb9\x5a\x1b\x0b\x73\xa9\x8d\x05\x5c\x95\x76\x5b\x43\x94\x96\xce\xa9\x94\x54\x5a\x9b\x6f\x83\xd4\xd1\x96\x68\x7b\x37\x3e\x1a\x24\xdc\xb1\xda\x23\x63\x61\xa7\xb3\x34\x9c\x83\x66\x44\xbc\xf7\xe0\xb7\x58\x4c\x48\x59\x90\x8d\x62\x84\x4a\xe1\xe2\x4e\xb6\x0a\xd9\x59\x05\xbb\x29\xeb\x1e\xc6\x15\xf5\x99\xc0\xc7\x4f\x17\x0f\x46\x32\x4d\x89\xe2\x88\xe1\x2b\x35\xfb\x1c\x9c\xfb\xe6\xab\x4a\x85\x7b\x1c\xfd\xc6\xb0\x49\xb9\x36\xcb\xb8\x29\x50\x35\xef\x28\xe4\x6c\xb4\xf4\x21\xf6\x64\x02\x27\x3d\x96\xc2\x7f\xf7\xdc\x75\xd3\xe3\x2a\x90\x0f\x6e\xbb\xb1\xca\x54\x37\xde\x13\x49\x48\x47\x99\xf5\xcd\xa4\x35\xed\x01\xc9\x62\xc8\x1b\x03\x76\x08\x5c\x23\xd0\x1c\x53\xce\x7d\x93\xe6\x43\x9f\x18\x97\x14\x29\x92\x59\x0c\x95\x12\xc7\x83\x8b\x4e\x1b\x4a\x05\x68\xda\xef\x61\xf8\xae\x44\xc5\xd4\x3a\x48\x3f\x99\xbc\x49\x28\x6f\x15\x1f\x37\x2a\x58\xc2\xa6\x34\x05\xca\x0b\xad\xd6\x45\x36\xe2\x97\xc7\x43\xf0\x30\x1c\xa6\x7b\x03\xce\xd7\x79\xce\xe5\x57\xf5\x36\x20\x91\xf4\x23\x37\xff\x94\x54\xaf\x9b\xfd\xda\xd4\x0f\x4d\x0d\xea\x1b\x99\x76\x38\x0d\x50\x41\x80\x17\x13\x88\xe0\x23\x55\xe7\x1f\xee\x4d\x95\xda\x9f\x7c\xd9\x7f\x60\xdb\x43\x03\xbb\xd2\x94\x07\xc6\x8d\x7d\xab\x27\xc2\x0e\x35\x38\xef\x84\xcb\x73\x3e\x34\x4a\x68\xcf\x35\x67\xee\x28\xc2\x43\x50\x5f\x40\xb4\x32\x87\xf1\xaa\xd9\xcd\x65\x35\xb4\x4b\x8e\xb7\x36\xae\x06\x1e\x5c\xdc\xdb\x58\x58\xab\xe3\x88\x8b\x69\xa3\x61\x1f\x2e\xc1\x04\x37\xa0\x58\x59\x3a\x23\xdd\x3f\x08\xbd\xa6\xc0\x80\x97\x2e\x5a\xc2\xa2\xaa\xe4\x8c\x6b\x0e\xa2\x17\x0c\x9a\xaa\x04\x1a\xfd\x7a\xc2\x7f\x02\xd4\x5e\x63\xea\xa9\x57\xda\xda\x2e\x2b\x68\xd9\x59\xa7\x23\xbe\x1d\x69\x58\xea\x2b\x4e\xb2\xb3\x24\x34\xaa\x0e\x74\xb5\x7f\x7c\x71\x09\xff\x3e\xd0\xc2\x58\x91\x5e\x1f\xea\xee\x6a\x97\xe2\x3b\x5e\x78\x70\x15\xff\xf7\xc1\x10\xb8\x4e\xf5\xfc\x64\xc8\xcb\xce\xc9\x10\x7c\xfd\xed\x49\xe3\xc4\x41\xf3\x27\x61\x2b\x50\x39\x40\x10\x67\x43\x90\x7e\x81\xa6\xe8\xa6\x65\x82\xb8\xe6\xa0\xb6\x3a\xad\xd3\x1f\xcd\x9f\x64\xa5\xd6\x06\xd5\xda\x3e\x16\x2e\x2f\x7f\x8f\x01\xdc\x3e\x36\xd2\x85\xda\xdb\x07\x0e\x1d\xd1\xe0\x5e\xc9\x5a\x57\xbb\x83\xdd\x9f\xf1\xd8\x1d\xe9\xa1\xa3\x87\x09\x25\x45\x8b\x85\x9c\x6f\xbd\xd3\xe0\x73\x50\x43\xb6\xda\x43\x78\xd9\x36\x6a\xf5\x7f\x95\x2f\xb4\x27\x44\xce\xee\xfb\x77\x24\x38\x6e\x15\x60\xb1\x29\x63\xaf\x34\xc7\x5c\x8e\x7a\x3c\x84\x63\x5e\x22\xcb\xda\x58\x93\xdc\xaa\xf9\xdc\xa0\x8d\x3f\x8e\x4e\x4f\x86\xc0\x82\xde\x00\x67\x6e\x16\x0e\x9c\x0f\x4a\x7a\x16\x71\x51\x96\xb4\x83\x11\x99\x9b\x45\x14\xb4\x94\xa5\x31\x1a\xc2\x41\xa9\x24\x8f\x6b\xbd\x6a\xda\xa9\x41\x42\xdb\xe9\x31\xb3\xaf\xb7\x07\x57\x7b\xc5\x11\xf1\x7a\x9e\xab\x4d\x34\x84\xc8\x77\xaf\x62\xac\xe6\x8f\x03\x67\x65\xd9\x9e\x90\x77\x8c\x1b\xeb\x20\x39\x69\x83\x9a\xed\x72\x0e\xfc\xc8\xe7\x3e\xe1\x12\x4e\xbf\x26\x21\xf6\x4e\x16\xbd\xba\x68\x98\xb5\xc6\xe3\xc4\xac\x67\xc6\x6a\xda\xb7\x26\x3f\xff\x05\x44\x49\x92\x54\xd6\xb0\xaa\x7a\x20\x2c\x9e\xb3\xad\x32\x30\xe9\xf1\x8b\x1c\xac\xf0\xcd\x15\xd1\x46\xf5\x24\x28\xdf\x2c\xae\x5d\x2d\x23\x6d\x94\x71\x7e\xa4\xea\xeb\x0b\x0c\xe8\x48\x5e\x7a\x3d\xa2\x53\xa7\x49\xcb\x2f\xfa\x6c\x78\x37\xa3\x38\x6e\xee\xf1\x23\xae\xc8\xd3\xe3\x1d\x57\x01\x1b\x0a\xcf\xa9\xfe\xa3\xa4\x53\xca\x6e\xab\x16\x85\x91\xb5\x2f\xe7\x77\x49\xe8\x43\x73\x3f\x97\x0e\xd2\xb3\x94\x54\x6e\x24\x05\x2b\x1e\x23\x4a\x6e\x16\x95\x83\x49\xb1\x62\x78\x03\xb1\x5d\x36\x0a\x04\xde\xff\xfc\x1f\x5c\x83\x3a\x70\x81\x0c\xed\x0f\x70\xe9\x5a\xe8\xfa\xf6\x2a\x54\x1b\xd0\xa6\xb8\x81\x5c\x52\x9d\x73\xa7\x56\x2c\x1a\xf4\xe1\x4a\x27\x13\x73\x61\x6c\x28\x4e\x63\x6f\xd2\x6d\xa9\x13\x64\xb6\xf5\xce\x95\xa4\xcc\x71\x43\x36\x0f\x3b\xb1\xb0\x98\xfa\x13\xb0\xc4\x87\xfd\x32\xc3\xc0\x70\x07\x7b\xd2\x2c\x55\x0b\x01\x13\xd1\xa2\xd2\x54\x99\x35\x6a\xf0\x5c\xd7\xdc\xfb\x34\x5e\x66\xfc\x6a\x57\xc9\x03\x34\xb4\x93\x01\x56\xcf\x01\x38\x6a\x77\x76\xf4\x06\x75\x33\x72\xef\x1a\xba\xfb\x4c\x34\x8d\xd7\xc0\x09\x60\x77\x60\x8c\xb5\xed\x0c\x71\xbf\x85\x76\x70\x7b\xa0\xed\xe5\x19\xba\xd8\x1e\x30\xc6\x3d\x3e\x41\xc7\x32\xef\x06\xbd\x74\x63\xca\x3e\x9a\x70\x8f\x20\xd6\xaf\x4a\x22\x12\x38\xbf\xcd\xee\x30\x4f\xe8\x4c\xa6\xfe\xc3\x87\xef\xbe\x1d\x0c\xea\xe9\x35\x52\x29\x74\xc0\x96\x52\xfb\x3e\x24\xa5\xfc\x01\xc4\x5c\x63\xc9\x2b\xbd\xb3\x16\x03\x7f\xe8\x77\x83\xa0\x4a\x97\x47\x6a\xc2\xf2\x7d\x39\xf9\x40\x76\x27\xf8\x2f\x24\x35\xac\xb0\xa2\x58\xe4\x55\xe0\xe5\xe3\x04\x32\xf2\xad\xf5\xa3\x2d\xf4\xe4\x57\xd1\x4a\x20\xf8\x63\xcd\xa8\xe7\xf1\x47\x6a\x36\x74\xd7\x3c\x7c\xf2\xd9\xa7\x1a\xf9\x26\x0d\xb1\x61\x9c\xfb\x33\x04\xfb\x62\x51\xe7\x70\xe9\xa7\xa3\x88\xbf\xca\x58\xd5\x60\x9c\xcf\x59\x2c\x73\xa6\x7b\xd8\xce\x32\x81\xa0\xc1\x9d\x66\xa7\x3b\x18\x40\xca\xdc\xf1\xfe\x8b\x73\xfc\x93\xa3\xbf\xc7\xd1\xbf\x97\x15\xec\x27\x20\x5d\xd4\xe0\x61\x44\x3e\xe7\x7d\xd1\x0b\x81\xb9\xf3\xa8\x5e\xbb\x07\x70\xee\x0b\x7c\x1e\x87\xa9\xbf\xde\xe3\x49\x78\x1e\xea\xb3\xeb\x44\xe7\xde\x73\x97\x73\x72\xd7\x04\xe5\xef\xc8\x4f\x83\x7f\xfb\xb7\xfd\xe2\xf0\x1a\xf5\x4e\xaa\xa5\x05\x89\x16\x5b\x5a\x64\xe9\x74\x1b\x72\x02\x83\x97\x23\xa3\xb4\xad\x6a\xc8\xe9\x09\xd5\x05\xc1\xa4\x02\x49\x01\xd0\x39\x1c\x1f\x0f\xdb\x45\x23\xb2\x58\xfc\xa0\x33\xd4\x9d\x02\x23\x77\x2c\x31\xbc\x09\xa2\x4b\x30\xba\x5e\xce\x52\x1a\xce\x64\xf1\xb6\x36\x7d\x68\x33\xa0\x7e\xef\xde\x36\x89\xcb\xef\x3a\x78\xec\x6f\x7b\x56\xee\xd1\x69\xfd\xcc\x93\xe2\x1e\x20\x5f\xf5\x3d\xbf\xd8\x47\xbd\xd3\xa2\x8d\xbc\x1f\x78\x74\x7a\x6f\xdc\xd6\x87\x5e\xf3\xdf\x70\xca\x9e\x18\x47\x3c\x99\xf9\xb3\x6a\xb2\x58\xfc\x85\x18\xdd\xc9\xb2\x31\xe5\x5b\x67\xdf\x1a\x0b\x2d\x31\x97\x38\x1d\xa6\x19\x18\x9d\x34\x18\x16\x1f\xf3\x51\x38\x86\x5d\x7b\xe9\x24\x7d\x09\x75\xad\xfd\x0b\x31\x84\x59\x73\xc2\xe3\x31\x6d\x82\x53\xc9\x87\x54\x45\x9b\x54\xdb\x12\xd5\x1c\x04\xc7\x91\x86\x59\x7d\xec\xd2\x69\x5c\xdf\xe0\x5f\xcf\x7a\x5e\x0f\xfa\x88\x48\xd4\xf7\xb0\x82\x87\x3c\xa1\x6c\x15\xc1\x9a\xf5\x3c\x6f\x01\xa9\xa0\x78\x7a\xf6\x41\xfd\x78\xf2\x29\x69\xd1\x18\x2e\x61\x76\xe0\xd5\xa0\x8f\x99\x35\x8d\x7f\xd3\xc7\xfe\x7b\x87\x9a\x7e\xe1\x50\x7b\xa3\xf4\x34\x3e\xe9\x11\xb2\xc1\x23\x8d\x86\x97\x3d\x27\xed\xf7\x4a\x9e\x3f\x21\xf9\x64\xb9\xc3\x22\xfb\x57\x97\xba\x06\x75\xdb\x32\xd7\x78\x31\xe8\xe3\xec\xd3\x24\xae\x39\xcc\xf4\x8b\x86\xd9\x1b\xe1\xd7\x91\xb6\x70\xe4\xf5\x90\xa8\x85\xc3\xb3\x4f\x96\xb5\x00\xf8\x5f\x58\xd6\x02\x09\xda\x82\x16\x9e\x0e\xfa\x38\xfa\x34\x29\xab\x06\x98\x3e\x7d\x80\x3d\xd8\xbf\x8e\x7c\xb1\xfb\x08\x22\x2f\x97\x62\x86\xec\x38\xe6\xdb\xca\x0d\xaa\xc5\x2c\xe4\xf4\x2b\xc9\x18\x3c\x4d\xda\x78\x98\x7f\xb4\xa8\x31\x50\x27\x4b\x2e\xa9\xd7\x16\xb5\xfd\xd7\x4f\x91\x12\xee\x9d\x58\xf5\x2d\xd5\x7a\xbf\x12\x06\xe3\x01\xcb\x49\xcf\xf3\x2f\x97\x94\xbe\x41\xa6\x5f\x32\xc8\x1e\xfc\x7f\xb0\xb4\xd0\x56\x3c\xad\x7f\x78\x83\x96\x12\x53\xbe\x12\xca\xef\xcc\x47\xcf\xf6\xae\x1b\x08\x17\x03\xf5\xb8\x63\x83\x8b\x6e\xb7\x70\xa3\xc0\x7e\x27\xff\x66\xbf\x4b\x75\x69\xc0\x7e\x9f\xf0\x6a\xbf\x13\x4b\x71\xcf\x28\xf5\xa6\xc4\xde\x65\x3d\xfe\xfa\x41\xda\x34\x85\x0f\x94\xca\xe3\xeb\x04\xef\xb9\x22\x20\xdc\xc8\x00\x77\xcd\x53\xba\x23\x4a\xe2\xc3\x29\xae\x5a\x67\x77\xc3\x9d\x1a\xe1\x05\xb1\xe5\x59\xa9\xd5\x5c\xe6\xf8\xb3\xc4\xcd\x10\x9e\xdd\xa0\x9e\x29\xc3\x71\xb3\x7f\x32\xa7\xab\x5f\xe9\xb3\x1f\x61\xef\xb0\x31\x41\x49\xe6\xf2\x16\xb3\x91\x25\x8c\x47\xd5\x29\x58\xdf\x63\xa6\x48\x2e\x3b\x1d\xb8\x29\xd8\x25\xdc\xed\x9f\x1a\x76\xc5\x46\xdd\xa6\xe1\xf8\x36\xc0\x46\xe9\x6c\x34\xd3\x28\xae\xcf\x81\xff\x19\x89\x3c\xdf\x3b\x20\x4c\x84\xfc\xe3\xda\x58\x39\xa7\x2b\xcb\xb4\xc8\xa4\x1a\x79\x39\xe2\x30\xcc\x6c\xa4\xaf\x19\x9d\xa1\xdd\x20\x16\x75\x61\xbd\xa7\x09\x10\x71\xdd\x85\x8e\x7d\x97\x60\xf0\x35\x0f\xb4\x5d\x59\xd6\x9f\x46\x9f\xab\x11\xeb\x67\xb7\x26\x82\xd6\xa9\x51\x8f\x46\xc4\xd7\x52\x30\x66\xca\x1f\x5a\xbe\x64\x55\xec\xde\x25\x51\x6a\xb9\x12\x7a\x0b\x54\xb5\x78\xe3\x2e\xdf\x00\x68\xdd\x56\xc2\x40\x22\x0e\xd9\x1c\x82\x51\xb8\xa1\x22\xb0\x32\x22\xb7\x6d\x8d\x93\x88\x1e\x00\x3f\x99\x56\x1f\x2f\xc7\x0c\x8c\x00\x5f\x8e\x19\x85\x07\x91\x79\x1a\x16\x3f\xb7\xe5\xaa\x42\xc6\x3f\x87\x06\x52\x7b\x8f\x7e\x75\xe4\xde\xd5\x2a\x50\x21\xe6\x9f\x79\x9c\x9a\xdf\x7e\x75\x74\xde\x04\x8d\xab\x90\xe1\x27\xe0\xee\x5f\x9e\x36\xbe\xf4\xa1\x12\xee\x15\x21\x43\x72\x04\x7f\x14\x37\xe2\xbd\x3b\x29\x9f\x52\x3d\x12\xa5\xfc\xa9\xb4\x88\xa4\x9c\x12\x1a\x75\x69\xc5\xb8\x23\xf5\x59\xfb\xf0\x8e\x4c\x97\x47\xc0\xfc\xf5\xc6\x98\xb2\x8b\x2e\xd7\x82\xd9\x11\xab\xc8\x83\x27\xf2\x29\x95\x5e\x29\x0f\x63\x7e\xce\x10\xc9\x44\x72\x95\x49\xdc\xbf\xde\x4b\x3a\x78\x17\x12\x42\xbc\xbb\x15\xc9\xac\x75\x62\x81\x5a\x4c\xa0\x25\xee\xcd\x05\x8c\x36\x79\xb3\xea\x45\x42\x13\x0f\x8b\x4e\xcf\x0a\xd6\x69\xdd\xde\xe3\xdd\x1d\xf5\x8d\xda\x15\xef\xee\xe0\x1d\xb3\xfa\x38\x1c\xf6\x3b\x3d\x06\x15\x2f\xaa\xbd\x68\x78\x0e\x3f\x1e\x85\x76\x87\xc7\x0c\x5f\x0b\x6f\x77\xf0\x6a\x21\xd9\x1b\x1a\x20\xd3\x62\xc3\x3d\x1f\xc0\xa7\x09\xa3\x8b\x0d\xad\xa6\xed\x8b\xef\x68\x05\xa0\xfd\x1f\xb9\xa2\x5d\x2d\x3a\x34\x4f\x6c\x65\xf9\x86\x5c\x6c\xd5\xda\x3a\xdb\xbe\xce\xd9\x4c\x55\x3c\x0f\x5a\xec\x2f\xdd\x23\xe5\xa5\xbb\xa7\x9a\x4f\x9d\xb2\x52\x1e\xb7\xbe\x49\x8f\x8e\x52\xd6\x17\xb1\x7b\x9d\x6f\xde\x6d\x4c\x87\x8f\xc2\x05\xc4\x74\x99\x0a\xed\x6e\x51\x69\xc3\x24\x6a\xdc\xc6\x47\x3d\xab\xaf\xae\x07\x2d\x6a\xd4\x3a\x40\x24\xc2\x3c\x0d\x4e\x85\xd5\x1e\xa8\xea\x4a\xe4\xbb\x3b\xaa\xfc\xa9\x6e\x0b\xa6\xb9\xbc\x77\x55\x2d\xf7\x5d\xc6\xec\x2f\x39\x0a\xe3\xfb\x3a\x18\x9e\xc1\x6e\xd7\xb9\x78\x79\x8f\x1e\x44\xa8\xe4\x9b\xa2\x50\xee\xd0\xb2\x09\x73\x72\xbe\x41\x20\x37\x7f\xa9\x3c\x8b\x0c\x0b\x3a\x33\xe5\xbe\x93\x1b\x5e\x62\xe6\x49\x4d\xf3\xd1\x64\x46\xc0\xef\x94\x34\x40\x1f\x1a\x72\xe0\xc7\xac\x51\x7b\x1b\x84\xa5\xf1\x06\xe0\xd2\xea\xe9\xa5\x5d\xd2\xbc\xfe\x13\xb7\x34\x35\xbb\x9c\x5e\xda\x6c\x7a\x77\x67\xac\x86\x84\xaf\x5e\xe6\xc7\xd9\xf4\x72\x6c\x75\xc0\xa8\x4d\xe3\xf6\xb7\xcb\x31\xcf\xa2\xcb\x0a\x77\x99\x97\xbb\xb7\xad\x96\x61\x6f\x0c\xee\x97\xe0\xae\xc5\xf8\x2f\x41\xfe\x97\x14\xe4\x2f\x15\xd6\x2f\x16\x4e\xbf\x4c\xec\xcb\x65\xb8\x6d\xb0\xb9\x8e\x4c\x8f\x2a\xca\xb4\xaf\x52\xa1\x47\xde\x51\x5e\xeb\x9c\x65\xc0\x2f\x66\xfe\x96\xff\xfb\x08\xe9\x3b\xba\x2b\x86\x26\xd1\xcb\xdf\xfd\xce\x13\xf3\xd2\xd2\xb5\x9a\x61\x8a\x97\x4d\xd5\xbc\xa4\x0b\x31\x08\x05\x8a\x6b\x09\x1c\xe9\xc4\x3a\xe0\xc0\x67\xa3\x27\x11\x49\x6e\x34\xa5\xdf\x4c\xc6\xa7\x75\xe6\xd8\x74\x4a\xbf\x21\x5e\x99\xc1\x17\x42\x08\x75\xd7\x1e\xd2\x8b\xfa\x84\xd0\xdf\x03\x74\xbd\x8a\xa6\xaf\xd6\xab\x75\x2e\x28\xa8\x80\xbf\x1b\xc9\xb0\x11\xe8\xef\x5d\xf4\x8a\x44\xaf\x0e\xec\x74\x66\x6b\x4a\xa2\x38\xdf\xb2\x3e\xe5\x97\xa1\x45\xbd\xe2\xb3\xc2\xf4\x0c\x8b\x6c\x64\xd5\x88\x4e\x63\xd3\x9d\x6f\x45\xba\x8d\xa6\xaf\x02\x1c\xde\xee\x74\x34\xa0\x31\xa7\x4d\xd4\x6b\xc1\xbe\x1c\x37\x44\xe0\xd2\xd2\x2d\x48\x55\x23\x32\xaf\xaf\x6f\x05\x9d\xda\xe2\x69\x86\x5b\x54\x28\x40\xa4\xad\x47\x89\x9b\x50\x84\xc2\xd4\x84\x9f\xde\xf6\x4b\x52\x36\x1d\xdb\x55\xf9\xef\x73\xa5\x26\x44\x1f\xd6\xad\xd6\xeb\xd3\x93\xdf\x9e\xec\x3f\x3d\x3b\x39\xe9\x79\xfa\xb2\xfb\xb8\xa9\xa5\xa3\x51\x35\xad\x30\x95\x4a\x59\x9b\xce\x3f\x6b\x26\x3b\x4c\x74\x6c\xa0\x5c\x1e\xd6\xce\xda\xab\xaa\xee\xe5\xf5\xaa\xc6\x17\x5e\xba\xbb\xcf\x82\x3a\xb5\xe2\x9c\x94\x6e\xaf\x98\xa9\xdb\xd0\x26\x98\xcf\x2b\x2d\x36\x3d\xc7\x2c\xa4\xf6\x57\x56\x18\x0f\xad\x13\x13\x05\x70\xce\xbb\x92\xa9\x4c\x73\x8c\xa6\xf0\x96\x3f\xf8\x49\x57\x11\x57\xfb\xef\x81\xbc\x51\x79\x86\x59\xdb\x50\x84\x28\x8c\xae\xfa\xe6\x10\xfd\xd6\x54\x18\xda\x0e\x71\xa4\x13\xd1\x39\x83\xa1\x0d\xbf\xf4\x9a\xcf\xa2\xad\xe8\x0c\xe4\xbc\xd5\x94\xee\x79\xa2\x7a\x78\x5a\x65\x38\xcb\x0a\xb2\x80\x95\x4c\xb5\x32\x48\x1e\x8a\xa9\xfe\xa2\x49\x0b\x5a\xf5\xe7\x34\x1e\xfc\x4b\x32\x8d\x69\x7d\x87\x7a\x81\x19\xbb\xc6\x5f\x3e\x37\x5f\x41\xaf\x31\xa5\x32\x31\xff\x67\x2a\xd8\xdb\xa3\x96\x86\x1a\x52\x18\x3a\x84\x15\x8f\x46\x3b\x4b\x7c\x57\x43\x34\x75\xc3\x77\x81\x55\xab\x31\x59\xc5\xf0\x87\x86\x9a\xf3\xab\x57\x8b\xee\x5d\xcf\x0c\xa9\x7b\xc7\xb3\x8f\x55\xbb\xf2\x4a\xb7\x06\x68\xb1\xa1\xe8\x13\x0b\x90\x5c\xb3\x45\xa1\x5b\x31\xa4\x33\xa5\xd2\x1a\x7f\xf7\x9a\xa4\xdb\xd2\xae\x0b\xba\xb2\x76\xb3\xa4\x65\x48\xda\x23\x6a\x1b\x6e\x82\x78\x5c\x70\x4a\x09\x68\x1e\xfd\xaa\xba\x21\x8e\xc9\xbe\xe3\x22\xbe\x10\x98\x36\x23\x15\x1f\x9b\xcc\xab\xbf\x8d\x14\x02\x94\x68\x58\x43\x72\x15\xc6\x5e\x94\x07\x89\x34\x71\x74\xce\x62\x8e\x59\xe4\xaa\xbe\x88\x50\xad\x36\x5c\x8c\xc2\xf1\x70\x34\xac\xc7\xdb\x8f\x6d\x8e\x5c\xad\x0f\xe7\x23\x7d\x9c\x2e\x42\x10\x3f\x62\x65\x67\xab\x00\x5a\x6d\xf8\xf4\x06\x5d\x99\x42\x64\xb4\x0a\x34\x66\x92\xea\x77\x60\xcd\x97\x7f\x70\x31\x5e\xa9\x55\xe9\x4e\x13\xd2\xcd\x7b\x05\xd0\xb9\x93\xc7\x12\xaf\x13\x2b\xfa\x9c\x9d\x9b\xcb\x31\x23\x38\xd2\x6a\x93\xcc\x8c\x7b\x71\x5c\xd7\xd7\x00\x15\xd2\x30\x86\xcf\x7d\x6d\x60\xa0\x2b\x9f\x45\x68\xd7\x7c\x51\x85\x8c\x2f\x5e\xa0\x59\x25\x3f\xfd\xf8\x6d\x68\x7d\xb0\x40\xcc\xb7\x0b\x29\xe6\xbd\x28\xf1\xee\x0e\x8b\x6c\xb7\x3b\xfa\xbf\x03\x00\x5f\x2d\xea\x3d\x79\x6b\x00\x00"),
+			uncompressedSize:  27513,
 		},
 		"/traces.html": &_vfsgen_compressedFileInfo{
 			name:              "traces.html",
-			modTime:           mustUnmarshalTextTime("2015-10-04T03:50:12Z"),
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x58\x5b\x73\xdb\xb8\x15\x7e\xcf\xaf\x40\xb0\x99\x86\x9a\x58\x54\xbb\x33\x7d\x71\x24\x77\xbc\x9b\x6d\xc7\x6d\x37\xc9\xac\x9d\x74\xa6\x9d\x3e\x40\x24\x24\xc1\x81\x08\x16\x00\x65\xab\x5a\xfd\xf7\x7e\x07\xe0\x5d\x72\xe2\xd9\x3e\xc4\x11\x41\xe0\x5c\xbe\xf3\x9d\x0b\x78\x38\xe4\x72\xa5\x0a\xc9\xf8\x9d\xf2\x5a\xf2\xe3\xf1\xce\x8a\x4c\x3a\x36\x65\xa2\x2c\x73\xe1\x36\x87\x83\x2c\xf2\xe3\xf1\xc5\x8b\x6e\xeb\xcf\x42\x15\x9c\x96\xe6\x2f\xa7\x53\x76\xeb\xf7\x5a\x15\x6b\xb6\x32\x96\xf9\x8d\x64\x6a\x5b\x1a\xeb\xa7\xf7\xce\x14\x6c\x59\x79\x8f\xff\x7e\xc7\xb6\xb2\xa8\xd8\x74\x7a\xf5\x62\xee\xb0\x5d\x5e\xbd\x60\xec\x3b\x6f\xca\xa9\x55\xeb\x8d\x9f\x2e\x7d\xe1\xd8\x01\x6b\x8c\x6d\x85\x5d\xab\x62\x8a\x77\x97\xec\xfb\x3f\x96\x8f\x6f\xb1\x7a\xc4\xbf\xd9\x8c\x7d\x58\xad\x9c\xf4\xad\x9e\x6c\x23\xb3\x2f\x4b\xf3\xc8\x96\x32\x13\x95\x83\x62\xff\xda\xb1\xc2\x78\x26\x32\x5f\x09\xad\xf7\x6c\x27\xad\x57\x59\xf8\x29\xb4\x5a\x17\x32\x67\x0f\xca\x6f\xa2\x38\x92\xe1\xe5\xa3\x4f\xf1\x98\x7a\xf2\x7a\xda\x8a\x8c\xb6\x60\xd3\xdd\x46\x39\x96\x1b\xe9\x8a\xd7\xd0\xac\x1e\xa3\x87\xce\x55\xf2\xb2\xde\xd2\xe8\x98\x06\x0d\x97\x6c\xab\xf2\x5c\xcb\xb7\xe1\x6d\x69\x9c\xf2\xca\x60\xd5\x4a\x2d\xbc\xda\xd5\xeb\xd1\xbb\xc6\xb9\xf9\xac\xc6\x24\xe2\x79\x07\x58\x7e\x21\x58\xd8\xcf\x2d\x68\xb9\xda\xb1\x4c\x0b\xe7\x16\x1c\x58\x4d\xd7\xd6\x54\x25\x2b\x2b\xad\x23\x80\x9c\x59\xa3\xe5\x82\x87\x75\xce\x84\x55\x62\xaa\xc5\x52\xea\x05\x4f\xd3\x94\x33\x95\x2f\xf8\x10\x6d\x4e\x11\x08\xea\x6e\x42\xb8\xd8\x5f\x6f\x3f\xbc\x6f\xc2\x45\x2a\xf1\xb6\x7e\xea\xf4\x32\xd2\x0d\x12\x88\x4a\x43\xa5\xdf\x97\x50\x19\x37\x45\x15\xbd\xc8\xf3\xe0\x67\x2e\xbc\x40\x28\xd7\x6b\x32\x2e\x33\x5a\x8b\xd2\x49\x5e\x2f\x23\xd0\xd2\x2f\xf8\x77\xbd\x53\x53\xa2\x49\x3c\xea\x89\x8e\x8d\xc8\x68\x9d\x8f\xcc\xcc\x95\x95\x99\x47\x48\x55\xe1\x0d\xbb\x8e\x2c\xe5\x57\x3d\x3f\xe6\xb3\x68\x15\x10\xad\x9d\xac\x49\x6d\x4a\x8a\x86\xeb\xd8\xd8\x79\x39\xf4\xe6\xbc\xcf\x2c\xb7\xa6\xcc\xcd\x43\x51\xfb\xc4\x87\x0e\x36\x6f\xeb\x00\xc8\xc7\x52\x14\xb9\x04\x2e\x2b\xa1\xc9\xed\xda\xa5\x9d\x92\x0f\xad\x25\x44\xe6\x2d\x44\xab\x52\x4b\xe6\xa4\x86\x67\x20\x69\xf4\x34\xc4\x88\x35\xb6\xcf\x1d\xc4\x35\x86\x65\xc2\x4a\xcf\xaf\xc0\x1c\x2c\x06\x37\x5a\x97\xf1\xbb\xd2\xcd\xbe\xd6\xe0\x00\x6c\xcd\x92\xf0\x3b\xca\x9e\x6b\x75\x35\x17\x6c\x63\xe5\x0a\x91\x68\x88\x42\xee\x4c\xa3\x31\x8a\xd0\xa8\x0d\x8f\x2b\xb3\x5c\xc6\x1f\x48\x29\xdd\x5a\x7a\x17\x0e\xb1\xdb\xe6\xd0\x7c\x26\x60\x1d\xa4\xf7\xd5\x90\x74\xa0\x42\xd1\xf6\x26\xd2\xa4\x91\x9d\x99\x72\x1f\x72\x6b\x84\x01\x52\x25\x26\xbb\x56\xe5\xd2\x08\x9b\x33\xe1\x22\x1b\x08\x7a\x7e\xf5\x53\x10\x57\xeb\x95\xf9\x59\xb5\x03\xef\xc4\x7a\x6d\xe5\x5a\x78\x39\xa5\x38\x0c\x83\x42\x8a\xda\xf7\x79\xd0\xc0\xcc\xea\x9c\x59\xfc\xea\xba\xd9\xc7\x3e\xe3\x68\x5f\xef\x7c\x56\x69\xa4\xec\x0c\x39\xdb\xa4\x74\x29\xd6\x32\x6a\x8a\xe9\xbc\xf9\xc3\x55\x8c\xea\x7c\x86\x9f\x54\x5a\xbd\xdc\x96\x9a\xa4\xf1\xc8\xe3\xe8\x17\x08\xa6\x00\x34\xbf\x79\xc7\x19\x3f\xc9\x13\xc6\xaf\xeb\x00\xf1\x1e\xf9\x79\x53\xca\xdb\x55\xd1\x4b\x1f\xb6\xdc\xc3\x1a\xe7\xa9\x60\x2b\x8f\xd2\xa9\xcd\xc3\x65\x57\xcb\xef\x50\x0f\xaf\xad\x14\x2c\x29\xa0\xe5\xcf\x20\xd1\x66\xc2\x40\x5f\xbd\x14\xd9\x97\x40\xd6\x1f\x11\xa8\x37\x1f\x21\x41\x12\x34\xfd\xbc\x24\xcf\x9e\xe5\x48\x4d\x81\xbe\x23\x8d\xc5\x9f\x50\xc6\x33\x6f\xf5\x9b\x8c\x41\x57\x66\xb6\x5b\xa4\x10\x1e\xc0\x82\x96\x21\x7d\x9d\x7d\xfb\x3b\xd6\x6b\xe5\xfc\xb4\x2a\x42\x55\xcd\x03\xd3\x0f\x07\x2b\x0a\xc4\x20\x8d\xb0\x1f\x8f\x81\x1f\x87\x83\x5a\xb1\x84\xfa\x03\x7b\x95\x7e\x56\x4e\x2d\x11\xa0\x74\x52\xbf\x8d\xfc\xa9\x7f\xe2\x41\x15\x65\xe5\xeb\x3a\xd1\x34\x8a\xb6\x52\x0c\xfb\x07\x8f\xcd\x89\x72\x7f\x0f\xb2\xb4\x32\x42\xb9\x08\x7e\x87\xfd\x0b\x7e\x38\xa4\xb7\xde\x22\x18\xc7\x23\xef\xa9\x6a\x38\x7b\x38\x54\x56\xdf\x99\x60\x34\x4b\x6f\x91\xeb\xe9\xcd\xbb\xe8\x03\x1d\xa0\xd3\xc3\x35\xe2\xe1\x8b\x4e\x4e\x07\xc9\xa0\xa2\xb4\xde\x0d\xde\xc6\xfc\xa0\x82\x32\xed\x09\xa6\xff\x07\xc6\xb5\xc0\xa5\xef\xc5\x56\xb6\x58\xd5\x32\x9d\xb7\x06\xd4\xaa\xb3\x0a\x72\x6e\xde\xd5\x96\xc6\xdd\xd4\xeb\x68\xc7\x58\x9e\x44\x85\x7c\xbe\xac\xd6\xae\x27\xc5\xc5\x91\xe5\xd4\xe6\xe0\xd6\x75\x81\x29\x41\x84\x02\x3c\xd6\xe9\x05\x71\xa0\x81\x25\x3c\x84\xbf\xd3\xcc\xa0\x96\x17\x8e\x2a\x40\x78\x86\x5e\x55\xd6\xec\x1a\xaa\x89\x4c\x4b\x56\x4a\x7b\x69\x7b\xaa\x4e\x95\x4f\x46\xda\x3b\x33\x63\xee\x88\xc2\x9f\xd9\x41\x56\xda\xab\xb9\xdf\x10\x12\x7f\x93\x7b\x42\x01\x0f\x73\x9f\x63\x01\x66\xb1\xf4\xb3\xd0\x55\xc0\x1a\x4b\xf8\x63\xc7\x36\x76\x08\x7d\x7b\x15\xe7\xc9\xdf\xf3\x00\xf7\xb7\x0d\x72\x25\x94\xc0\xf1\x9b\xee\x54\xf3\x2b\xee\xc3\x4c\x98\x01\xcc\x26\xb7\x68\x26\x9b\xdd\x8b\x9d\x88\xab\x01\x61\x4c\x62\x3f\xa8\x22\x47\xa2\xb8\xb3\x73\x26\x95\x11\x9a\xe3\x92\x55\x55\x84\x9a\x98\x4c\xba\x19\xee\xa6\xc0\x10\x86\xe9\xec\xbf\x92\xea\x88\xd8\x19\x95\x33\xb7\x31\x0f\x54\x03\x71\x76\xa5\xac\xf3\x2c\x6d\xc6\x93\x84\x6f\x54\x2e\xf9\x84\x51\x5d\x48\x83\x8c\x57\x09\xff\xee\xa4\x68\x4d\xba\x13\x87\xd8\x32\x2f\x59\x68\xf4\xc7\xc9\xdb\xf6\xd4\x49\xcd\xfe\xea\xa9\xc6\xe0\x7f\x6c\x64\x11\x5c\x1c\x2b\xc5\xe4\x19\x2c\x2f\xd8\x83\x64\x0f\xa0\x07\x39\x44\xe6\xf6\x00\x61\x2d\x20\x8d\x38\x67\xa8\xd4\x7b\xf1\x05\x05\x53\x79\xc7\x50\x9f\x33\xf9\x55\xcf\x80\xdf\x6b\xd2\x93\x2e\x5d\x6b\xef\xeb\x0b\xd6\x80\xcb\x5a\x74\x9f\xe3\x67\x8d\x67\x04\xe5\x38\x69\xac\xba\x2e\x72\xb6\x53\x28\x9a\x18\xa0\x9d\x68\xa3\x6a\xf0\xc7\xd6\x83\xe8\xe5\x39\x1c\x49\xb4\x56\xd9\x97\xd3\x50\x3f\x27\x54\x23\x63\x3a\xcc\x3f\x95\x34\xea\x1a\x34\x2f\x19\x5c\xac\xfb\x7e\x8d\x29\xac\xdb\x5e\x10\xe8\x1f\x3f\xdc\xde\x8d\xba\x50\x9c\x13\x30\x8f\x7b\xd3\x08\xa3\x0d\x7c\x16\x6b\xee\xac\x2a\xb5\x11\x39\x67\x9f\x7e\xf9\x3b\x43\x37\xa3\xab\x00\x9e\xc3\x9e\x38\x17\x18\x34\x47\x87\xa0\xc4\xf6\x56\xd0\x20\x62\x07\x11\x1a\xa3\xcb\x52\x11\x1b\xff\x57\xa0\xa0\xbb\x8b\x55\x5b\xf6\xb0\x51\x5e\xa2\xa8\x67\x41\x11\x2a\x58\x65\x23\x5b\x70\x63\xb2\x61\x14\x40\x49\x73\x66\x2b\xfd\x86\xf2\x21\x29\x75\xe5\x2e\xea\x91\xc7\x22\x34\x9d\xb8\xe6\x16\x44\xb3\x27\x13\x4b\x83\x66\xd8\x09\x9f\xa4\xf5\xc6\x9d\xb0\x11\x90\xc5\x13\xa6\x53\x7a\x43\x82\x80\xf1\x3b\xa1\x93\x3a\x14\x8c\xa9\x55\xf2\x32\x1c\xfc\xf5\xd7\x20\x00\x57\x32\xb5\x4d\x26\xa9\x96\xc5\xda\x6f\xd8\x62\xc1\x7e\xdf\x0f\xb4\xd0\xb8\x77\x25\xfc\xa3\x96\x82\xae\x7e\xa1\x37\x0b\x68\xd7\x48\xee\x10\x9b\xd0\x11\x5f\xf2\x56\x3e\x03\xf0\xbe\xb2\x45\xf3\xdc\xb6\x87\x10\xfc\x36\x24\x01\xfa\x0b\xec\x5d\x59\xe9\x02\x24\x21\x48\xd5\x90\x1e\x8d\xb7\xaf\x52\x5c\xf1\x60\xc6\x28\xd6\x17\xc1\x83\x49\xab\x39\xcd\x4d\x21\x07\x51\x62\xda\x64\xa1\x09\xa4\x91\x0e\x58\x3b\xf6\xf6\xaf\x84\xd2\xdd\xfe\xc7\x8d\xbd\x08\x57\xd5\x5b\x34\x0e\x0a\x8f\xb4\xd6\xd8\xbb\x8d\x45\x25\xe8\x63\xd2\xa2\x12\xde\x5f\x32\xce\xde\x30\x9c\x85\x0a\x07\xf3\x9d\xa4\xe9\xae\x87\x47\xab\xf0\xd8\xe6\x43\x12\x33\xe2\x5c\xb9\xf5\xa7\x57\xa8\x27\x2b\x6e\x3b\x2d\x47\xc8\x1d\x78\x8f\x3b\x91\x05\xc3\xeb\xb4\x2a\x85\xa5\x56\x3a\x4e\x22\xd2\x25\x45\xb6\x69\x05\xb4\x09\xd5\x25\x04\x11\xac\x95\xbf\x60\x67\xa8\x4f\x3b\x6a\x6b\x17\xec\x5f\xff\x6e\x1c\x06\x1d\x47\xd7\x7c\x50\x90\xb4\x75\x2e\x28\x40\xdb\x07\x14\x9c\x7c\x95\x20\x31\xdc\x24\x2d\x71\x93\x4a\x78\x3d\xd6\xf1\xc9\x10\x76\xd2\x78\x1f\x18\x1f\x37\x0b\xef\x6d\xc2\x47\xd3\x5e\x9f\x8a\xac\x36\x30\x2d\x2b\xb7\x49\xc0\x22\xc2\x83\xd0\x48\xee\x27\xfd\x08\x8d\x02\xd4\x70\xb8\x3e\x5d\x47\xad\xad\x61\xa3\xcb\x50\x5d\x45\x3b\xd8\x62\x61\xbc\x33\x01\xf6\x45\xa8\x34\xff\x94\xd6\xfc\xd8\xdc\xad\x92\x5e\xf5\x6c\x2e\x68\x8d\x39\xfd\xb3\xd4\x1f\xc2\x8d\x8d\x77\x3d\x21\x91\xc3\x00\x20\x44\x50\xd1\x04\x6a\x90\xe6\x58\x7c\x2a\xab\xc7\x29\xda\x66\xe8\x7b\xe3\xd1\x29\xbf\xa7\x06\x18\xca\x32\x0d\x63\xa1\x4e\x6b\xb9\x93\xba\x49\xc7\x81\x91\x4e\x7a\x22\x7c\x12\x1f\xc2\x94\xad\x56\x7b\xd2\x7e\xc1\x8a\x4a\xe3\xef\xf7\x1d\xd6\x31\x71\x7a\x96\xbd\x41\xf6\xf4\x6f\x1a\x70\x57\xd1\xf0\x67\xba\xab\x68\xca\x27\x27\x6d\xe4\x03\x88\x5e\xec\x87\xb0\xc6\x74\x45\x69\x45\x41\x13\x56\xe9\x3d\xea\x26\x1a\x7c\xb8\x5d\x91\x43\xe1\x93\xd5\x0e\x19\x4f\x83\xd6\x04\x5d\xa6\x11\xd6\x5e\xbc\xa0\xb5\x72\x54\x8b\x42\x5d\xc6\x5c\x98\x93\xd8\xa6\x92\xa6\xe7\x03\x14\xb4\x3e\x11\xa1\xc1\x66\x5c\xe8\x31\x44\xef\x93\x49\x97\x28\x27\x2c\xf8\xbf\x7a\x2e\x8d\x12\xbc\x01\xe9\x5b\x04\xf9\x16\x45\xc6\x24\xe9\x68\x72\xde\x92\x93\x8e\xf3\x2c\x3e\x3c\x43\xd6\xca\x64\x95\x43\x83\x8a\x2e\x74\x0e\x1c\xcf\x4c\x17\xe3\xcf\x23\x27\xa9\x59\x17\x16\x20\xe2\x6d\x25\xbb\x01\xf2\xe4\x63\xcc\x49\x24\xfa\x51\x45\x95\x42\x3a\x14\xfe\x5d\xfc\x5e\xd5\xd9\xd4\x89\x7f\x59\xff\xfc\x6a\x55\x1c\x16\xbb\x8b\xe6\xf8\x19\xc7\x86\x9f\x41\x06\x6e\x91\xf9\xa3\xaf\x2d\xbf\xcd\xf8\xf3\x6c\xe9\x6a\xc3\xcd\x0a\x39\xf3\x7a\xd7\xfb\x48\x03\x39\x76\x1f\x06\x9a\x8b\x66\xde\x97\xa1\x9d\xd1\x88\x80\x57\xe8\xbe\xf8\x4d\x03\xd9\x7f\x2a\x3c\x76\xa2\x50\x87\x71\x4d\xf5\x34\x81\xee\xd9\x7d\x85\x7b\xc1\xda\xd0\x31\xd0\x45\x84\x4f\xb2\xc8\xc4\x59\xeb\x14\xcd\x3f\xd9\xe6\x82\xf6\xd6\x5f\x08\x2f\xc2\x78\xee\x3a\x81\xe3\xcf\x49\xd4\xe1\xba\xef\x66\xe9\x53\x45\xf1\x6c\x54\xe2\xeb\x7e\x2e\xe0\x0a\x93\x63\x4a\x6f\x67\x09\xfa\x6a\x00\x98\x70\x2f\xfc\x01\x53\x11\xfc\x6b\xbf\x2e\x50\x3d\x6b\x6d\xe1\xcf\x99\x89\x6e\x81\x58\xec\xd5\x56\x66\x32\x80\x17\x86\x5f\x2b\x81\x19\x80\xa1\x0f\xe9\xe1\xfd\xcd\x3b\x47\x93\x31\x4d\x85\xaa\x00\x74\x32\x8c\x94\xaa\xe8\x44\x51\xec\x63\x2c\xa2\xc8\x82\xfd\xe5\xa7\x38\x45\xf7\xb0\xa4\x31\xab\x3f\x44\xaa\x7c\xd4\xbe\x63\xaf\x0e\xe9\xda\x6f\xd8\x01\xa6\x41\xd3\xce\xeb\xb6\x1a\x87\x86\xe6\xe3\xc8\x49\x7e\xfe\x66\xf8\xfe\xd4\x66\xe3\x82\x26\x2c\xd2\x77\x0f\x96\x24\xbd\xf4\x68\x66\xa9\xf9\x2c\x5e\x62\xc3\x37\xbe\x78\xe7\xfd\x5f\x00\x00\x00\xff\xff\x29\x3a\x44\x0c\x72\x19\x00\x00"),
-			uncompressedSize:  6514,
+			modTime:           mustUnmarshalTextTime("2026-10-17T01:46:56.738982567Z"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\x6d\x73\x1b\x37\x92\xfe\xce\x5f\xd1\x46\x54\x09\x59\x16\x87\xb2\x72\xae\xba\x53\x48\xa6\xbc\xb6\xb3\xe7\xbb\xc4\x71\x59\x4a\xb6\xea\xb6\xf6\x03\x38\xd3\x14\x61\x83\xc0\x04\xc0\x90\xe2\x32\xfc\xef\x57\x8d\x97\x99\x21\x87\x94\x64\x3b\x8e\xaa\x9c\x21\x06\xd3\x78\xfa\x15\xdd\x0d\x6c\xb7\x05\xce\x85\x42\x60\x37\xc2\x49\x64\xbb\xdd\x8d\xe1\x39\x5a\x18\x02\x2f\xcb\x82\xdb\xc5\x76\x8b\xaa\xd8\xed\x7a\xbd\x66\xea\x2f\x5c\x28\x46\x43\xe3\x27\xc3\x21\x5c\xbb\x8d\x14\xea\x16\xe6\xda\x80\x5b\x20\x88\x65\xa9\x8d\x1b\x7e\xb0\x5a\xc1\xac\x72\x4e\x2b\xf8\x16\x96\xa8\x2a\x18\x0e\xa7\xbd\xb1\x75\x1b\x89\xd3\x1e\xc0\x37\x4e\x97\x43\x23\x6e\x17\x6e\x38\x73\xca\xc2\xb6\x07\x00\xb0\xe4\xe6\x56\xa8\xa1\xd3\xe5\x15\x5c\x3e\x2f\xef\x7e\xe8\x01\xec\x7a\x00\xa3\x11\xfc\x3a\x9f\x5b\x74\xf5\x3a\xf9\x02\xf3\x8f\x33\x7d\x07\x33\xcc\x79\x65\x11\x84\xfb\xce\x82\xd2\x0e\x78\xee\x2a\x2e\xe5\x06\x56\x68\x9c\xc8\xfd\x23\x97\xe2\x56\x61\x01\x6b\xe1\x16\x81\x1c\x61\x75\x78\xe7\xb2\x1e\x40\xe6\x88\xeb\x61\x4d\x32\x60\x19\x8d\xe0\x66\x21\x2c\x14\x1a\xad\xfa\xce\xc1\x5c\xdc\xf9\x95\x85\xb5\x15\x5e\xc5\x29\x69\x8d\xa1\x5f\xe1\x0a\x96\xa2\x28\x24\x12\x6c\x80\x52\x5b\xe1\x84\x56\x57\x60\x50\x72\x27\x56\x71\x3c\x70\x97\x98\x1b\x8f\xa2\x4c\x82\x3c\x6f\x74\x39\x7c\x4f\x62\x81\x5f\x6a\xa1\x15\x62\x05\xb9\xe4\xd6\x4e\xd8\xcc\xa9\xe1\xad\xd1\x55\x09\x65\x25\x65\x10\x20\x03\xa3\x25\x4e\x98\x1f\x67\xc0\x8d\xe0\x43\xc9\x67\x28\x27\x2c\xcb\x32\x06\xa2\x98\xb0\x7d\x69\x33\xd2\x80\x5f\xee\x8d\x57\x17\xfc\xcf\xf5\xaf\x6f\x93\xba\x68\x49\x80\x71\xfc\xd5\xac\x0b\xb4\x76\x81\x73\x5e\x49\xc7\xc0\x6d\x4a\x9c\xb0\x30\x29\x2c\xd1\xd2\x3c\xf3\x7c\x16\xdc\xf1\xa1\xd3\xb7\xb7\x04\x2e\xd7\x52\xf2\xd2\x22\x8b\xc3\xdc\xdc\xa2\x9b\xb0\x6f\x5a\x5f\x0d\xc9\x4c\xc2\xa7\x8e\xcc\x31\x91\x0c\xe8\xbc\x8e\x2c\x14\xc2\x60\xee\xe4\x06\x84\x72\x1a\x5e\x04\x2b\x65\xd3\x16\x1f\xe3\x51\x40\x35\xed\x25\x26\xa3\x51\xeb\x92\xb4\x61\x1b\x6b\x6c\xb8\xdc\xe7\xe6\x38\xcf\x50\x18\x5d\x16\x7a\xad\x22\x4f\x6c\x9f\xc1\xf4\x36\x2a\x00\xef\x4a\xae\x0a\x2c\x26\x6c\xce\x25\xb1\x1d\x59\x5a\x09\x5c\xd7\x48\xc8\x98\x97\x95\x74\xa2\x94\x08\x16\x25\xe6\x0e\x8b\xc8\xa9\xd7\x11\x24\xec\x63\x5b\xf2\x5a\x19\x39\x37\xe8\xd8\x74\x3c\xa2\x41\x9a\xd6\xb0\x0c\x30\xae\x64\x9a\x57\x03\x26\x8e\x93\x95\xf8\x67\x9a\x08\x30\x96\x62\x3a\xe6\xb0\x30\x38\x9f\xb0\x6f\x92\xa1\x10\x6f\xc3\x00\x46\x68\x55\x03\x0f\x23\xa3\x02\xc3\x03\x70\x29\x6b\xa4\x37\x5e\x06\x70\x9d\x3e\x1a\x8f\xf8\x74\x3c\x92\x62\x6f\x19\xa2\x8e\x77\xa4\xa6\xa1\xd3\x5e\xe1\x35\xed\x5c\x97\x1b\xef\x5b\x07\x32\x00\xa7\xfd\x70\x2e\x45\x39\xd3\xdc\x14\xc0\xad\xd7\xb1\x17\x3d\x9b\xbe\xf6\xe4\xe2\xba\x58\x1c\x5d\x76\x8f\x3b\x7e\x7b\x6b\xf0\x96\x3b\x1c\x92\x1e\xea\xf5\xe9\x87\x5f\xa8\x7e\x5f\xf8\x15\x40\xcf\x8f\xc1\x62\xd3\x17\x69\x1e\xfc\x2e\x70\xfd\xf0\xba\xb9\x5e\x96\xdc\x24\xb1\x62\xd1\xe2\xdc\xbf\xf0\xab\xb8\xb5\x3e\x5c\x09\xac\x28\x10\x66\x1b\xff\x7f\x36\x7d\x19\xc8\x9c\x60\x78\xbb\x15\x73\xc8\x5e\x72\xf5\x0a\x25\x3a\xdc\xed\x12\x9c\xda\x1e\xc4\x4a\x14\x68\xd8\x03\x60\x0b\xff\x79\x17\x6b\x18\x3f\x2e\x90\xb0\xe4\x27\x68\xa2\xac\xcc\x2d\xb2\xc7\xc5\x08\x3f\x37\x44\x87\x43\x2c\xc1\x4c\x66\x1b\x50\x7c\x89\x40\x7b\x83\x58\x22\x18\xae\x6e\x91\x4d\xdf\xd1\x77\xdf\x2e\x50\x4a\x51\xfe\x70\x28\xab\xb0\xaf\x91\xef\x54\x72\xda\x1b\x8f\x0a\xb1\x4a\x31\xb8\xe4\xb7\x18\x16\x0a\x9b\xd6\xe2\xd9\x34\xb8\xe1\x78\xb4\x78\x36\xa5\xbd\xd0\xe1\xb2\x94\xdc\x21\xb0\x10\x78\x82\x21\x32\x28\x44\xee\x80\xbd\x79\xc5\xa0\x1d\x0e\x23\x74\xf6\x22\x7a\x54\xfc\xc8\x5b\x32\x4b\x7b\x6f\x22\x05\xbc\x15\xef\x48\xf5\x25\xb7\x8e\x76\x58\xe1\x60\x86\x52\xaf\xaf\x9a\xcd\xf7\x06\xef\xdc\x0b\x83\x1c\xfa\x4a\xab\xe1\x4f\x92\xdb\xc5\x00\xe6\x5c\xca\x19\xcf\x3f\xfa\xad\xf2\xa5\x2e\x37\x4f\xdf\x71\xeb\x90\x6c\xb9\x1d\x48\x89\xb3\x47\x31\x82\x77\x1d\x46\x12\xe2\xdf\x2c\x42\xee\x8c\x7c\x9a\x93\xe8\x73\xbd\x5c\x72\x55\x3c\xcd\xc9\x6d\x6b\x97\x6e\xaf\xd9\xc2\xdf\x35\x56\xbf\x1f\x79\x95\xb5\xf2\x05\xda\xfa\x6a\x73\x89\xcb\x47\x6b\xae\xad\x85\x34\x3a\x9e\x6b\xb3\x4c\x76\x4e\xcf\x43\xa1\xa4\x50\x98\xa2\x1e\x0d\xc5\x88\xda\xde\x4e\x69\x38\xec\xa7\xf1\x25\xc0\x58\xa8\xb2\x72\x71\x47\xa0\x0c\xa1\x5e\xd0\x4f\xce\xb5\x72\x46\x4b\xf0\xb3\x86\x76\xc9\xbc\xe5\x4d\x18\xfd\xcb\xa0\x94\x3c\xc7\x85\x96\x05\x9a\x09\x7b\xaf\xb5\x03\x0a\xd1\x7e\x4a\x6d\xba\xf4\x23\x05\x16\x93\xa6\x24\x6c\xc1\x0c\xbf\x1e\x4c\xeb\xb8\x71\x07\x38\xaf\x69\x0c\xfa\x98\xdd\x66\x30\xbc\xfc\x8f\xc5\x80\x81\x15\xff\xc6\x09\xbb\xbc\x38\xe1\x6f\x6e\xc1\x1d\x78\x52\x58\x00\x77\xa4\x7c\x3e\x77\x48\x19\xa0\xb0\xf0\xfe\xa7\x97\xf0\xfd\xf7\xdf\xff\x57\xf0\x45\x6d\xa0\xa8\x0c\x27\xcb\xaf\xf3\x20\x32\x10\xa5\xd7\x5f\xca\x0c\xaa\xe2\x80\x95\xd7\xaa\x48\x8c\x3c\xfb\x3c\x3e\x66\x38\xd7\x06\x3f\x8f\x91\xb6\xf6\x4e\xa4\x4f\x14\x98\x8c\xcf\x2a\xec\x12\x78\xda\x61\xdb\xd9\x47\x88\x5a\xed\x1d\xfd\x34\xb5\x98\x98\x44\x72\x39\x57\x39\xca\xc3\xd4\xec\x53\x23\xec\xf4\xa5\x27\x73\x00\xa0\x4c\x6b\x2f\x50\x96\xc3\x99\xd4\xf9\xc7\x14\xf4\xad\x77\xf3\x25\x77\xf9\x82\xc2\x54\x10\xed\x39\xe0\x5d\x8e\x65\xc8\xd7\x4b\xa1\x28\xf9\xd6\x0a\x6d\x36\x1e\x95\x44\x72\x3c\x22\x9b\xf6\x4f\x0b\x33\xaa\xe3\x6f\x5d\x6e\x34\x79\x1b\x58\xe4\x26\x5f\x10\xa1\x65\x08\xc7\x27\x5d\x9d\x02\x45\x58\x7e\x18\x3e\x62\xb0\x44\xb7\xd0\xc5\x84\xfd\xfd\xf5\x0d\x8b\xf2\x4e\x73\xbc\xf5\x6d\xb7\x54\x12\x40\x76\xed\xe7\xfb\x2d\xf3\x5e\xc7\xfb\x4c\x4b\x25\x7b\x65\xb0\xe2\xb2\xc2\x09\xdb\x6e\xb3\xb7\x7c\x89\xbb\xdd\x97\xc6\x8b\xda\xde\xbe\x06\xe4\xa5\x50\x6d\xc4\xbf\x08\xf5\x2a\x5a\x7f\x07\xf8\x2f\x42\x45\xaf\xbb\x7c\x7e\xb1\xb4\xb5\xdf\x3d\xbb\xac\x99\x58\x0a\x25\x96\xd5\x32\xee\x6a\xc9\x8f\xbe\x10\x21\xbf\xdb\x43\xc8\xef\x4e\x23\xe4\x77\x09\xe1\x71\x78\xfc\xee\x14\xbc\xaf\x2a\x64\xae\xf6\x84\xfc\x42\x29\xed\x8e\x73\x70\x8d\x66\x85\x26\x7b\x8f\xb6\xd4\xca\x62\x76\xed\xb8\xab\xec\x4b\x5d\xe0\xe4\xf9\xc5\x45\x62\xe9\xf2\x3f\x6b\x96\x78\x4d\x0b\x3e\xe2\x66\xe2\xe5\x14\x02\x1e\xf7\x16\x04\x42\x79\xc7\x0d\x1a\x59\x56\xd6\xc1\x82\xaf\xf0\xeb\xf3\x5c\x59\x34\x6d\xa6\x7f\xb3\x68\x3a\xec\xd2\x60\xad\xa7\x26\x7c\xff\xf7\xcd\xcd\x3b\xb0\x5e\x14\xe0\xe9\x7c\x11\x12\xa3\x2b\xb7\xe7\x96\xef\x69\xa0\x83\xc5\x8f\x3e\x00\x26\x90\xfa\x22\x34\x44\x49\xe4\x7b\x78\x48\xe9\x22\xef\x22\x8a\xe3\x47\x30\x45\x22\x41\xd1\x06\x73\x6d\x0a\xda\xa2\x83\xc6\x63\x10\xf1\x1a\xff\x14\x3d\xc7\x8a\xef\x31\x4c\xa0\x31\xda\xd8\x1a\xce\x5c\x48\x4a\x0b\x66\x1b\x58\x2f\xd0\x2d\x28\x43\xa0\xc5\x2d\xd0\xf7\x5c\x28\x88\xf3\xeb\x44\x20\x54\xc6\x49\x04\x0c\x7c\x9a\x88\x7f\x40\xf6\xda\x4f\x04\xc6\x76\xbb\x54\x7c\xc4\xcd\x62\xfa\x42\x6d\x28\x19\x71\x95\x1d\x8f\xc2\xf7\x27\xc8\x6d\xd0\x1e\xa1\x48\xa3\x5d\xa2\xff\xa0\x6d\x21\xa0\x7b\x80\xaa\xd2\x47\x88\x2a\x7d\x82\xa6\xae\xdc\x51\xb2\xe3\x51\x40\xf0\xe9\x22\xb7\xda\xb8\x5a\xe0\xa4\x6f\xb3\xa7\xe8\xc7\x08\xf7\x9a\xaa\x91\x63\xa2\x7d\x15\x33\x0c\x4f\xf6\x21\x39\xe0\x1a\xad\xeb\x50\x8d\xc3\x5d\xda\x6f\xfd\x0b\x98\x0b\x63\xdd\x03\xa4\xc9\x11\x8f\x90\x8e\xc3\x5d\xd2\xbf\xca\xe2\xb1\xa4\xad\xd4\x47\x61\xa7\xf1\x2e\xf1\x6b\xa9\x1f\x0d\x7c\x4e\x95\xd7\x11\xea\x69\xbc\x4b\xfd\xa7\xf0\xe6\x18\xf5\xcf\x37\x11\x29\x96\xa2\xb1\x11\x55\x2d\x67\xd1\x48\x82\x33\x96\x68\x7c\xcd\x7b\xca\x52\x2e\x5a\xf8\x7f\x26\x52\x70\xd1\x05\xfe\x42\xca\x07\x84\x71\xf9\xbc\x43\xe7\xf2\x79\x97\xd0\xe5\xf3\x1a\xd0\x03\x04\x9f\x77\x81\x3d\x3f\x82\xec\xf9\xc5\x63\x09\x3e\xbb\xe8\x52\x7c\x76\x71\x84\xe4\xb3\x8b\x53\x34\xdb\x4a\xaa\xc3\xab\x27\x79\x46\xad\x99\x77\x42\x75\x92\xcc\xd4\x77\x8e\xe2\x1f\xfb\xde\x6d\xed\xd0\x4a\x6e\xc0\x2e\xf4\x3a\x65\xd1\xc9\xa9\xf7\x76\x99\x9a\x44\x54\x78\x98\xcb\x8e\x07\xbe\x77\xfe\x65\x0a\x7c\xfe\xd3\x86\x33\x08\x6f\xc7\x23\x8f\xe2\x80\x8b\xd4\x33\xf1\xa4\xb2\xeb\x85\x5e\xef\x76\x7b\x38\x16\xa2\x28\x50\xd5\x91\x69\xa1\xd7\x7b\xdb\x99\xff\x80\x4d\x1b\x42\xa9\xb0\x09\xa9\x9f\xad\x66\xde\x4e\xef\x2d\x73\xd8\x34\xe4\xea\xed\x02\xc5\xe3\xa1\xde\x7f\xf6\x7a\x59\xba\xcd\x6e\x57\x77\x9b\xa2\xb4\x0e\x49\x4a\xa1\x3e\xd6\xf4\x5e\x4a\xe4\x86\xda\x43\x6d\x06\xc3\x53\x2a\x53\x42\x91\xd2\x6b\xd6\x09\xfd\xa0\xa0\xca\xba\x36\xa2\xd4\x75\xb8\xac\xa8\x67\x36\x7d\xab\x93\x77\xcd\x75\xa5\x8a\x50\xf7\x24\xb2\xbd\x56\x93\x56\x0a\xeb\x86\x95\xf2\x87\x00\x45\xac\x49\x7c\xff\x6a\x6f\x91\xc8\x63\x9f\x8e\x33\xe0\x2c\xfb\x5d\x58\x31\x93\x08\xd9\x20\xbe\x0d\x9d\xbc\xf8\x08\x70\xc2\x38\x12\xce\xbd\xe3\x0e\x06\xd1\x04\x26\xde\x22\x6a\x1a\xbe\x70\xf4\xed\x2b\xcf\x48\x48\x48\x9c\x11\xea\x76\xb7\xab\x23\x05\x40\x2d\xea\xed\xb6\x32\xf2\x46\x7b\xd0\x90\x5d\x97\x5c\x65\x6f\x5e\x05\x1e\xe8\x83\xed\xf6\x70\x8c\x44\x5e\x93\xd9\x63\x2f\x5a\x28\x71\xb7\xd7\xf8\xf6\x36\x09\xfe\xdf\xa1\x50\x73\xcd\xa6\xc9\x5a\x69\x56\x4b\x7f\x2d\x9a\xd9\x4f\x5c\x48\x2c\xee\xa1\x54\x90\xb4\x4d\x1d\x1e\xdb\xc9\x92\xb0\xb1\x24\x98\x7b\x22\x6c\x1a\x88\x1d\xac\x57\x2f\xd8\x52\x6b\x72\xd3\xfa\xdd\x5e\x27\xd6\xbf\x0d\xdd\x56\xa2\x34\x6c\x09\x87\x04\xb7\x27\xe0\x86\x93\x50\x35\xee\xbd\x18\x5b\x67\x34\x55\xdd\x21\x5a\x6c\xb7\xd9\x9b\x57\x51\xda\x61\x36\x1d\x2f\xd1\x8c\x43\x7a\x28\xed\x27\xd0\xaa\x71\x9d\x24\xb7\x27\x78\x80\x56\x65\x4d\x7c\xd5\x89\xec\x69\x25\xd4\x07\x4b\x7b\x89\xac\x5f\x7d\xb7\x3b\x25\xf0\x46\x36\x24\xb6\x56\xfd\x94\xbc\x26\xfd\x37\x76\x9c\xfc\x25\x89\xdf\xff\xf0\x43\x94\x8e\x17\xa8\x2c\x9d\x39\xf8\xdf\xd6\x19\x51\x92\x07\xef\x7d\xdf\x78\x65\x3f\xe4\xb4\xad\xa5\xba\x8b\x37\x5e\xd9\xfc\x17\x60\x86\x2e\x2b\x57\xee\xc8\x0c\x42\x69\xa6\x63\xb7\x20\x9e\xff\x17\x29\x8c\x8d\xdc\x62\x3a\x76\xc5\x74\xbb\xb5\xce\x40\xf6\x3b\x6d\x53\x7e\xb8\x98\x8e\x47\xce\x1c\x62\x3c\xae\x89\xe3\xa3\xe3\x91\xe7\x7f\xda\xbb\x7f\x62\xd3\x32\xa7\xbf\xd0\x2c\x3f\x7c\xd3\x7c\x95\x9e\xc2\xbc\x10\x2f\xb5\x81\xec\x9d\xc1\xd5\x3b\xea\xaa\x67\x6f\xf1\xce\xd1\xd3\x6e\xd7\x1b\x2b\xbe\x3a\x38\xb1\xa2\xfd\x34\x15\x75\xc9\x80\xd2\xb7\xbb\x5d\xcb\x81\x4a\x83\x2b\xa1\x2b\xcb\x9a\x83\x05\x6f\x28\x6c\xfa\xad\xe4\xc6\xfc\x00\xef\xe2\x84\xba\xed\xdf\x80\x6c\x48\x37\x60\x5a\xa4\x15\x55\x6c\x1d\xb2\x34\x15\xbe\x35\x44\xfb\x08\xc9\x74\x88\xe0\x39\x4a\xc3\xbd\xb1\xcd\x8d\x28\x53\x18\xa6\xdd\x61\xf4\x81\xaf\x78\x18\xf5\x5c\x8e\x46\xf0\x37\xa1\x0a\xa1\x6e\xed\xd1\x13\x74\x6a\x78\xd3\x09\x75\x7f\x5e\x29\xdf\xbc\xea\x0f\xe2\x49\xf9\x68\x04\x6f\x94\x70\x82\x4b\xf1\x6f\xdf\x86\xe4\x2b\x2d\x0a\x9f\x29\x50\x17\x4e\xab\x90\x41\x42\x96\x5a\x7e\x7d\xb6\xa0\x33\xa4\x01\x50\x8c\x25\x9a\x00\x67\x7d\xf6\x4d\xa7\xbb\x3f\x68\xbe\xd8\x86\x83\x99\x2b\x3a\x52\xb0\xb8\x1b\xfc\x50\x7f\x25\x96\x9f\xf2\x55\x02\xfc\x8f\x05\x86\x9e\xc3\xe1\xa2\x20\xac\x47\xae\x60\x8d\xb0\xe6\xca\x11\x43\x04\xb7\x25\x10\xa8\x05\x92\xc8\x59\x0d\xc2\x81\xe3\x1f\xd1\x82\x70\x36\x94\xc8\xf7\x72\xa6\x55\xff\x3b\x5a\x27\x9b\xd9\x1a\xef\x77\xe7\x90\x84\x0b\xb5\x74\x1f\xc3\x67\x94\x67\x10\xca\x6e\x90\x50\xbd\x50\x05\xac\x44\x8e\xc3\x15\x1a\xcb\x6b\xad\x6a\x5f\xfd\x86\x94\xe5\xea\x98\x1c\x89\xb4\x14\xf9\xc7\xae\xaa\xef\x61\xe8\x14\x98\x46\xe6\xbf\x95\xd4\x85\xd6\xcb\x52\xa2\x67\x51\xcf\xdb\x32\xa5\xec\xe6\x9c\x84\xfe\xee\xd7\xeb\x9b\x83\xe3\x1a\xdf\x22\x86\xaa\x04\xa7\x13\x31\x9a\xc0\x46\xfe\xad\x1d\x55\xa5\xd4\xbc\x60\xf0\xdb\xfb\x9f\x81\xab\x82\x9a\xfb\x9a\x17\x9e\x08\xb9\x31\xa9\xb0\x10\xb6\x94\x3c\x1c\xed\x2a\x3a\x62\x35\x7b\x1a\x3a\x94\x2e\x64\xb1\x23\x7e\x8f\x28\xe8\x56\x86\x11\x4b\x58\x2f\x84\x43\x5b\x12\x4e\xa7\x01\x95\xad\xe2\x29\x2a\xb5\x85\xfc\x99\x19\x16\x60\x35\xf5\x7f\xc9\x1f\xfa\xa5\xac\xec\x79\x3c\xbb\xa4\x8e\x4d\x43\x2e\xdd\xef\xa0\x53\x75\xe0\x33\x2a\xd0\x1b\xe2\x83\x2c\x4e\x5c\x71\x13\x04\x32\x39\x01\x9d\xdc\x9b\x1b\xe4\x6c\x90\xad\xb8\xec\x47\x55\x00\x88\x79\xff\x89\xff\xf0\xcf\x3f\x3d\x81\xcc\x19\xb1\xec\x0f\x32\x89\xea\xd6\x2d\x60\x32\x81\x8b\xb6\xa2\xb9\x44\xe3\xfa\xec\x9d\x44\x4e\x97\x5a\x7c\x1a\xc7\x29\x87\x16\x45\xd0\x8d\x4f\xa8\x9e\x24\x55\xd3\x9f\x41\x57\x19\x95\x7e\xd7\xbb\xa3\x57\x7e\xad\x12\xaf\xb4\x73\x30\x38\x37\x68\x7d\xa3\xde\x2b\xa9\xda\x37\x8f\xc4\xed\x59\x56\x6a\xeb\xfa\x87\xba\x3e\xf7\x1c\x0c\xe2\x24\x80\xac\xd0\x0a\xf7\xb4\x04\x52\xe7\x7e\x0f\xcc\x82\x39\xf4\x07\xc9\x35\xe8\x2f\xa3\x34\xaa\x99\x7f\xb7\x30\xe7\x40\x72\x0b\xcd\xcc\xf3\xd0\x18\xb9\x59\x18\xbd\x56\x6d\x99\xd4\x52\xf1\xef\xaf\x80\xc1\x53\xb8\x5b\x98\xcc\xc4\x66\x28\x1d\x83\xb6\xe4\x51\x2f\x98\x22\xd6\x6e\x40\xea\x38\x11\x6e\x5d\xf7\x72\xc8\xc9\x88\x9b\xca\xc0\x28\x72\x0b\x5c\x01\x37\x86\x6f\x52\xc7\xa5\xe4\x86\x32\x89\x43\x27\xa2\x20\x80\x3c\x5f\xd4\xe7\xe6\xb5\x43\x35\x0e\x41\x06\x56\xd3\x9f\x40\x67\xf9\x30\x23\xa2\x9d\xc0\x3f\xff\x95\x18\x3e\xeb\xb3\x83\x0b\x4c\x6c\x90\xd1\x6a\x0d\x0b\xe2\x1c\xb0\xa1\xe3\x6d\xf2\xac\x4f\xd9\xed\x20\x2b\x8d\x2e\xfb\x2c\x56\x00\x6c\xd0\x9e\x15\x56\xfc\xe0\x2d\x3e\x4c\xe6\xce\x99\x3e\x3b\x28\x0c\xda\xa6\x08\x11\x60\x56\x56\x76\xd1\x3f\xcb\xbc\x3c\x48\x1a\xfd\x0f\x83\xd6\xb4\xdd\x81\x82\x92\x0d\xc7\xaf\xa3\xd6\xea\x18\x76\x70\xcd\x23\x5e\x54\x6a\xc4\x16\x02\xe3\x8d\xa6\x85\x60\xe2\x23\xcd\xff\xa1\xd1\x2f\xd3\xad\x91\x7e\x2b\x7a\xa6\xab\x27\x09\x4e\xfb\xdb\x4c\xab\x3e\xa3\x83\x6b\xd6\xec\x09\xfd\x96\xe0\xa2\x8a\x60\x52\x2b\x6a\xcf\xcd\x2d\xca\x53\x5e\x7d\xe8\xa2\xb5\x87\xbe\xd5\x0e\xaf\xe0\x92\x36\x40\xb2\x1f\xa1\x0a\x54\xb4\x2c\x48\x5c\x61\xdc\xa6\x0f\x40\x5a\x74\x64\xf0\xfd\xf0\xc3\x17\x64\x62\xbe\xe9\x5b\x94\xe7\xa0\x2a\x29\xcf\xe1\xb2\x91\x75\x70\x9c\x16\xb2\xa7\xc0\x5a\xe6\x49\x8d\xd8\x52\x50\xee\xab\x9b\x4b\x36\x19\x1b\x74\xb6\x91\x5f\x15\x70\xb5\xd9\x17\x6b\x70\x57\xe8\x97\x46\x2c\xb9\x11\xd2\x37\x79\x15\xf8\x6b\x08\xc4\x10\x15\xe4\x7c\xc5\x85\xa4\x3c\x73\x00\x6b\x4c\xc4\xea\x1b\x0a\x4e\x43\x65\x29\x16\x11\xef\xd6\x71\x55\xd0\x1d\x9f\x14\x49\xb3\xe3\x0a\xf2\xab\x9e\xd0\xd0\xde\x64\xea\x01\x1a\xbd\xe9\x0f\x7a\x9d\x3d\xd4\xe9\xbf\x62\xcf\xa5\x54\x82\x25\x21\x3d\x64\x20\x0f\x99\xc8\xa1\x91\x34\x66\x72\x1c\x49\x67\xc7\x79\x94\x3d\x3c\x82\xd6\x5c\xe7\x95\xed\x0f\xb2\xc0\x42\xc3\x40\x13\x4d\x1b\xb3\x38\xbc\xf8\xd5\x71\xcd\x18\x58\x60\x02\xce\x54\xf1\xfe\x23\x21\xe8\x5c\x33\xeb\x68\xa2\xad\xd5\x8c\xd2\x7d\x54\x2e\xb6\xa3\x1b\x4c\x0d\xf9\x27\xf1\xf1\xde\xa8\xb8\x1f\xec\xce\xd3\xe7\x47\x18\xdb\xbf\xe0\xb5\xc7\x16\xc1\xaf\xef\x89\x85\x7b\x64\x9f\x07\xfe\xb8\xb5\xc4\x97\x94\xdf\xcf\x61\x8d\xdf\xad\x5a\xb7\xad\x70\x85\x66\xe3\x13\x9a\xf3\x94\xef\xa3\xdf\xce\x80\xd3\x6d\xd7\x0d\x48\xaa\xdf\x29\x21\xfb\xa3\x42\xb3\x69\x48\x95\xdc\xf0\x25\xc6\xa3\x98\x0f\x74\xd6\x77\xab\xe9\x33\xeb\x0c\xa7\x2b\xa4\xe4\xff\xa3\x9a\x29\x4a\xae\xf2\xc5\x39\x5d\x3e\x8a\x65\xf9\xb9\x4f\xcf\x6d\x43\xf0\xf0\xa2\x1c\xed\x70\xcd\x8d\xc0\xac\x77\xc2\xe2\x8f\x6a\x25\x44\xa6\x46\x62\x00\x6b\xa1\x0a\xbd\xce\xea\x5c\x82\x2a\x31\x98\xc0\x76\x9b\xfd\x8d\x5b\xfc\xed\xfd\xcf\x75\x23\x0a\x9e\x02\xab\xb1\xb0\x1f\x7a\xc7\x7d\xa9\x9d\x13\x5d\xa3\x8a\x49\xaa\xc1\x1c\xbd\xf0\x7c\xf2\x6b\xf0\x8f\x8a\x0e\x0d\x7c\x65\x48\xef\xdf\xbc\xb2\x94\x19\x53\x56\x28\x94\x43\x83\x3e\xa5\x14\xaa\x21\x45\xba\x0f\xba\x08\x24\x15\xfc\xfd\x75\xc8\xa2\x5b\xb2\xa4\x34\x2b\xc9\x83\x34\x2e\x8a\x83\xed\x3b\xec\xd5\x3e\x7c\xd7\xf6\x23\xce\xc3\x56\xd8\x16\x8a\x28\xe2\xb6\xea\xdf\xd4\x7d\xb4\x8e\x7f\x7e\xb6\xf8\x7e\xac\xbd\x71\x42\x19\x16\xad\xf7\x41\x0b\xd5\x3f\xe2\x1e\x87\x17\x11\x3b\x0e\xd2\xb9\xf0\xf8\x57\xba\xc8\x31\xe3\x7a\x32\x81\xcb\x23\x79\x74\x00\xe8\xaf\x56\xc6\x0d\xcf\xdf\x4d\xf3\xe0\xb2\xfb\x92\xe8\xcf\x91\x64\xa4\xfb\x23\x49\xcf\xe7\x3e\x7c\xd9\xdf\xf2\x2b\xf2\xdf\x7f\x5e\xfc\xab\xd6\xd8\x39\xcc\xc2\xd8\xb3\x66\xec\x58\x70\x3d\xb8\x53\xd9\x91\xf1\xe1\x3d\xcd\xaf\x2d\x62\xda\xb1\xe0\xcf\x3f\xe1\x49\xae\xd5\x5c\x98\x65\x9f\x45\x84\xc4\x6f\x6b\x1e\xa5\x18\x41\xd8\x3f\xee\x27\x94\xc7\x65\x4c\x00\xa2\xf3\x91\x67\x9c\x65\x4b\x5e\x1e\xb8\x43\xc7\x17\x62\xba\x78\x96\xf1\x0f\xfc\xae\x4f\x8d\xe7\xab\x93\xe6\x5d\x8a\xd1\xea\x59\x2a\x64\x08\xea\xbe\xff\x9c\xfb\xf6\xcc\x15\xb0\x57\xaf\x7f\x7e\x7d\xf3\x9a\x35\x6e\xd4\x3c\x9d\x65\x94\xd9\x64\xbc\x2c\xe5\xa6\x7f\x76\x5e\xc3\xfd\x2b\x2b\xa1\x36\x7b\x9f\x5c\xf0\x74\x8d\xc7\xdf\x22\xeb\x98\x4c\x73\xcf\xeb\x31\xb5\x36\x69\x86\x3a\x04\x30\x39\xfc\x98\x46\x1b\xef\xa1\x12\xf7\xd0\x26\x68\x33\x38\xb8\x13\x16\x6f\x9f\x18\xa4\x6a\x3b\x9c\x50\x3d\xca\x40\x62\x31\x7a\xbf\x7e\x3d\x67\x94\x15\x6a\xb3\xcc\x2c\x9a\xd0\x18\xeb\x0f\x4e\xaa\xc8\xa0\x3d\x26\x72\x83\x36\x0b\x8e\x55\x74\xed\x19\xd2\x9b\x76\xe4\x80\xae\xa6\x8f\xd4\xa2\x5f\x51\xe5\xa9\xc0\x1d\x8f\x42\x67\x71\xda\xeb\x6d\xb7\xa8\x8a\xdd\xae\xf7\xff\x03\x00\x57\xbd\x06\x58\xe1\x33\x00\x00"),
+			uncompressedSize:  13281,
 		},
 	}
 
 	fs["/"].(*_vfsgen_dirInfo).entries = []os.FileInfo{
 		fs["/aggregate.html"].(os.FileInfo),
+		fs["/compare.html"].(os.FileInfo),
 		fs["/dashboard.html"].(os.FileInfo),
+		fs["/dependencies.html"].(os.FileInfo),
+		fs["/flame.html"].(os.FileInfo),
+		fs["/flamegraph.html"].(os.FileInfo),
 		fs["/layout.html"].(os.FileInfo),
 		fs["/root.html"].(os.FileInfo),
 		fs["/trace.html"].(os.FileInfo),
@@ -173,8 +201,6 @@ func (f *_vfsgen_compressedFile) Seek(offset int64, whence int) (int64, error) {
 		f.seekPos += offset
 	case os.SEEK_END:
 		f.seekPos = f._vfsgen_compressedFileInfo.uncompressedSize + offset
-	default:
-		panic(fmt.Errorf("invalid whence value: %v", whence))
 	}
 	return f.seekPos, nil
 }
@@ -26,7 +26,10 @@ type timelineItem struct {
 	SpanID       string                  `json:"spanID"`
 	ParentSpanID string                  `json:"parentSpanID"`
 	URL          string                  `json:"url"`
+	Service      string                  `json:"service,omitempty"`
 	Visible      bool                    `json:"visible"`
+	Failed       bool                    `json:"failed"`
+	CriticalPath int64                   `json:"criticalPath"` // msec on the critical path
 }
 
 func (tl *timelineItem) Valid() bool {
@@ -46,10 +49,14 @@ type timelineItemTimespan struct {
 }
 
 func (a *App) d3timeline(t *appdash.Trace) ([]timelineItem, error) {
-	return a.d3timelineInner(t, 0)
+	critical, err := t.CriticalPathTimes()
+	if err != nil {
+		return nil, err
+	}
+	return a.d3timelineInner(t, 0, critical)
 }
 
-func (a *App) d3timelineInner(t *appdash.Trace, depth int) ([]timelineItem, error) {
+func (a *App) d3timelineInner(t *appdash.Trace, depth int, critical map[appdash.SpanID]time.Duration) ([]timelineItem, error) {
 	var items []timelineItem
 
 	var events []appdash.Event
@@ -73,11 +80,14 @@ func (a *App) d3timelineInner(t *appdash.Trace, depth int) ([]timelineItem, erro
 	}
 
 	item := timelineItem{
-		Label:     t.Span.Name(),
-		FullLabel: t.Span.Name(),
-		Data:      t.Annotations.StringMap(),
-		SpanID:    t.Span.ID.Span.String(),
-		URL:       u.String(),
+		Label:        t.Span.Name(),
+		FullLabel:    t.Span.Name(),
+		Data:         t.Annotations.StringMap(),
+		SpanID:       t.Span.ID.Span.String(),
+		URL:          u.String(),
+		Service:      t.Span.Service(),
+		Failed:       t.Span.Failed(),
+		CriticalPath: msRound(critical[t.Span.ID]),
 	}
 
 	if !item.Valid() {
@@ -140,7 +150,7 @@ func (a *App) d3timelineInner(t *appdash.Trace, depth int) ([]timelineItem, erro
 	items = append(items, item)
 
 	for _, child := range t.Sub {
-		subItems, err := a.d3timelineInner(child, depth+1)
+		subItems, err := a.d3timelineInner(child, depth+1, critical)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,334 @@
+package zipkin
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+	"sourcegraph.com/sourcegraph/appdash/sqltrace"
+)
+
+// ToAppdash converts a Zipkin span to an appdash span and its annotations. The
+// service of its local endpoint becomes the service of the appdash span (see
+// appdash.ServiceKey).
+func ToAppdash(s *Span) (appdash.SpanID, appdash.Annotations, error) {
+	var (
+		span appdash.SpanID
+		err  error
+	)
+	if span.Trace, err = parseID(s.TraceID, true); err != nil {
+		return span, nil, fmt.Errorf("invalid trace ID: %s", err)
+	}
+	if span.Span, err = parseID(s.ID, false); err != nil {
+		return span, nil, fmt.Errorf("invalid span ID: %s", err)
+	}
+	if s.ParentID != "" {
+		if span.Parent, err = parseID(s.ParentID, false); err != nil {
+			return span, nil, fmt.Errorf("invalid parent span ID: %s", err)
+		}
+	}
+
+	var (
+		events     []appdash.Event
+		start, end time.Time
+		tags       = make(map[string]string, len(s.Tags))
+	)
+	for k, v := range s.Tags {
+		tags[k] = v
+	}
+	if s.Timestamp != 0 {
+		start = fromMicros(s.Timestamp)
+		end = fromMicros(s.Timestamp + s.Duration)
+	}
+	if s.Name != "" {
+		events = append(events, appdash.SpanName(s.Name))
+	}
+
+	isHTTP := tags[TagHTTPMethod] != "" || tags[TagHTTPPath] != "" || tags[TagHTTPURL] != ""
+	switch {
+	case s.Kind == KindServer && isHTTP:
+		e := httptrace.ServerEvent{ServerRecv: start, ServerSend: end}
+		e.Request, e.Response = takeHTTPTags(tags)
+		e.Route = takeTag(tags, TagHTTPRoute)
+		events = append(events, e)
+	case s.Kind == KindClient && isHTTP:
+		e := httptrace.ClientEvent{ClientSend: start, ClientRecv: end}
+		e.Request, e.Response = takeHTTPTags(tags)
+		events = append(events, e)
+	case s.Kind == KindClient && tags[TagSQLQuery] != "":
+		e := sqltrace.SQLEvent{ClientSend: start, ClientRecv: end}
+		e.SQL = takeTag(tags, TagSQLQuery)
+		e.Error = takeTag(tags, TagError)
+		if s.RemoteEndpoint != nil {
+			e.Tag = s.RemoteEndpoint.ServiceName
+		}
+		events = append(events, e)
+	case s.Timestamp != 0:
+		events = append(events, appdash.Timespan{S: start, E: end})
+	}
+	if msg, ok := s.Tags[TagError]; ok {
+		delete(tags, TagError)
+		events = append(events, appdash.ErrorEvent{Message: msg, Type: "Zipkin"})
+	}
+
+	var ze SpanEvent
+	ze.Kind = s.Kind
+	if s.LocalEndpoint != nil {
+		ze.LocalService = s.LocalEndpoint.ServiceName
+	}
+	if s.RemoteEndpoint != nil {
+		ze.RemoteService = s.RemoteEndpoint.ServiceName
+	}
+	if ze != (SpanEvent{}) {
+		events = append(events, ze)
+	}
+
+	for _, a := range s.Annotations {
+		events = append(events, appdash.LogWithTimestamp(a.Value, fromMicros(a.Timestamp)))
+	}
+
+	var anns appdash.Annotations
+	for _, e := range events {
+		as, err := appdash.MarshalEvent(e)
+		if err != nil {
+			return span, nil, err
+		}
+		anns = append(anns, as...)
+	}
+	for _, k := range sortedKeys(tags) {
+		anns = append(anns, appdash.Annotation{Key: k, Value: []byte(tags[k])})
+	}
+	if ze.LocalService != "" {
+		anns = append(anns, appdash.Annotation{Key: appdash.ServiceKey, Value: []byte(ze.LocalService)})
+	}
+	return span, anns, nil
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// takeTag removes the tag with key k from tags and returns its value.
+func takeTag(tags map[string]string, k string) string {
+	v := tags[k]
+	delete(tags, k)
+	return v
+}
+
+// takeHTTPTags removes the HTTP request and response tags from tags, and
+// returns the request and response they describe.
+func takeHTTPTags(tags map[string]string) (httptrace.RequestInfo, httptrace.ResponseInfo) {
+	var (
+		req  httptrace.RequestInfo
+		resp httptrace.ResponseInfo
+	)
+	req.Method = takeTag(tags, TagHTTPMethod)
+	req.URI = takeTag(tags, TagHTTPPath)
+	req.Host = takeTag(tags, TagHTTPHost)
+	if u, err := url.Parse(tags[TagHTTPURL]); err == nil && tags[TagHTTPURL] != "" {
+		delete(tags, TagHTTPURL)
+		if req.URI == "" {
+			req.URI = u.RequestURI()
+		}
+		if req.Host == "" {
+			req.Host = u.Host
+		}
+	}
+	if code, err := strconv.Atoi(tags[TagHTTPStatusCode]); err == nil {
+		delete(tags, TagHTTPStatusCode)
+		resp.StatusCode = code
+	}
+	return req, resp
+}
+
+// FromAppdash converts an appdash span and its annotations to Zipkin spans.
+// Usually only one span is returned, but as appdash records both the client
+// and server sides of an HTTP request in the same span, a span with both an
+// HTTPClient and an HTTPServer event is converted to a CLIENT span and a
+// shared SERVER span.
+//
+// The local endpoint, if non-nil, is that of the returned spans unless the
+// annotations specify another (see SpanEvent) or the span was recorded by a
+// service (see appdash.Resource). Annotations that are not part
+// of the events converted to Zipkin fields become tags.
+func FromAppdash(span appdash.SpanID, anns appdash.Annotations, local *Endpoint) []*Span {
+	var events []appdash.Event
+	appdash.UnmarshalEvents(anns, &events)
+
+	var (
+		base     = Span{TraceID: formatID(span.Trace), ID: formatID(span.Span), LocalEndpoint: local}
+		client   *httptrace.ClientEvent
+		server   *httptrace.ServerEvent
+		sql      *sqltrace.SQLEvent
+		timespan appdash.TimespanEvent
+		errMsg   string
+		consumed = map[string]bool{}
+	)
+	if span.Parent != 0 {
+		base.ParentID = formatID(span.Parent)
+	}
+	if svc := (&appdash.Span{Annotations: anns}).Service(); svc != "" {
+		base.LocalEndpoint = &Endpoint{ServiceName: svc}
+		consumed[appdash.ServiceKey] = true
+	}
+	consume := func(e appdash.Event) {
+		as, _ := appdash.MarshalEvent(e)
+		for _, a := range as {
+			consumed[a.Key] = true
+		}
+	}
+	m := anns.StringMap()
+	for _, e := range events {
+		switch e := e.(type) {
+		case httptrace.ClientEvent:
+			client = &e
+		case httptrace.ServerEvent:
+			server = &e
+		case sqltrace.SQLEvent:
+			sql = &e
+		case SpanEvent:
+			base.Kind = e.Kind
+			if e.LocalService != "" {
+				base.LocalEndpoint = &Endpoint{ServiceName: e.LocalService}
+			}
+			if e.RemoteService != "" {
+				base.RemoteEndpoint = &Endpoint{ServiceName: e.RemoteService}
+			}
+		case appdash.TimespanEvent:
+			timespan = e
+		case appdash.ErrorEvent:
+			errMsg = e.Message
+		default:
+			switch e.Schema() {
+			case "name":
+				base.Name = m["Name"]
+			case "log":
+				t, err := time.Parse(time.RFC3339Nano, m["Time"])
+				if err != nil {
+					continue
+				}
+				base.Annotations = append(base.Annotations, Annotation{Timestamp: micros(t), Value: m["Msg"]})
+			default:
+				continue
+			}
+		}
+		consume(e)
+	}
+
+	var tags map[string]string
+	for _, a := range anns {
+		if consumed[a.Key] || strings.HasPrefix(a.Key, "_schema:") {
+			continue
+		}
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+		tags[a.Key] = string(a.Value)
+	}
+	if errMsg != "" {
+		if tags == nil {
+			tags = make(map[string]string)
+		}
+		tags[TagError] = errMsg
+	}
+
+	var spans []*Span
+	newSpan := func(kind string, start, end time.Time) *Span {
+		s := base
+		if kind != "" {
+			s.Kind = kind
+		}
+		if !start.IsZero() {
+			s.Timestamp = micros(start)
+			s.Duration = micros(end) - s.Timestamp
+			if s.Duration < 1 {
+				s.Duration = 1
+			}
+		}
+		s.Tags = make(map[string]string, len(tags))
+		for k, v := range tags {
+			s.Tags[k] = v
+		}
+		if len(s.Tags) == 0 {
+			s.Tags = nil
+		}
+		spans = append(spans, &s)
+		return &s
+	}
+	if client != nil {
+		s := newSpan(KindClient, client.ClientSend, client.ClientRecv)
+		setHTTPTags(s, client.Request, client.Response)
+		if s.Name == "" {
+			s.Name = client.Request.Method
+		}
+	}
+	if server != nil {
+		s := newSpan(KindServer, server.ServerRecv, server.ServerSend)
+		setHTTPTags(s, server.Request, server.Response)
+		setTag(s, TagHTTPRoute, server.Route)
+		s.Shared = client != nil
+		if s.Name == "" {
+			s.Name = server.Request.Method
+		}
+	}
+	if sql != nil {
+		s := newSpan(KindClient, sql.ClientSend, sql.ClientRecv)
+		setTag(s, TagSQLQuery, sql.SQL)
+		setTag(s, TagError, sql.Error)
+		if sql.Tag != "" {
+			s.RemoteEndpoint = &Endpoint{ServiceName: sql.Tag}
+		}
+		if s.Name == "" {
+			s.Name = strings.ToLower("sql " + sql.Op)
+		}
+	}
+	if len(spans) == 0 {
+		var start, end time.Time
+		if timespan != nil {
+			start, end = timespan.Start(), timespan.End()
+		}
+		newSpan("", start, end)
+	}
+	return spans
+}
+
+// setHTTPTags sets the HTTP tags of s that describe the request and
+// response.
+func setHTTPTags(s *Span, req httptrace.RequestInfo, resp httptrace.ResponseInfo) {
+	setTag(s, TagHTTPMethod, req.Method)
+	setTag(s, TagHTTPHost, req.Host)
+	if u, err := url.ParseRequestURI(req.URI); err == nil {
+		setTag(s, TagHTTPPath, u.Path)
+	}
+	switch code := resp.StatusCode; {
+	case code < 0:
+		setTag(s, TagError, "request failed")
+	case code > 0:
+		setTag(s, TagHTTPStatusCode, strconv.Itoa(code))
+		if code >= 500 {
+			setTag(s, TagError, strconv.Itoa(code))
+		}
+	}
+}
+
+// setTag sets the tag with key k of s to v, unless v is empty.
+func setTag(s *Span, k, v string) {
+	if v == "" {
+		return
+	}
+	if s.Tags == nil {
+		s.Tags = make(map[string]string)
+	}
+	s.Tags[k] = v
+}
@@ -0,0 +1,190 @@
+package zipkin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// Exporter is an appdash.Collector that sends spans to a Zipkin v2 receiver
+// (such as a Zipkin server, or another appdash server; see Handler).
+//
+// Exporter buffers the spans it collects and sends them in a single request
+// every MinInterval. Annotations collected for the same span within an
+// interval are sent together; a span collected again in a later interval is
+// sent again, and it is up to the receiver to merge the two (as Zipkin
+// does).
+type Exporter struct {
+	// URL is the URL of the receiver's span endpoint, such as
+	// "http://localhost:9411/api/v2/spans".
+	URL string
+
+	// LocalEndpoint, if non-nil, is the local endpoint of the exported
+	// spans, which identifies the service that recorded them.
+	LocalEndpoint *Endpoint
+
+	// Client is the HTTP client used to send spans. If nil,
+	// http.DefaultClient is used.
+	Client *http.Client
+
+	// MinInterval is the minimum time period between sending buffered
+	// spans.
+	//
+	// Default MinInterval = 500 * time.Millisecond.
+	MinInterval time.Duration
+
+	// Log, if non-nil, is used to log errors from sending spans in the
+	// background.
+	Log *log.Logger
+
+	// The last error from sending spans in the background, if any. It
+	// will be returned to the next caller of Collect and this field will
+	// be set to nil.
+	lastErr error
+
+	started, stopped bool
+	stopChan         chan struct{}
+
+	pendingBySpanID map[appdash.SpanID]appdash.Annotations
+
+	// mu protects pendingBySpanID, lastErr, started, stopped, and stopChan.
+	mu sync.Mutex
+}
+
+// NewExporter is shorthand for:
+//
+//	e := &Exporter{
+//		URL:           url,
+//		LocalEndpoint: &Endpoint{ServiceName: serviceName},
+//		MinInterval:   500 * time.Millisecond,
+//		Log:           log.New(os.Stderr, "appdash: ", log.LstdFlags),
+//	}
+func NewExporter(url, serviceName string) *Exporter {
+	return &Exporter{
+		URL:           url,
+		LocalEndpoint: &Endpoint{ServiceName: serviceName},
+		MinInterval:   500 * time.Millisecond,
+		Log:           log.New(os.Stderr, "appdash: ", log.LstdFlags),
+	}
+}
+
+// Collect implements the appdash.Collector interface by buffering the span
+// and annotations until they are sent by the next call to Flush (or when
+// MinInterval elapses).
+func (e *Exporter) Collect(span appdash.SpanID, anns ...appdash.Annotation) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.stopped {
+		return errors.New("zipkin.Exporter is stopped")
+	}
+	if !e.started {
+		e.start()
+	}
+
+	if e.pendingBySpanID == nil {
+		e.pendingBySpanID = make(map[appdash.SpanID]appdash.Annotations)
+	}
+	e.pendingBySpanID[span] = append(e.pendingBySpanID[span], anns...)
+
+	if err := e.lastErr; err != nil {
+		e.lastErr = nil
+		return err
+	}
+	return nil
+}
+
+// Flush immediately sends all buffered spans to the receiver.
+func (e *Exporter) Flush() error {
+	e.mu.Lock()
+	pendingBySpanID := e.pendingBySpanID
+	e.pendingBySpanID = nil
+	e.mu.Unlock()
+
+	if len(pendingBySpanID) == 0 {
+		return nil
+	}
+	var spans []*Span
+	for span, anns := range pendingBySpanID {
+		spans = append(spans, FromAppdash(span, anns, e.LocalEndpoint)...)
+	}
+	return e.send(spans)
+}
+
+// send posts the spans to the receiver.
+func (e *Exporter) send(spans []*Span) error {
+	body, err := json.Marshal(spans)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", e.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := e.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("zipkin.Exporter: sending %d spans to %s: %s: %s", len(spans), e.URL, resp.Status, bytes.TrimSpace(msg))
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+func (e *Exporter) start() {
+	e.stopChan = make(chan struct{})
+	e.started = true
+	go func() {
+		for {
+			t := time.After(e.MinInterval)
+			select {
+			case <-t:
+				if err := e.Flush(); err != nil {
+					e.mu.Lock()
+					e.lastErr = err
+					if e.Log != nil {
+						e.Log.Println(err)
+					}
+					e.mu.Unlock()
+				}
+			case <-e.stopChan:
+				return // stop
+			}
+		}
+	}()
+}
+
+// Stop stops the exporter, after sending all buffered spans to the
+// receiver. After stopping, calls to Collect will fail.
+func (e *Exporter) Stop() error {
+	e.mu.Lock()
+	if e.stopped {
+		e.mu.Unlock()
+		return nil
+	}
+	if e.started {
+		close(e.stopChan)
+	}
+	e.stopped = true
+	e.mu.Unlock()
+	return e.Flush()
+}
@@ -0,0 +1,100 @@
+package zipkin
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// SpansPath is the path of the Zipkin v2 HTTP API endpoint that receives
+// spans.
+const SpansPath = "/api/v2/spans"
+
+// Handler is an http.Handler for the Zipkin v2 span endpoint (see SpansPath).
+// It accepts POST requests with a JSON list of spans, optionally gzipped,
+// and collects the spans to its Collector.
+type Handler struct {
+	// Collector receives the spans.
+	Collector appdash.Collector
+
+	// MaxBodySize is the maximum size of a request body, after
+	// decompression, in bytes.
+	//
+	// Default MaxBodySize = 16 * 1024 * 1024 (16 MB).
+	MaxBodySize int64
+}
+
+// NewHandler returns a Handler that collects the spans it receives to c.
+func NewHandler(c appdash.Collector) *Handler {
+	return &Handler{
+		Collector:   c,
+		MaxBodySize: 16 * 1024 * 1024, // 16 MB
+	}
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		if mt, _, _ := mime.ParseMediaType(ct); mt != "application/json" {
+			http.Error(w, fmt.Sprintf("unsupported content type %q (only application/json is supported)", ct), http.StatusUnsupportedMediaType)
+			return
+		}
+	}
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer gz.Close()
+		body = gz
+	}
+	if h.MaxBodySize > 0 {
+		body = io.LimitReader(body, h.MaxBodySize+1)
+	}
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if h.MaxBodySize > 0 && int64(len(data)) > h.MaxBodySize {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	var spans []*Span
+	if err := json.Unmarshal(data, &spans); err != nil {
+		http.Error(w, fmt.Sprintf("invalid spans: %s", err), http.StatusBadRequest)
+		return
+	}
+	// Convert all of the spans before collecting any, so that requests with
+	// invalid spans are rejected without collecting part of them.
+	ids := make([]appdash.SpanID, len(spans))
+	anns := make([]appdash.Annotations, len(spans))
+	for i, s := range spans {
+		if ids[i], anns[i], err = ToAppdash(s); err != nil {
+			http.Error(w, fmt.Sprintf("invalid span %d: %s", i, err), http.StatusBadRequest)
+			return
+		}
+	}
+	for i := range spans {
+		if err := h.Collector.Collect(ids[i], anns[i]...); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
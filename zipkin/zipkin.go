@@ -0,0 +1,121 @@
+// Package zipkin bridges appdash and Zipkin (https://zipkin.io), so that
+// services instrumented with Zipkin-compatible libraries can report to
+// appdash, and appdash spans can be viewed in Zipkin-compatible systems.
+//
+// Handler receives spans sent to the Zipkin v2 HTTP API (POST
+// /api/v2/spans) and collects them to an appdash Collector. Exporter is an
+// appdash Collector that sends spans to a Zipkin v2 receiver.
+//
+// Zipkin spans are converted to and from the events of the httptrace and
+// sqltrace packages where possible: spans of kind CLIENT and SERVER with
+// http.* tags become HTTPClient and HTTPServer events, and CLIENT spans with
+// a sql.query tag become SQL events. The remaining tags are kept as plain
+// annotations.
+package zipkin
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+)
+
+// Span is a span in the Zipkin v2 data model.
+type Span struct {
+	TraceID        string            `json:"traceId"`
+	ID             string            `json:"id"`
+	ParentID       string            `json:"parentId,omitempty"`
+	Name           string            `json:"name,omitempty"`
+	Kind           string            `json:"kind,omitempty"`
+	Timestamp      int64             `json:"timestamp,omitempty"` // microseconds since the epoch
+	Duration       int64             `json:"duration,omitempty"`  // microseconds
+	Debug          bool              `json:"debug,omitempty"`
+	Shared         bool              `json:"shared,omitempty"`
+	LocalEndpoint  *Endpoint         `json:"localEndpoint,omitempty"`
+	RemoteEndpoint *Endpoint         `json:"remoteEndpoint,omitempty"`
+	Annotations    []Annotation      `json:"annotations,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+}
+
+// Endpoint is the network context of a node in the service graph.
+type Endpoint struct {
+	ServiceName string `json:"serviceName,omitempty"`
+	IPv4        string `json:"ipv4,omitempty"`
+	IPv6        string `json:"ipv6,omitempty"`
+	Port        int    `json:"port,omitempty"`
+}
+
+// Annotation is a timestamped event in a Zipkin span.
+type Annotation struct {
+	Timestamp int64  `json:"timestamp"` // microseconds since the epoch
+	Value     string `json:"value"`
+}
+
+// Span kinds.
+const (
+	KindClient   = "CLIENT"
+	KindServer   = "SERVER"
+	KindProducer = "PRODUCER"
+	KindConsumer = "CONSUMER"
+)
+
+// Tag names, as used by Zipkin's instrumentation libraries.
+const (
+	TagHTTPMethod     = "http.method"
+	TagHTTPPath       = "http.path"
+	TagHTTPURL        = "http.url"
+	TagHTTPHost       = "http.host"
+	TagHTTPRoute      = "http.route"
+	TagHTTPStatusCode = "http.status_code"
+	TagSQLQuery       = "sql.query"
+	TagError          = "error"
+)
+
+// SpanEvent records the fields of a Zipkin span that have no equivalent in
+// the other appdash events, so that they survive conversion to appdash and
+// back.
+type SpanEvent struct {
+	Kind          string `trace:"Zipkin.Kind"`
+	LocalService  string `trace:"Zipkin.LocalEndpoint.ServiceName"`
+	RemoteService string `trace:"Zipkin.RemoteEndpoint.ServiceName"`
+}
+
+// Schema returns the constant "Zipkin".
+func (SpanEvent) Schema() string { return "Zipkin" }
+
+func init() { appdash.RegisterEvent(SpanEvent{}) }
+
+// formatID formats an ID as 16 lowercase hexadecimal digits.
+func formatID(id appdash.ID) string {
+	return fmt.Sprintf("%016x", uint64(id))
+}
+
+// parseID parses a 64 bit Zipkin ID or, if trace is true, a 64 or 128 bit
+// Zipkin trace ID (of which the low 64 bits are returned).
+func parseID(s string, trace bool) (appdash.ID, error) {
+	if trace && len(s) == 32 {
+		s = s[16:]
+	}
+	if len(s) == 0 || len(s) > 16 {
+		return 0, fmt.Errorf("%q has invalid length", s)
+	}
+	id, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		return 0, fmt.Errorf("%q is zero", s)
+	}
+	return appdash.ID(id), nil
+}
+
+// micros returns t in microseconds since the epoch.
+func micros(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Microsecond)
+}
+
+// fromMicros returns the time us microseconds after the epoch.
+func fromMicros(us int64) time.Time {
+	return time.Unix(0, us*int64(time.Microsecond)).UTC()
+}
@@ -0,0 +1,248 @@
+package zipkin
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"sourcegraph.com/sourcegraph/appdash"
+	"sourcegraph.com/sourcegraph/appdash/httptrace"
+	"sourcegraph.com/sourcegraph/appdash/sqltrace"
+)
+
+const testSpans = `[{
+  "traceId": "5af7183fb1d4cf5f5af7183fb1d4cf5f",
+  "id": "352bff9a74ca9ad2",
+  "parentId": "6b221d5bc9e6496c",
+  "name": "get /api",
+  "kind": "SERVER",
+  "timestamp": 1556604172355737,
+  "duration": 1431,
+  "localEndpoint": {"serviceName": "backend", "ipv4": "192.168.99.1", "port": 3306},
+  "annotations": [{"timestamp": 1556604172355800, "value": "cache miss"}],
+  "tags": {"http.method": "GET", "http.path": "/api", "http.status_code": "503", "error": "upstream timeout", "mvc.controller.class": "Frontend"}
+}, {
+  "traceId": "5af7183fb1d4cf5f",
+  "id": "0000000000000003",
+  "parentId": "352bff9a74ca9ad2",
+  "name": "query",
+  "kind": "CLIENT",
+  "timestamp": 1556604172355800,
+  "duration": 100,
+  "remoteEndpoint": {"serviceName": "mysql"},
+  "tags": {"sql.query": "SELECT 1"}
+}]`
+
+func TestHandler(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	srv := httptest.NewServer(NewHandler(ms))
+	defer srv.Close()
+
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	w.Write([]byte(testSpans))
+	w.Close()
+	req, _ := http.NewRequest("POST", srv.URL+SpansPath, &gz)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Encoding", "gzip")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("got status %s, want 202 Accepted", resp.Status)
+	}
+
+	trace, err := ms.Trace(0x5af7183fb1d4cf5f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := trace.FindSpan(0x352bff9a74ca9ad2)
+	if server == nil {
+		t.Fatalf("server span not found in %v", trace)
+	}
+	if server.Span.ID.Parent != 0x6b221d5bc9e6496c {
+		t.Errorf("got parent %v, want 6b221d5bc9e6496c", server.Span.ID.Parent)
+	}
+	if name := server.Span.Name(); name != "get /api" {
+		t.Errorf("got name %q, want %q", name, "get /api")
+	}
+	var se httptrace.ServerEvent
+	if err := appdash.UnmarshalEvent(server.Span.Annotations, &se); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Unix(0, 1556604172355737000).UTC()
+	wantSE := httptrace.ServerEvent{
+		Request:    httptrace.RequestInfo{Method: "GET", URI: "/api"},
+		Response:   httptrace.ResponseInfo{StatusCode: 503},
+		ServerRecv: start,
+		ServerSend: start.Add(1431 * time.Microsecond),
+	}
+	if !reflect.DeepEqual(se, wantSE) {
+		t.Errorf("got server event %+v, want %+v", se, wantSE)
+	}
+	var ee appdash.ErrorEvent
+	if err := appdash.UnmarshalEvent(server.Span.Annotations, &ee); err != nil {
+		t.Fatal(err)
+	}
+	if want := (appdash.ErrorEvent{Message: "upstream timeout", Type: "Zipkin"}); ee != want {
+		t.Errorf("got error event %+v, want %+v", ee, want)
+	}
+	m := server.Span.Annotations.StringMap()
+	if m["mvc.controller.class"] != "Frontend" || m["Zipkin.LocalEndpoint.ServiceName"] != "backend" || m["Msg"] != "cache miss" {
+		t.Errorf("missing annotations in %v", m)
+	}
+
+	query := trace.FindSpan(3)
+	if query == nil {
+		t.Fatalf("query span not found in %v", trace)
+	}
+	var sqlEv sqltrace.SQLEvent
+	if err := appdash.UnmarshalEvent(query.Span.Annotations, &sqlEv); err != nil {
+		t.Fatal(err)
+	}
+	if sqlEv.SQL != "SELECT 1" || sqlEv.Tag != "mysql" || sqlEv.ClientRecv.Sub(sqlEv.ClientSend) != 100*time.Microsecond {
+		t.Errorf("got SQL event %+v", sqlEv)
+	}
+}
+
+func TestHandler_errors(t *testing.T) {
+	h := NewHandler(appdash.NewMemoryStore())
+	tests := []struct {
+		method, contentType, body string
+		want                      int
+	}{
+		{"GET", "", "", http.StatusMethodNotAllowed},
+		{"POST", "application/x-protobuf", "", http.StatusUnsupportedMediaType},
+		{"POST", "application/json", "{", http.StatusBadRequest},
+		{"POST", "application/json", `[{"traceId": "x", "id": "1"}]`, http.StatusBadRequest},
+		{"POST", "application/json; charset=utf-8", `[]`, http.StatusAccepted},
+	}
+	for _, test := range tests {
+		req := httptest.NewRequest(test.method, SpansPath, strings.NewReader(test.body))
+		if test.contentType != "" {
+			req.Header.Set("Content-Type", test.contentType)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if w.Code != test.want {
+			t.Errorf("%s %s %q: got status %d, want %d", test.method, test.contentType, test.body, w.Code, test.want)
+		}
+	}
+}
+
+func TestFromAppdash_shared(t *testing.T) {
+	start := time.Unix(1, 0)
+	var anns appdash.Annotations
+	for _, e := range []appdash.Event{
+		appdash.SpanName("example.com"),
+		httptrace.ClientEvent{
+			Request:    httptrace.RequestInfo{Method: "GET", URI: "/a?b=c", Host: "example.com"},
+			Response:   httptrace.ResponseInfo{StatusCode: 200},
+			ClientSend: start,
+			ClientRecv: start.Add(3 * time.Millisecond),
+		},
+		httptrace.ServerEvent{
+			Request:    httptrace.RequestInfo{Method: "GET", URI: "/a?b=c", Host: "example.com"},
+			Response:   httptrace.ResponseInfo{StatusCode: 500},
+			Route:      "/a",
+			ServerRecv: start.Add(time.Millisecond),
+			ServerSend: start.Add(2 * time.Millisecond),
+		},
+	} {
+		as, err := appdash.MarshalEvent(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		anns = append(anns, as...)
+	}
+	anns = append(anns, appdash.Annotation{Key: "custom", Value: []byte("v")})
+
+	spans := FromAppdash(appdash.SpanID{Trace: 1, Span: 2, Parent: 3}, anns, &Endpoint{ServiceName: "svc"})
+	want := []*Span{{
+		TraceID:       "0000000000000001",
+		ID:            "0000000000000002",
+		ParentID:      "0000000000000003",
+		Name:          "example.com",
+		Kind:          KindClient,
+		Timestamp:     1000000,
+		Duration:      3000,
+		LocalEndpoint: &Endpoint{ServiceName: "svc"},
+		Tags:          map[string]string{"custom": "v", "http.method": "GET", "http.host": "example.com", "http.path": "/a", "http.status_code": "200"},
+	}, {
+		TraceID:       "0000000000000001",
+		ID:            "0000000000000002",
+		ParentID:      "0000000000000003",
+		Name:          "example.com",
+		Kind:          KindServer,
+		Timestamp:     1001000,
+		Duration:      1000,
+		Shared:        true,
+		LocalEndpoint: &Endpoint{ServiceName: "svc"},
+		Tags:          map[string]string{"custom": "v", "http.method": "GET", "http.host": "example.com", "http.path": "/a", "http.route": "/a", "http.status_code": "500", "error": "500"},
+	}}
+	if !reflect.DeepEqual(spans, want) {
+		t.Errorf("got spans\n%+v\nwant\n%+v", spans, want)
+	}
+}
+
+func TestExporter(t *testing.T) {
+	ms := appdash.NewMemoryStore()
+	srv := httptest.NewServer(NewHandler(ms))
+	defer srv.Close()
+
+	e := NewExporter(srv.URL+SpansPath, "test")
+	e.MinInterval = time.Hour // send on Stop only
+	rec := appdash.NewRecorder(appdash.SpanID{Trace: 1, Span: 2}, e)
+	rec.Name("root")
+	child := rec.Child()
+	child.Name("query")
+	start := time.Now()
+	child.Event(sqltrace.SQLEvent{SQL: "SELECT 1", Tag: "db", ClientSend: start, ClientRecv: start.Add(time.Millisecond)})
+	child.Finish()
+	rec.Log("done")
+	rec.Finish()
+	for _, r := range []*appdash.Recorder{rec, child} {
+		if errs := r.Errors(); len(errs) > 0 {
+			t.Fatal(errs)
+		}
+	}
+
+	if _, err := ms.Trace(1); err != appdash.ErrTraceNotFound {
+		t.Fatalf("got err %v before Stop, want ErrTraceNotFound", err)
+	}
+	if err := e.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	trace, err := ms.Trace(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := trace.Span.Name(); name != "root" {
+		t.Errorf("got root name %q, want %q", name, "root")
+	}
+	m := trace.Span.Annotations.StringMap()
+	if m["Msg"] != "done" || m["Zipkin.LocalEndpoint.ServiceName"] != "test" {
+		t.Errorf("missing root annotations in %v", m)
+	}
+	if len(trace.Sub) != 1 {
+		t.Fatalf("got %d sub-traces, want 1", len(trace.Sub))
+	}
+	var sqlEv sqltrace.SQLEvent
+	if err := appdash.UnmarshalEvent(trace.Sub[0].Span.Annotations, &sqlEv); err != nil {
+		t.Fatal(err)
+	}
+	if sqlEv.SQL != "SELECT 1" || sqlEv.Tag != "db" {
+		t.Errorf("got SQL event %+v", sqlEv)
+	}
+
+	if err := e.Collect(appdash.SpanID{Trace: 1, Span: 3}); err == nil {
+		t.Error("Collect after Stop: got nil error")
+	}
+}